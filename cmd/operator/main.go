@@ -41,12 +41,13 @@ import (
 	metricsserver "sigs.k8s.io/controller-runtime/pkg/metrics/server"
 	"sigs.k8s.io/controller-runtime/pkg/webhook"
 	mcmanager "sigs.k8s.io/multicluster-runtime/pkg/manager"
+	kubeconfigprovider "sigs.k8s.io/multicluster-runtime/providers/kubeconfig"
 
-	operatorclient "github.com/kcp-dev/kcp-operator/pkg/client"
 	"github.com/kcp-dev/kcp-operator/pkg/config"
 	"github.com/kcp-dev/kcp-operator/pkg/controller"
 	"github.com/kcp-dev/kcp-operator/pkg/metrics"
 	"github.com/kcp-dev/kcp-operator/pkg/reconciling"
+	operatorwebhook "github.com/kcp-dev/kcp-operator/pkg/webhook"
 	deployv1alpha1 "github.com/kcp-dev/kcp-operator/sdk/apis/deploy/v1alpha1"
 	operatorv1alpha1 "github.com/kcp-dev/kcp-operator/sdk/apis/operator/v1alpha1"
 )
@@ -77,10 +78,16 @@ func main() {
 
 func run(ctx context.Context) error {
 	var (
-		metricsAddr, probeAddr                           string
-		enableLeaderElection, secureMetrics, enableHTTP2 bool
-		tlsOpts                                          []func(*tls.Config)
-		enabledControllerGroups                          []string
+		metricsAddr, probeAddr, webhookCertPath                          string
+		enableLeaderElection, secureMetrics, enableHTTP2, enableWebhooks bool
+		tlsOpts                                                          []func(*tls.Config)
+		enabledControllerGroups                                          []string
+		clusterProvider                                                  string
+		providerOpts                                                     config.ProviderOptions
+		addresser                                                        string
+		addresserOpts                                                    config.AddresserOptions
+		tracingOpts                                                      config.TracingOptions
+		pkiBackend                                                       string
 	)
 
 	// Create pflag set and bind to standard flag set
@@ -96,9 +103,42 @@ func run(ctx context.Context) error {
 		"If set, the metrics endpoint is served securely via HTTPS. Use --metrics-secure=false to use HTTP instead.")
 	fs.BoolVar(&enableHTTP2, "enable-http2", false,
 		"If set, HTTP/2 will be enabled for the metrics and webhook servers")
+	fs.BoolVar(&enableWebhooks, "enable-webhooks", false,
+		"If set, the validating admission webhooks for operator.kcp.io resources are served.")
+	fs.StringVar(&webhookCertPath, "webhook-cert-path", "",
+		"The directory that contains the webhook serving certificate (tls.crt and tls.key). "+
+			"If empty, the controller-runtime default location is used.")
 	fs.StringSliceVar(&enabledControllerGroups, "enabled-controller-groups",
 		[]string{string(config.ControllerGroupConfig), string(config.ControllerGroupWorkload)},
 		"Comma-separated list of controller groups to enable (available: config, workload).")
+	fs.StringVar(&clusterProvider, "workload-cluster-provider", string(config.ClusterProviderNone),
+		"The multicluster provider that supplies the clusters the workload controllers deploy into (available: none, kubeconfig).")
+	fs.StringVar(&providerOpts.KubeconfigNamespace, "workload-cluster-kubeconfig-namespace", "",
+		"The namespace in which the kubeconfig provider looks for kubeconfig Secrets of workload clusters.")
+	fs.StringVar(&providerOpts.KubeconfigSecretLabel, "workload-cluster-kubeconfig-label", kubeconfigprovider.DefaultKubeconfigSecretLabel,
+		"The label that marks Secrets as workload cluster kubeconfigs (the label value must be \"true\").")
+	fs.StringVar(&providerOpts.KubeconfigSecretKey, "workload-cluster-kubeconfig-key", kubeconfigprovider.DefaultKubeconfigSecretKey,
+		"The key in the kubeconfig Secrets that holds the kubeconfig.")
+	fs.BoolVar(&providerOpts.EngageLocalCluster, "workload-engage-local-cluster", false,
+		"If set and a workload cluster provider is configured, the workload controllers also deploy into the local cluster.")
+	fs.StringVar(&providerOpts.APIExportKubeconfig, "apiexport-kubeconfig", "",
+		"Path to a kubeconfig for the kcp workspace containing the operator's APIExport. If set, the config controllers reconcile objects in all workspaces binding the APIExport instead of the local cluster.")
+	fs.StringVar(&providerOpts.APIExportEndpointSlice, "apiexport-endpoint-slice", "",
+		"The name of the APIExportEndpointSlice of the operator's APIExport.")
+	fs.StringVar(&addresser, "addresser", string(config.AddresserInCluster),
+		"How the operator reaches kcp components (available: in-cluster, static, compiled).")
+	fs.StringVar(&addresserOpts.StaticFile, "addresser-static-file", "",
+		"The file that maps components to their endpoints for the static addresser.")
+	fs.StringVar(&addresserOpts.ProxyURL, "addresser-proxy-url", "",
+		"If set, all connections to kcp components are tunneled through this SOCKS5 (socks5://) or HTTP CONNECT (http://, https://) proxy.")
+	fs.StringVar(&pkiBackend, "pki-backend", string(config.PKIBackendCertManager),
+		"How certificates for kcp components are issued (available: cert-manager, builtin).")
+	fs.StringVar(&tracingOpts.Endpoint, "tracing-otlp-endpoint", "",
+		"The host:port of an OTLP/gRPC collector to send reconcile traces to. Tracing is disabled if empty.")
+	fs.BoolVar(&tracingOpts.Insecure, "tracing-otlp-insecure", false,
+		"If set, the connection to the OTLP collector does not use TLS.")
+	fs.Float64Var(&tracingOpts.SamplingRatio, "tracing-sampling-ratio", 1,
+		"The fraction of reconciles that are traced, between 0 and 1.")
 
 	// Add feature gates flag
 	config.DefaultMutableFeatureGate.AddFlag(fs)
@@ -126,10 +166,37 @@ func run(ctx context.Context) error {
 	}
 	setupLog.Info("Enabled controller groups", "groups", sets.List(controllerGroups))
 
+	providerOpts.Provider = config.ClusterProvider(clusterProvider)
+	providerOpts.Scheme = scheme
+	provider, err := providerOpts.NewProvider()
+	if err != nil {
+		return fmt.Errorf("invalid workload cluster provider configuration: %w", err)
+	}
+
+	addresserOpts.Addresser = config.AddresserType(addresser)
+	if err := addresserOpts.Validate(); err != nil {
+		return fmt.Errorf("invalid addresser configuration: %w", err)
+	}
+
+	backend, err := config.NewPKIBackend(config.PKIBackendType(pkiBackend))
+	if err != nil {
+		return fmt.Errorf("invalid --pki-backend: %w", err)
+	}
+
 	log := zap.NewRaw(zap.UseFlagOptions(&opts))
 	ctrl.SetLogger(zapr.NewLogger(log))
 	reconciling.Configure(log.Sugar())
 
+	shutdownTracing, err := tracingOpts.SetupTracing(ctx, "kcp-operator")
+	if err != nil {
+		return fmt.Errorf("invalid tracing configuration: %w", err)
+	}
+	defer func() {
+		if err := shutdownTracing(context.Background()); err != nil {
+			setupLog.Error(err, "failed to flush traces")
+		}
+	}()
+
 	// if the enable-http2 flag is false (the default), http/2 should be disabled
 	// due to its vulnerabilities. More specifically, disabling http/2 will
 	// prevent from being vulnerable to the HTTP/2 Stream Cancellation and
@@ -147,6 +214,7 @@ func run(ctx context.Context) error {
 
 	webhookServer := webhook.NewServer(webhook.Options{
 		TLSOpts: tlsOpts,
+		CertDir: webhookCertPath,
 	})
 
 	// Metrics endpoint is enabled in 'config/default/kustomization.yaml'. The Metrics options configure the server.
@@ -173,7 +241,7 @@ func run(ctx context.Context) error {
 		metricsServerOptions.FilterProvider = filters.WithAuthenticationAndAuthorization
 	}
 
-	mgr, err := mcmanager.New(ctrl.GetConfigOrDie(), nil, ctrl.Options{
+	mgr, err := mcmanager.New(ctrl.GetConfigOrDie(), provider, ctrl.Options{
 		Scheme:                 scheme,
 		Metrics:                metricsServerOptions,
 		WebhookServer:          webhookServer,
@@ -196,18 +264,40 @@ func run(ctx context.Context) error {
 		return fmt.Errorf("unable to start manager: %w", err)
 	}
 
+	if err := config.SetupProvider(ctx, provider, mgr); err != nil {
+		return fmt.Errorf("unable to set up workload cluster provider: %w", err)
+	}
+
+	address, err := addresserOpts.NewAddresser(mgr.GetLocalManager().GetClient())
+	if err != nil {
+		return fmt.Errorf("unable to set up addresser: %w", err)
+	}
+
 	if controllerGroups.Has(config.ControllerGroupConfig) {
 		if err := controller.AddConfigControllers(
 			mgr,
 			controller.Options{
-				Address: operatorclient.InCluster{},
+				Engage:  providerOpts.ConfigEngageOptions(),
+				Address: address,
+				PKI:     backend,
 			},
 		); err != nil {
 			return err
 		}
 	}
 	if controllerGroups.Has(config.ControllerGroupWorkload) {
-		if err := controller.AddWorkloadControllers(mgr, controller.Options{}); err != nil {
+		if err := controller.AddWorkloadControllers(
+			mgr,
+			controller.Options{
+				Engage:  providerOpts.WorkloadEngageOptions(),
+				Address: address,
+			},
+		); err != nil {
+			return err
+		}
+	}
+	if enableWebhooks {
+		if err := operatorwebhook.AddWebhooks(mgr.GetLocalManager()); err != nil {
 			return err
 		}
 	}
@@ -215,7 +305,7 @@ func run(ctx context.Context) error {
 
 	metrics.RegisterMetrics()
 
-	metricsCollector := metrics.NewMetricsCollector(mgr.GetLocalManager().GetClient())
+	metricsCollector := metrics.NewMetricsCollector(mgr.GetLocalManager().GetClient(), backend)
 	go metricsCollector.Start(ctx)
 
 	if err := mgr.AddHealthzCheck("healthz", healthz.Ping); err != nil {
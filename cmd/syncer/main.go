@@ -0,0 +1,201 @@
+/*
+Copyright 2026 The kcp Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Command syncer copies Compiled* resources and the Secrets and ConfigMaps they mount from the
+// config cluster into a workload cluster and syncs their status back. It is needed whenever the
+// config and workload controller groups of the kcp-operator run in different clusters.
+package main
+
+import (
+	"context"
+	"crypto/sha256"
+	"flag"
+	"fmt"
+	"os"
+
+	"github.com/go-logr/zapr"
+	"github.com/spf13/pflag"
+	"go.uber.org/zap/zapcore"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	_ "k8s.io/client-go/plugin/pkg/client/auth"
+	"k8s.io/client-go/tools/clientcmd"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/cache"
+	ctrlruntimeclient "sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/cluster"
+	"sigs.k8s.io/controller-runtime/pkg/healthz"
+	"sigs.k8s.io/controller-runtime/pkg/log/zap"
+	metricsserver "sigs.k8s.io/controller-runtime/pkg/metrics/server"
+
+	"github.com/kcp-dev/kcp-operator/pkg/syncer"
+	deployv1alpha1 "github.com/kcp-dev/kcp-operator/sdk/apis/deploy/v1alpha1"
+)
+
+var (
+	scheme   = runtime.NewScheme()
+	setupLog = ctrl.Log.WithName("setup")
+)
+
+func init() {
+	utilruntime.Must(clientgoscheme.AddToScheme(scheme))
+	utilruntime.Must(deployv1alpha1.AddToScheme(scheme))
+}
+
+func main() {
+	ctx := ctrl.SetupSignalHandler()
+	if err := run(ctx); err != nil {
+		setupLog.Error(err, "problem running syncer")
+		os.Exit(1)
+	}
+}
+
+func run(ctx context.Context) error {
+	var (
+		configKubeconfig, workloadKubeconfig, namespacePrefix, clusterName string
+		metricsAddr, probeAddr, leaderElectionNamespace                    string
+		enableLeaderElection                                               bool
+	)
+
+	fs := pflag.NewFlagSet("kcp-operator-syncer", pflag.ExitOnError)
+
+	fs.StringVar(&configKubeconfig, "config-kubeconfig", "",
+		"Path to the kubeconfig for the config cluster. If empty, the in-cluster configuration is used.")
+	fs.StringVar(&workloadKubeconfig, "workload-kubeconfig", "", "Path to the kubeconfig for the workload cluster.")
+	fs.StringVar(&namespacePrefix, "namespace-prefix", "", "Only sync namespaces with this prefix.")
+	fs.StringVar(&clusterName, "cluster-name", "",
+		"The name of the workload cluster. Only components placed into this cluster are synced; if empty, only components without a placement are synced.")
+	fs.StringVar(&metricsAddr, "metrics-bind-address", ":8080", "The address the metrics endpoint binds to, or 0 to disable it.")
+	fs.StringVar(&probeAddr, "health-probe-bind-address", ":8081", "The address the probe endpoint binds to.")
+	fs.BoolVar(&enableLeaderElection, "leader-elect", false,
+		"Enable leader election in the config cluster, ensuring there is only one active syncer per workload cluster.")
+	fs.StringVar(&leaderElectionNamespace, "leader-election-namespace", "",
+		"The namespace for the leader election lease. Defaults to the namespace the syncer runs in.")
+
+	opts := zap.Options{
+		Development:     true,
+		StacktraceLevel: zapcore.PanicLevel,
+	}
+	opts.BindFlags(flag.CommandLine)
+	fs.AddGoFlagSet(flag.CommandLine)
+
+	if err := fs.Parse(os.Args[1:]); err != nil {
+		return fmt.Errorf("failed to parse flags: %w", err)
+	}
+
+	if workloadKubeconfig == "" {
+		return fmt.Errorf("--workload-kubeconfig must be set")
+	}
+
+	ctrl.SetLogger(zapr.NewLogger(zap.NewRaw(zap.UseFlagOptions(&opts))))
+
+	configRestConfig, err := ctrl.GetConfig()
+	if configKubeconfig != "" {
+		configRestConfig, err = clientcmd.BuildConfigFromFlags("", configKubeconfig)
+	}
+	if err != nil {
+		return fmt.Errorf("failed to load config cluster kubeconfig: %w", err)
+	}
+
+	workloadRestConfig, err := clientcmd.BuildConfigFromFlags("", workloadKubeconfig)
+	if err != nil {
+		return fmt.Errorf("failed to load workload cluster kubeconfig: %w", err)
+	}
+
+	// Syncers for different workload clusters run side by side in the config cluster, so each
+	// workload cluster needs its own lease. Without a cluster name, the API server identifies it.
+	workloadID := clusterName
+	if workloadID == "" {
+		workloadID = fmt.Sprintf("%x", sha256.Sum256([]byte(workloadRestConfig.Host)))[:16]
+	}
+	leaderElectionID := workloadID + ".syncer.operator.kcp.io"
+
+	mgr, err := ctrl.NewManager(configRestConfig, ctrl.Options{
+		Scheme: scheme,
+		// Secrets and ConfigMaps are read using the label selectors of the components being
+		// synced, and only watched in the source caches below.
+		Client: ctrlruntimeclient.Options{
+			Cache: &ctrlruntimeclient.CacheOptions{
+				DisableFor: []ctrlruntimeclient.Object{&corev1.Secret{}, &corev1.ConfigMap{}},
+			},
+		},
+		Metrics:                 metricsserver.Options{BindAddress: metricsAddr},
+		HealthProbeBindAddress:  probeAddr,
+		LeaderElection:          enableLeaderElection,
+		LeaderElectionID:        leaderElectionID,
+		LeaderElectionNamespace: leaderElectionNamespace,
+	})
+	if err != nil {
+		return fmt.Errorf("unable to create manager: %w", err)
+	}
+
+	sources, err := syncer.NewSourceCaches(configRestConfig, scheme)
+	if err != nil {
+		return fmt.Errorf("unable to create config cluster caches: %w", err)
+	}
+
+	for _, source := range sources {
+		if err := mgr.Add(source); err != nil {
+			return fmt.Errorf("unable to add config cluster cache: %w", err)
+		}
+	}
+
+	// Only cache what the syncer created in the workload cluster.
+	synced := labels.SelectorFromSet(labels.Set{syncer.SyncedLabel: "true"})
+
+	workload, err := cluster.New(workloadRestConfig, func(o *cluster.Options) {
+		o.Scheme = scheme
+		o.Cache.ByObject = map[ctrlruntimeclient.Object]cache.ByObject{
+			&corev1.Secret{}:    {Label: synced},
+			&corev1.ConfigMap{}: {Label: synced},
+		}
+	})
+	if err != nil {
+		return fmt.Errorf("unable to create workload cluster: %w", err)
+	}
+
+	if err := mgr.Add(workload); err != nil {
+		return fmt.Errorf("unable to add workload cluster: %w", err)
+	}
+
+	for _, kind := range syncer.Kinds {
+		if err := (&syncer.Reconciler{
+			Kind:            kind,
+			Config:          mgr.GetClient(),
+			Workload:        workload.GetClient(),
+			NamespacePrefix: namespacePrefix,
+			ClusterName:     clusterName,
+		}).SetupWithManager(mgr, workload, sources); err != nil {
+			return fmt.Errorf("unable to create syncer for %s: %w", kind.Name, err)
+		}
+	}
+
+	syncer.RegisterMetrics()
+
+	if err := mgr.AddHealthzCheck("healthz", healthz.Ping); err != nil {
+		return fmt.Errorf("unable to set up health check: %w", err)
+	}
+	if err := mgr.AddReadyzCheck("readyz", healthz.Ping); err != nil {
+		return fmt.Errorf("unable to set up ready check: %w", err)
+	}
+
+	setupLog.Info("starting syncer")
+	return mgr.Start(ctx)
+}
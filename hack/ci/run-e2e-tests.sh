@@ -189,11 +189,11 @@ if [[ "$E2E_TOPOLOGY" == config-workload ]]; then
   KUBECONFIG="$WORKLOAD_KUBECONFIG" "$KUBECTL" --namespace kcp-operator-system wait deployment kcp-operator-controller-manager --for condition=Available
   KUBECONFIG="$WORKLOAD_KUBECONFIG" "$KUBECTL" --namespace kcp-operator-system wait pod --all --for condition=Ready
 
-  # the syncer plays the role of an external GitOps tool, copying compiled
-  # resources and secrets from the config to the workload cluster
+  # the syncer copies compiled resources and secrets from the config to the
+  # workload cluster
   echo "Starting e2e syncer..."
-  go build -o "$ARTIFACTS/e2e-syncer" ./test/syncer
-  "$ARTIFACTS/e2e-syncer" --config-kubeconfig "$KUBECONFIG" --workload-kubeconfig "$WORKLOAD_KUBECONFIG" >"$ARTIFACTS/syncer.log" 2>&1 &
+  go build -o "$ARTIFACTS/e2e-syncer" ./cmd/syncer
+  "$ARTIFACTS/e2e-syncer" --config-kubeconfig "$KUBECONFIG" --workload-kubeconfig "$WORKLOAD_KUBECONFIG" --namespace-prefix e2e- --metrics-bind-address 0 --health-probe-bind-address 0 >"$ARTIFACTS/syncer.log" 2>&1 &
 else
   echo "Deploying operator..."
   "$KUBECTL" kustomize hack/ci/testdata/single | "$KUBECTL" apply --server-side --filename -
@@ -237,8 +237,8 @@ if [[ "$E2E_TOPOLOGY" == config-workload ]]; then
   "$KUSTOMIZE" build hack/ci/testdata/workload | KUBECONFIG="$WORKLOAD_KUBECONFIG" "$KUBECTL" apply --server-side --filename -
 
   echo "Building and starting e2e syncer..."
-  go build -o "$DATA_DIR/e2e-syncer" ./test/syncer
-  "$DATA_DIR/e2e-syncer" --config-kubeconfig "$KUBECONFIG" --workload-kubeconfig "$WORKLOAD_KUBECONFIG" >"$DATA_DIR/syncer.log" 2>&1 &
+  go build -o "$DATA_DIR/e2e-syncer" ./cmd/syncer
+  "$DATA_DIR/e2e-syncer" --config-kubeconfig "$KUBECONFIG" --workload-kubeconfig "$WORKLOAD_KUBECONFIG" --namespace-prefix e2e- --metrics-bind-address 0 --health-probe-bind-address 0 >"$DATA_DIR/syncer.log" 2>&1 &
   SYNCER_PID=$!
 else
   echo "Deploying kcp-operator..."
@@ -38,6 +38,7 @@ func RootCACertificateReconciler(server *operatorv1alpha1.CacheServer) reconcili
 	return func() (string, reconciling.CertificateReconciler) {
 		return name, func(cert *certmanagerv1.Certificate) (*certmanagerv1.Certificate, error) {
 			cert.SetLabels(resources.GetCacheServerResourceLabels(server))
+			utils.SetCAKind(cert, operatorv1alpha1.RootCA)
 
 			cert.Spec = certmanagerv1.CertificateSpec{
 				IsCA:       true,
@@ -77,6 +78,7 @@ func ClientCertificateReconciler(server *operatorv1alpha1.CacheServer) reconcili
 	return func() (string, reconciling.CertificateReconciler) {
 		return name, func(cert *certmanagerv1.Certificate) (*certmanagerv1.Certificate, error) {
 			cert.SetLabels(resources.GetCacheServerResourceLabels(server))
+			utils.SetCertificateKind(cert, operatorv1alpha1.ClientCertificate)
 			cert.Spec = certmanagerv1.CertificateSpec{
 				SecretName: name,
 				SecretTemplate: &certmanagerv1.CertificateSecretTemplate{
@@ -126,6 +128,7 @@ func ServerCertificateReconciler(server *operatorv1alpha1.CacheServer) reconcili
 	return func() (string, reconciling.CertificateReconciler) {
 		return name, func(cert *certmanagerv1.Certificate) (*certmanagerv1.Certificate, error) {
 			cert.SetLabels(resources.GetCacheServerResourceLabels(server))
+			utils.SetCertificateKind(cert, certKind)
 			cert.Spec = certmanagerv1.CertificateSpec{
 				SecretName: name,
 				SecretTemplate: &certmanagerv1.CertificateSecretTemplate{
@@ -45,6 +45,7 @@ func CompiledCacheServerReconciler(server *operatorv1alpha1.CacheServer, revisio
 				obj.Labels = make(map[string]string)
 			}
 			obj.Labels[resources.CacheServerLabel] = server.Name
+			resources.SetPlacementLabel(obj.Labels, server.Spec.Placement)
 
 			obj.Spec.CacheServer = server.Spec
 
@@ -61,9 +61,9 @@ func (r *reconciler) pathMappingConfigMapReconciler() reconciling.NamedConfigMap
 func (r *reconciler) defaultPathMappings() []operatorv1alpha1.PathMappingEntry {
 	url := r.rootShardBaseURL()
 
-	// Determine CA path based on CABundleSecretRef
+	// Determine CA path based on the configured CA bundle
 	backendCA := kcpBasepath + "/tls/ca/tls.crt"
-	if r.getCABundleSecretRef() != nil {
+	if r.hasCABundle() {
 		backendCA = getCAMountPath(operatorv1alpha1.CABundleCA) + "/tls.crt"
 	}
 
@@ -34,9 +34,23 @@ import (
 	operatorv1alpha1 "github.com/kcp-dev/kcp-operator/sdk/apis/operator/v1alpha1"
 )
 
+const (
+	ServerContainerName = "kcp-front-proxy"
+)
+
+// autoscaledAnnotation marks a Deployment whose replica count is controlled by a
+// HorizontalPodAutoscaler, so that it can be reset once autoscaling is disabled again.
+const autoscaledAnnotation = "operator.kcp.io/autoscaled"
+
+func (r *reconciler) deploymentName() string {
+	if r.frontProxy != nil {
+		return resources.GetCompiledFrontProxyDeploymentName(r.frontProxy)
+	}
+	return resources.GetCompiledRootShardProxyDeploymentName(r.rootShard)
+}
+
 func (r *reconciler) deploymentReconciler() reconciling.NamedDeploymentReconcilerFactory {
 	var (
-		name              string
 		imageSpec         *operatorv1alpha1.ImageSpec
 		depResources      *corev1.ResourceRequirements
 		template          *operatorv1alpha1.DeploymentTemplate
@@ -46,7 +60,6 @@ func (r *reconciler) deploymentReconciler() reconciling.NamedDeploymentReconcile
 	)
 
 	if r.frontProxy != nil {
-		name = resources.GetCompiledFrontProxyDeploymentName(r.frontProxy)
 		imageSpec = r.frontProxy.Spec.FrontProxy.Image
 		depResources = r.frontProxy.Spec.FrontProxy.Resources
 		template = r.frontProxy.Spec.FrontProxy.DeploymentTemplate
@@ -54,8 +67,6 @@ func (r *reconciler) deploymentReconciler() reconciling.NamedDeploymentReconcile
 		extraVolumes = r.frontProxy.Spec.FrontProxy.ExtraVolumes
 		extraVolumeMounts = r.frontProxy.Spec.FrontProxy.ExtraVolumeMounts
 	} else {
-		name = resources.GetCompiledRootShardProxyDeploymentName(r.rootShard)
-
 		if proxy := r.rootShardSpec().Proxy; proxy != nil {
 			imageSpec = proxy.Image
 			depResources = proxy.Resources
@@ -66,8 +77,10 @@ func (r *reconciler) deploymentReconciler() reconciling.NamedDeploymentReconcile
 		}
 	}
 
+	autoscaling := r.autoscaling()
+
 	return func() (string, reconciling.DeploymentReconciler) {
-		return name, func(dep *appsv1.Deployment) (*appsv1.Deployment, error) {
+		return r.deploymentName(), func(dep *appsv1.Deployment) (*appsv1.Deployment, error) {
 			// Only set the selector on creation, as it's immutable
 			if dep.Spec.Selector == nil {
 				dep.Spec.Selector = &metav1.LabelSelector{
@@ -80,7 +93,7 @@ func (r *reconciler) deploymentReconciler() reconciling.NamedDeploymentReconcile
 			args := r.getArgs(version)
 
 			container := corev1.Container{
-				Name:    "kcp-front-proxy",
+				Name:    ServerContainerName,
 				Image:   image,
 				Command: []string{"/kcp-front-proxy"},
 				Args:    args,
@@ -184,9 +197,9 @@ func (r *reconciler) deploymentReconciler() reconciling.NamedDeploymentReconcile
 				mountSecret(r.rootShardCAName(operatorv1alpha1.RequestHeaderClientCA), getCAMountPath(operatorv1alpha1.RequestHeaderClientCA), true)
 			}
 
-			// If caBundleSecretRef is specified, mount the merged CA bundle secret.
+			// If a CA bundle is specified, mount the merged CA bundle secret.
 			// This secret contains both kcp root CA and user-provided CA bundle merged together.
-			if r.getCABundleSecretRef() != nil {
+			if r.hasCABundle() {
 				mountSecret(r.backendCABundleSecretName(), getCAMountPath(operatorv1alpha1.CABundleCA), true)
 			}
 
@@ -217,12 +230,29 @@ func (r *reconciler) deploymentReconciler() reconciling.NamedDeploymentReconcile
 			container.VolumeMounts = volumeMounts
 			dep.Spec.Template.Spec.Volumes = volumes
 
-			if replicas != nil {
+			_, autoscaled := dep.Annotations[autoscaledAnnotation]
+
+			switch {
+			case autoscaling != nil:
+				// The HorizontalPodAutoscaler owns the replica count, so only provide a starting point.
+				if dep.Spec.Replicas == nil {
+					dep.Spec.Replicas = ptr.To(minReplicas(autoscaling))
+				}
+				if dep.Annotations == nil {
+					dep.Annotations = map[string]string{}
+				}
+				dep.Annotations[autoscaledAnnotation] = "true"
+			case replicas != nil:
 				dep.Spec.Replicas = replicas
-			} else if dep.Spec.Replicas == nil {
+			case dep.Spec.Replicas == nil || autoscaled:
+				// Do not keep the replica count last chosen by a removed HorizontalPodAutoscaler.
 				dep.Spec.Replicas = ptr.To[int32](2)
 			}
 
+			if autoscaling == nil {
+				delete(dep.Annotations, autoscaledAnnotation)
+			}
+
 			dep.Spec.Template.Spec.Containers = []corev1.Container{
 				utils.ApplyResources(container, depResources),
 			}
@@ -0,0 +1,88 @@
+/*
+Copyright 2026 The kcp Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package compiledfrontproxy
+
+import (
+	autoscalingv2 "k8s.io/api/autoscaling/v2"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/utils/ptr"
+
+	"github.com/kcp-dev/kcp-operator/pkg/reconciling"
+	operatorv1alpha1 "github.com/kcp-dev/kcp-operator/sdk/apis/operator/v1alpha1"
+)
+
+const defaultTargetCPUUtilizationPercentage = 80
+
+// minReplicas returns the lower replica limit, defaulting to 2 unless that exceeds the upper limit.
+func minReplicas(autoscaling *operatorv1alpha1.AutoscalingSpec) int32 {
+	return ptr.Deref(autoscaling.MinReplicas, min(2, autoscaling.MaxReplicas))
+}
+
+func (r *reconciler) horizontalPodAutoscalerReconciler(autoscaling *operatorv1alpha1.AutoscalingSpec) reconciling.NamedHorizontalPodAutoscalerReconcilerFactory {
+	name := r.deploymentName()
+
+	return func() (string, reconciling.HorizontalPodAutoscalerReconciler) {
+		return name, func(hpa *autoscalingv2.HorizontalPodAutoscaler) (*autoscalingv2.HorizontalPodAutoscaler, error) {
+			hpa.SetLabels(r.resourceLabels)
+
+			hpa.Spec.ScaleTargetRef = autoscalingv2.CrossVersionObjectReference{
+				APIVersion: "apps/v1",
+				Kind:       "Deployment",
+				Name:       name,
+			}
+			hpa.Spec.MinReplicas = ptr.To(minReplicas(autoscaling))
+			hpa.Spec.MaxReplicas = autoscaling.MaxReplicas
+			hpa.Spec.Metrics = autoscalingMetrics(autoscaling)
+			hpa.Spec.Behavior = autoscaling.Behavior
+
+			return hpa, nil
+		}
+	}
+}
+
+func autoscalingMetrics(autoscaling *operatorv1alpha1.AutoscalingSpec) []autoscalingv2.MetricSpec {
+	var metrics []autoscalingv2.MetricSpec
+
+	if target := autoscaling.TargetCPUUtilizationPercentage; target != nil {
+		metrics = append(metrics, resourceUtilizationMetric(corev1.ResourceCPU, *target))
+	}
+
+	if target := autoscaling.TargetMemoryUtilizationPercentage; target != nil {
+		metrics = append(metrics, resourceUtilizationMetric(corev1.ResourceMemory, *target))
+	}
+
+	metrics = append(metrics, autoscaling.Metrics...)
+
+	if len(metrics) == 0 {
+		metrics = append(metrics, resourceUtilizationMetric(corev1.ResourceCPU, defaultTargetCPUUtilizationPercentage))
+	}
+
+	return metrics
+}
+
+func resourceUtilizationMetric(resource corev1.ResourceName, target int32) autoscalingv2.MetricSpec {
+	return autoscalingv2.MetricSpec{
+		Type: autoscalingv2.ResourceMetricSourceType,
+		Resource: &autoscalingv2.ResourceMetricSource{
+			Name: resource,
+			Target: autoscalingv2.MetricTarget{
+				Type:               autoscalingv2.UtilizationMetricType,
+				AverageUtilization: ptr.To(target),
+			},
+		},
+	}
+}
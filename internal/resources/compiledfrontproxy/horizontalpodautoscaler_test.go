@@ -0,0 +1,191 @@
+/*
+Copyright 2026 The kcp Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package compiledfrontproxy
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	appsv1 "k8s.io/api/apps/v1"
+	autoscalingv2 "k8s.io/api/autoscaling/v2"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+
+	deployv1alpha1 "github.com/kcp-dev/kcp-operator/sdk/apis/deploy/v1alpha1"
+	operatorv1alpha1 "github.com/kcp-dev/kcp-operator/sdk/apis/operator/v1alpha1"
+)
+
+func TestAutoscalingMetrics(t *testing.T) {
+	customMetric := autoscalingv2.MetricSpec{
+		Type: autoscalingv2.PodsMetricSourceType,
+		Pods: &autoscalingv2.PodsMetricSource{
+			Metric: autoscalingv2.MetricIdentifier{Name: "requests_per_second"},
+			Target: autoscalingv2.MetricTarget{Type: autoscalingv2.AverageValueMetricType},
+		},
+	}
+
+	tests := []struct {
+		name        string
+		autoscaling operatorv1alpha1.AutoscalingSpec
+		expected    []autoscalingv2.MetricSpec
+	}{
+		{
+			name:        "defaults to CPU utilization",
+			autoscaling: operatorv1alpha1.AutoscalingSpec{MaxReplicas: 5},
+			expected: []autoscalingv2.MetricSpec{
+				resourceUtilizationMetric(corev1.ResourceCPU, defaultTargetCPUUtilizationPercentage),
+			},
+		},
+		{
+			name: "CPU and memory targets",
+			autoscaling: operatorv1alpha1.AutoscalingSpec{
+				MaxReplicas:                       5,
+				TargetCPUUtilizationPercentage:    ptr.To[int32](60),
+				TargetMemoryUtilizationPercentage: ptr.To[int32](75),
+			},
+			expected: []autoscalingv2.MetricSpec{
+				resourceUtilizationMetric(corev1.ResourceCPU, 60),
+				resourceUtilizationMetric(corev1.ResourceMemory, 75),
+			},
+		},
+		{
+			name: "custom metrics only do not add a CPU target",
+			autoscaling: operatorv1alpha1.AutoscalingSpec{
+				MaxReplicas: 5,
+				Metrics:     []autoscalingv2.MetricSpec{customMetric},
+			},
+			expected: []autoscalingv2.MetricSpec{customMetric},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, autoscalingMetrics(&tt.autoscaling))
+		})
+	}
+}
+
+func TestAutoscaledDeployment(t *testing.T) {
+	frontProxy := &deployv1alpha1.CompiledFrontProxy{
+		ObjectMeta: metav1.ObjectMeta{Name: "test-front-proxy"},
+		Spec: deployv1alpha1.CompiledFrontProxySpec{
+			FrontProxy: operatorv1alpha1.FrontProxySpec{
+				Autoscaling: &operatorv1alpha1.AutoscalingSpec{
+					MinReplicas: ptr.To[int32](3),
+					MaxReplicas: 10,
+				},
+			},
+			RootShard: deployv1alpha1.NamedRootShardSpec{
+				Name: "test-root-shard",
+			},
+		},
+	}
+
+	r := NewFrontProxy(frontProxy)
+
+	name, hpaReconciler := r.horizontalPodAutoscalerReconciler(frontProxy.Spec.FrontProxy.Autoscaling)()
+	hpa, err := hpaReconciler(&autoscalingv2.HorizontalPodAutoscaler{})
+	require.NoError(t, err)
+	assert.Equal(t, "test-front-proxy-front-proxy", name)
+	assert.Equal(t, name, hpa.Spec.ScaleTargetRef.Name)
+	assert.Equal(t, ptr.To[int32](3), hpa.Spec.MinReplicas)
+	assert.Equal(t, int32(10), hpa.Spec.MaxReplicas)
+
+	_, depReconciler := r.deploymentReconciler()()
+
+	// a new Deployment starts with the minimum number of replicas
+	dep, err := depReconciler(&appsv1.Deployment{})
+	require.NoError(t, err)
+	assert.Equal(t, ptr.To[int32](3), dep.Spec.Replicas)
+
+	// afterwards, the replica count is left to the HorizontalPodAutoscaler
+	dep.Spec.Replicas = ptr.To[int32](7)
+	dep, err = depReconciler(dep)
+	require.NoError(t, err)
+	assert.Equal(t, ptr.To[int32](7), dep.Spec.Replicas)
+
+	// the PodDisruptionBudget is sized for the largest number of replicas
+	assert.Equal(t, int32(10), r.replicas())
+}
+
+func TestDeploymentAfterAutoscalingRemoved(t *testing.T) {
+	frontProxy := &deployv1alpha1.CompiledFrontProxy{
+		ObjectMeta: metav1.ObjectMeta{Name: "test-front-proxy"},
+		Spec: deployv1alpha1.CompiledFrontProxySpec{
+			FrontProxy: operatorv1alpha1.FrontProxySpec{
+				Autoscaling: &operatorv1alpha1.AutoscalingSpec{
+					MaxReplicas: 10,
+				},
+			},
+			RootShard: deployv1alpha1.NamedRootShardSpec{
+				Name: "test-root-shard",
+			},
+		},
+	}
+
+	_, depReconciler := NewFrontProxy(frontProxy).deploymentReconciler()()
+	autoscaled, err := depReconciler(&appsv1.Deployment{})
+	require.NoError(t, err)
+
+	// the HorizontalPodAutoscaler scales the Deployment up before it is removed
+	autoscaled.Spec.Replicas = ptr.To[int32](7)
+
+	frontProxy.Spec.FrontProxy.Autoscaling = nil
+
+	_, depReconciler = NewFrontProxy(frontProxy).deploymentReconciler()()
+	dep, err := depReconciler(autoscaled.DeepCopy())
+	require.NoError(t, err)
+	assert.Equal(t, ptr.To[int32](2), dep.Spec.Replicas)
+	assert.NotContains(t, dep.Annotations, autoscaledAnnotation)
+
+	// afterwards, the replica count can be changed manually again
+	dep.Spec.Replicas = ptr.To[int32](5)
+	dep, err = depReconciler(dep)
+	require.NoError(t, err)
+	assert.Equal(t, ptr.To[int32](5), dep.Spec.Replicas)
+
+	// configured replicas take precedence over the autoscaler's last choice as well
+	frontProxy.Spec.FrontProxy.Replicas = ptr.To[int32](4)
+
+	_, depReconciler = NewFrontProxy(frontProxy).deploymentReconciler()()
+	dep, err = depReconciler(autoscaled.DeepCopy())
+	require.NoError(t, err)
+	assert.Equal(t, ptr.To[int32](4), dep.Spec.Replicas)
+}
+
+func TestDeploymentKeepsManualReplicas(t *testing.T) {
+	frontProxy := &deployv1alpha1.CompiledFrontProxy{
+		ObjectMeta: metav1.ObjectMeta{Name: "test-front-proxy"},
+		Spec: deployv1alpha1.CompiledFrontProxySpec{
+			RootShard: deployv1alpha1.NamedRootShardSpec{
+				Name: "test-root-shard",
+			},
+		},
+	}
+
+	// the Deployment was scaled manually and no replicas are configured
+	existing := &appsv1.Deployment{}
+	existing.Spec.Replicas = ptr.To[int32](5)
+
+	_, depReconciler := NewFrontProxy(frontProxy).deploymentReconciler()()
+	dep, err := depReconciler(existing)
+	require.NoError(t, err)
+	assert.Equal(t, ptr.To[int32](5), dep.Spec.Replicas)
+}
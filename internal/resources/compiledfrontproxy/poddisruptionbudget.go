@@ -0,0 +1,58 @@
+/*
+Copyright 2026 The kcp Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package compiledfrontproxy
+
+import (
+	"k8c.io/reconciler/pkg/reconciling"
+
+	"k8s.io/utils/ptr"
+
+	"github.com/kcp-dev/kcp-operator/internal/resources/utils"
+	operatorv1alpha1 "github.com/kcp-dev/kcp-operator/sdk/apis/operator/v1alpha1"
+)
+
+// replicas returns the number of proxy replicas the PodDisruptionBudget is meant for. When
+// autoscaling, this is the largest number of replicas the proxy can be scaled to.
+func (r *reconciler) replicas() int32 {
+	if autoscaling := r.autoscaling(); autoscaling != nil {
+		return autoscaling.MaxReplicas
+	}
+
+	var replicas *int32
+
+	switch {
+	case r.frontProxy != nil:
+		replicas = r.frontProxy.Spec.FrontProxy.Replicas
+	case r.rootShardSpec().Proxy != nil:
+		replicas = r.rootShardSpec().Proxy.Replicas
+	}
+
+	return ptr.Deref(replicas, 2)
+}
+
+func (r *reconciler) podDisruptionBudgetReconciler() reconciling.NamedPodDisruptionBudgetReconcilerFactory {
+	var tpl *operatorv1alpha1.PodDisruptionBudgetTemplate
+
+	switch {
+	case r.frontProxy != nil:
+		tpl = r.frontProxy.Spec.FrontProxy.PodDisruptionBudget
+	case r.rootShardSpec().Proxy != nil:
+		tpl = r.rootShardSpec().Proxy.PodDisruptionBudget
+	}
+
+	return utils.PodDisruptionBudgetReconciler(r.deploymentName(), r.resourceLabels, tpl)
+}
@@ -23,12 +23,14 @@ import (
 
 	k8creconciling "k8c.io/reconciler/pkg/reconciling"
 
-	corev1 "k8s.io/api/core/v1"
+	autoscalingv2 "k8s.io/api/autoscaling/v2"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	kerrors "k8s.io/apimachinery/pkg/util/errors"
 	ctrlruntimeclient "sigs.k8s.io/controller-runtime/pkg/client"
 
 	"github.com/kcp-dev/kcp-operator/internal/resources"
+	"github.com/kcp-dev/kcp-operator/internal/resources/utils"
+	"github.com/kcp-dev/kcp-operator/pkg/reconciling"
 	"github.com/kcp-dev/kcp-operator/pkg/reconciling/modifier"
 	deployv1alpha1 "github.com/kcp-dev/kcp-operator/sdk/apis/deploy/v1alpha1"
 	operatorv1alpha1 "github.com/kcp-dev/kcp-operator/sdk/apis/operator/v1alpha1"
@@ -82,12 +84,13 @@ func (r *reconciler) rootShardBaseURL() string {
 	return resources.GetCompiledRootShardBaseURL(r.rootShard)
 }
 
-// rootShardCAName returns the name of one of the RootShard's CAs, in either mode.
+// rootShardCAName returns the name of the Secret to trust for one of the RootShard's CAs, in
+// either mode.
 func (r *reconciler) rootShardCAName(caName operatorv1alpha1.CA) string {
 	if r.frontProxy != nil {
-		return resources.GetNamedRootShardCAName(r.frontProxy.Spec.RootShard, caName)
+		return resources.GetNamedRootShardCATrustName(r.frontProxy.Spec.RootShard, caName)
 	}
-	return resources.GetCompiledRootShardCAName(r.rootShard, caName)
+	return resources.GetCompiledRootShardCATrustName(r.rootShard, caName)
 }
 
 // certName returns the name of one of the proxy's own certificates.
@@ -122,25 +125,42 @@ func (r *reconciler) backendCABundleSecretName() string {
 	return fmt.Sprintf("%s-proxy-merged-ca-bundle", r.rootShard.Name)
 }
 
-// getCABundleSecretRef returns the CABundleSecretRef from either the FrontProxy or RootShard spec.
-func (r *reconciler) getCABundleSecretRef() *corev1.LocalObjectReference {
+// hasCABundle returns true if either the FrontProxy or RootShard spec configures a CA bundle.
+func (r *reconciler) hasCABundle() bool {
 	if r.frontProxy != nil {
-		return r.frontProxy.Spec.FrontProxy.CABundleSecretRef
+		return utils.HasCABundle(r.frontProxy.Spec.FrontProxy.CABundleSecretRef, r.frontProxy.Spec.FrontProxy.CABundleConfigMapRef)
 	}
-	return r.rootShardSpec().CABundleSecretRef
+	return utils.HasCABundle(r.rootShardSpec().CABundleSecretRef, r.rootShardSpec().CABundleConfigMapRef)
+}
+
+// autoscaling returns the autoscaling settings of the proxy, if any, in either mode.
+func (r *reconciler) autoscaling() *operatorv1alpha1.AutoscalingSpec {
+	if r.frontProxy != nil {
+		return r.frontProxy.Spec.FrontProxy.Autoscaling
+	}
+	if proxy := r.rootShardSpec().Proxy; proxy != nil {
+		return proxy.Autoscaling
+	}
+	return nil
 }
 
 // +kubebuilder:rbac:groups=core,resources=configmaps;secrets;services,verbs=get;update;patch
 // +kubebuilder:rbac:groups=apps,resources=deployments,verbs=get;update;patch
 // +kubebuilder:rbac:groups=cert-manager.io,resources=certificates,verbs=get;update;patch
+// +kubebuilder:rbac:groups=policy,resources=poddisruptionbudgets,verbs=get;update;patch;delete
 
 func (r *reconciler) Reconcile(ctx context.Context, client ctrlruntimeclient.Client, namespace string) error {
 	var errs []error
 
-	var ref *metav1.OwnerReference
+	var (
+		owner metav1.Object
+		ref   *metav1.OwnerReference
+	)
 	if r.frontProxy != nil {
+		owner = r.frontProxy
 		ref = metav1.NewControllerRef(r.frontProxy, deployv1alpha1.SchemeGroupVersion.WithKind("CompiledFrontProxy"))
 	} else {
+		owner = r.rootShard
 		ref = metav1.NewControllerRef(r.rootShard, deployv1alpha1.SchemeGroupVersion.WithKind("CompiledRootShard"))
 	}
 	ownerRefWrapper := k8creconciling.OwnerRefWrapper(*ref)
@@ -171,7 +191,7 @@ func (r *reconciler) Reconcile(ctx context.Context, client ctrlruntimeclient.Cli
 	}
 
 	// must happen after the Secrets and Certificates have been reconciled, since it can fail as long as those do not exist
-	if err := k8creconciling.ReconcileDeployments(ctx, deploymentReconcilers, namespace, client, ownerRefWrapper, revisionLabels); err != nil {
+	if err := k8creconciling.ReconcileDeployments(ctx, deploymentReconcilers, namespace, client, ownerRefWrapper, revisionLabels, modifier.CopyPublishedAt(owner)); err != nil {
 		// swallow errors and rely on the caller watching Secrets and re-reconciling whenever they change
 		if !errors.Is(err, modifier.ErrMountNotFound) {
 			errs = append(errs, err)
@@ -182,5 +202,19 @@ func (r *reconciler) Reconcile(ctx context.Context, client ctrlruntimeclient.Cli
 		errs = append(errs, err)
 	}
 
+	if autoscaling := r.autoscaling(); autoscaling != nil {
+		if err := reconciling.ReconcileHorizontalPodAutoscalers(ctx, []reconciling.NamedHorizontalPodAutoscalerReconcilerFactory{
+			r.horizontalPodAutoscalerReconciler(autoscaling),
+		}, namespace, client, ownerRefWrapper); err != nil {
+			errs = append(errs, err)
+		}
+	} else if err := utils.DeleteIfExists(ctx, client, namespace, r.deploymentName(), &autoscalingv2.HorizontalPodAutoscaler{}); err != nil {
+		errs = append(errs, err)
+	}
+
+	if err := utils.ReconcilePodDisruptionBudget(ctx, client, namespace, r.replicas(), r.podDisruptionBudgetReconciler(), ownerRefWrapper); err != nil {
+		errs = append(errs, err)
+	}
+
 	return kerrors.NewAggregate(errs)
 }
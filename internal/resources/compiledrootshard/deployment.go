@@ -89,7 +89,7 @@ func DeploymentReconciler(rootShard *deployv1alpha1.CompiledRootShard) reconcili
 
 			secretMounts := []utils.SecretMount{{
 				VolumeName: "kcp-ca",
-				SecretName: resources.GetCompiledRootShardCAName(rootShard, operatorv1alpha1.RootCA),
+				SecretName: resources.GetCompiledRootShardCATrustName(rootShard, operatorv1alpha1.RootCA),
 				MountPath:  getCAMountPath(operatorv1alpha1.RootCA),
 			}}
 
@@ -115,7 +115,7 @@ func DeploymentReconciler(rootShard *deployv1alpha1.CompiledRootShard) reconcili
 			} {
 				secretMounts = append(secretMounts, utils.SecretMount{
 					VolumeName: fmt.Sprintf("%s-ca", ca),
-					SecretName: resources.GetCompiledRootShardCAName(rootShard, ca),
+					SecretName: resources.GetCompiledRootShardCATrustName(rootShard, ca),
 					MountPath:  getCAMountPath(ca),
 				})
 			}
@@ -130,7 +130,7 @@ func DeploymentReconciler(rootShard *deployv1alpha1.CompiledRootShard) reconcili
 			} else {
 				secretMounts = append(secretMounts, utils.SecretMount{
 					VolumeName: fmt.Sprintf("%s-ca", operatorv1alpha1.ClientCA),
-					SecretName: resources.GetCompiledRootShardCAName(rootShard, operatorv1alpha1.ClientCA),
+					SecretName: resources.GetCompiledRootShardCATrustName(rootShard, operatorv1alpha1.ClientCA),
 					MountPath:  getCAMountPath(operatorv1alpha1.ClientCA),
 				})
 			}
@@ -153,7 +153,7 @@ func DeploymentReconciler(rootShard *deployv1alpha1.CompiledRootShard) reconcili
 			// This secret contains both ServerCA and user-provided CA bundle merged together.
 			// It will not be used for the API server itself, but only for the "external-logical-cluster-admin-kubeconfig" kubeconfig.
 			// See the comment in the RootShard spec for more details.
-			if rootShard.Spec.RootShard.CABundleSecretRef != nil {
+			if utils.HasCABundle(rootShard.Spec.RootShard.CABundleSecretRef, rootShard.Spec.RootShard.CABundleConfigMapRef) {
 				secretMounts = append(secretMounts, utils.SecretMount{
 					VolumeName: "ca-bundle",
 					SecretName: fmt.Sprintf("%s-merged-ca-bundle", rootShard.Name),
@@ -202,9 +202,10 @@ func DeploymentReconciler(rootShard *deployv1alpha1.CompiledRootShard) reconcili
 			dep.Spec.Template.Spec.Volumes = volumes
 
 			dep = utils.ApplyCommonShardDeploymentProperties(dep)
-			dep = utils.ApplyCommonShardConfig(dep, &rootShard.Spec.RootShard.CommonShardSpec)
+			dep = utils.ApplyCommonShardConfig(dep, rootShard.Name, &rootShard.Spec.RootShard.CommonShardSpec)
 			dep = utils.ApplyDeploymentTemplate(dep, rootShard.Spec.RootShard.DeploymentTemplate)
 			dep = utils.ApplyAuthConfiguration(dep, rootShard.Spec.RootShard.Auth, rootShard.Name, rootShard.Spec.Shards)
+			dep = utils.ApplyEtcdRestoreState(dep, resources.IsEtcdRestoreInProgress(rootShard.Annotations))
 
 			return dep, nil
 		}
@@ -102,7 +102,7 @@ func DeploymentReconciler(shard *deployv1alpha1.CompiledShard) reconciling.Named
 
 			secretMounts := []utils.SecretMount{{
 				VolumeName: "kcp-ca",
-				SecretName: resources.GetNamedRootShardCAName(shard.Spec.RootShard, operatorv1alpha1.RootCA),
+				SecretName: resources.GetNamedRootShardCATrustName(shard.Spec.RootShard, operatorv1alpha1.RootCA),
 				MountPath:  getCAMountPath(operatorv1alpha1.RootCA),
 			}}
 
@@ -131,7 +131,7 @@ func DeploymentReconciler(shard *deployv1alpha1.CompiledShard) reconciling.Named
 			} {
 				secretMounts = append(secretMounts, utils.SecretMount{
 					VolumeName: fmt.Sprintf("%s-ca", ca),
-					SecretName: resources.GetNamedRootShardCAName(shard.Spec.RootShard, ca),
+					SecretName: resources.GetNamedRootShardCATrustName(shard.Spec.RootShard, ca),
 					MountPath:  getCAMountPath(ca),
 				})
 			}
@@ -146,7 +146,7 @@ func DeploymentReconciler(shard *deployv1alpha1.CompiledShard) reconciling.Named
 			} else {
 				secretMounts = append(secretMounts, utils.SecretMount{
 					VolumeName: fmt.Sprintf("%s-ca", operatorv1alpha1.ClientCA),
-					SecretName: resources.GetNamedRootShardCAName(shard.Spec.RootShard, operatorv1alpha1.ClientCA),
+					SecretName: resources.GetNamedRootShardCATrustName(shard.Spec.RootShard, operatorv1alpha1.ClientCA),
 					MountPath:  getCAMountPath(operatorv1alpha1.ClientCA),
 				})
 			}
@@ -175,7 +175,7 @@ func DeploymentReconciler(shard *deployv1alpha1.CompiledShard) reconciling.Named
 			// This secret contains both ServerCA and user-provided CA bundle merged together.
 			// It will not be used for the API server itself, but only for the "external-logical-cluster-admin-kubeconfig" kubeconfig.
 			// See the comment in the RootShard spec for more details.
-			if shard.Spec.Shard.CABundleSecretRef != nil {
+			if utils.HasCABundle(shard.Spec.Shard.CABundleSecretRef, shard.Spec.Shard.CABundleConfigMapRef) {
 				secretMounts = append(secretMounts, utils.SecretMount{
 					VolumeName: "ca-bundle",
 					SecretName: fmt.Sprintf("%s-merged-ca-bundle", shard.Name),
@@ -222,9 +222,10 @@ func DeploymentReconciler(shard *deployv1alpha1.CompiledShard) reconciling.Named
 			dep.Spec.Template.Spec.Volumes = volumes
 
 			dep = utils.ApplyCommonShardDeploymentProperties(dep)
-			dep = utils.ApplyCommonShardConfig(dep, &shard.Spec.Shard.CommonShardSpec)
+			dep = utils.ApplyCommonShardConfig(dep, shard.Name, &shard.Spec.Shard.CommonShardSpec)
 			dep = utils.ApplyDeploymentTemplate(dep, shard.Spec.Shard.DeploymentTemplate)
 			dep = utils.ApplyAuthConfiguration(dep, shard.Spec.Shard.Auth, shard.Spec.RootShard.Name, shard.Spec.Shards)
+			dep = utils.ApplyEtcdRestoreState(dep, resources.IsEtcdRestoreInProgress(shard.Annotations))
 
 			return dep, nil
 		}
@@ -127,7 +127,7 @@ func DeploymentReconciler(vw *deployv1alpha1.CompiledVirtualWorkspace) reconcili
 
 			secretMounts := []utils.SecretMount{{
 				VolumeName: "kcp-ca",
-				SecretName: resources.GetNamedRootShardCAName(vw.Spec.RootShard, operatorv1alpha1.RootCA),
+				SecretName: resources.GetNamedRootShardCATrustName(vw.Spec.RootShard, operatorv1alpha1.RootCA),
 				MountPath:  getCAMountPath(operatorv1alpha1.RootCA),
 			}}
 
@@ -140,7 +140,7 @@ func DeploymentReconciler(vw *deployv1alpha1.CompiledVirtualWorkspace) reconcili
 			} {
 				secretMounts = append(secretMounts, utils.SecretMount{
 					VolumeName: fmt.Sprintf("%s-ca", ca),
-					SecretName: resources.GetNamedRootShardCAName(vw.Spec.RootShard, ca),
+					SecretName: resources.GetNamedRootShardCATrustName(vw.Spec.RootShard, ca),
 					MountPath:  getCAMountPath(ca),
 				})
 			}
@@ -155,7 +155,7 @@ func DeploymentReconciler(vw *deployv1alpha1.CompiledVirtualWorkspace) reconcili
 			} else {
 				secretMounts = append(secretMounts, utils.SecretMount{
 					VolumeName: fmt.Sprintf("%s-ca", operatorv1alpha1.ClientCA),
-					SecretName: resources.GetNamedRootShardCAName(vw.Spec.RootShard, operatorv1alpha1.ClientCA),
+					SecretName: resources.GetNamedRootShardCATrustName(vw.Spec.RootShard, operatorv1alpha1.ClientCA),
 					MountPath:  getCAMountPath(operatorv1alpha1.ClientCA),
 				})
 			}
@@ -0,0 +1,33 @@
+/*
+Copyright 2026 The kcp Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package compiledvirtualworkspace
+
+import (
+	"k8c.io/reconciler/pkg/reconciling"
+
+	"github.com/kcp-dev/kcp-operator/internal/resources"
+	"github.com/kcp-dev/kcp-operator/internal/resources/utils"
+	deployv1alpha1 "github.com/kcp-dev/kcp-operator/sdk/apis/deploy/v1alpha1"
+)
+
+func PodDisruptionBudgetReconciler(vw *deployv1alpha1.CompiledVirtualWorkspace) reconciling.NamedPodDisruptionBudgetReconcilerFactory {
+	return utils.PodDisruptionBudgetReconciler(
+		resources.GetCompiledVirtualWorkspaceDeploymentName(vw),
+		resources.GetCompiledVirtualWorkspaceResourceLabels(vw),
+		vw.Spec.VirtualWorkspace.PodDisruptionBudget,
+	)
+}
@@ -0,0 +1,80 @@
+/*
+Copyright 2026 The kcp Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package etcd
+
+import (
+	"fmt"
+
+	"k8c.io/reconciler/pkg/reconciling"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+
+	"github.com/kcp-dev/kcp-operator/internal/resources"
+)
+
+// ServiceReconciler renders the headless Service that gives each etcd member a stable DNS name.
+// Not-ready addresses are published so that members can discover each other while bootstrapping.
+func ServiceReconciler(shardName string) reconciling.NamedServiceReconcilerFactory {
+	return func() (string, reconciling.ServiceReconciler) {
+		return resources.GetManagedEtcdName(shardName), func(svc *corev1.Service) (*corev1.Service, error) {
+			labels := resources.GetManagedEtcdResourceLabels(shardName)
+
+			svc.SetLabels(labels)
+			svc.Spec.Type = corev1.ServiceTypeClusterIP
+			svc.Spec.ClusterIP = corev1.ClusterIPNone
+			svc.Spec.PublishNotReadyAddresses = true
+			svc.Spec.Selector = labels
+			svc.Spec.Ports = []corev1.ServicePort{
+				{
+					Name:       "client",
+					Protocol:   corev1.ProtocolTCP,
+					Port:       ClientPort,
+					TargetPort: intstr.FromInt32(ClientPort),
+				},
+				{
+					Name:       "peer",
+					Protocol:   corev1.ProtocolTCP,
+					Port:       PeerPort,
+					TargetPort: intstr.FromInt32(PeerPort),
+				},
+			}
+
+			return svc, nil
+		}
+	}
+}
+
+// ServerDNSNames returns the names that the etcd members need to be reachable under, both from
+// their peers and from the shard.
+func ServerDNSNames(shardName, namespace, clusterDomain string) []string {
+	if clusterDomain == "" {
+		clusterDomain = "cluster.local"
+	}
+
+	name := resources.GetManagedEtcdName(shardName)
+
+	return []string{
+		name,
+		fmt.Sprintf("*.%s", name),
+		fmt.Sprintf("%s.%s.svc", name, namespace),
+		fmt.Sprintf("*.%s.%s.svc", name, namespace),
+		fmt.Sprintf("%s.%s.svc.%s", name, namespace, clusterDomain),
+		fmt.Sprintf("*.%s.%s.svc.%s", name, namespace, clusterDomain),
+		"localhost",
+	}
+}
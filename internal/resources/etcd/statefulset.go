@@ -0,0 +1,260 @@
+/*
+Copyright 2026 The kcp Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package etcd
+
+import (
+	"fmt"
+	"strings"
+
+	"k8c.io/reconciler/pkg/reconciling"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/utils/ptr"
+
+	"github.com/kcp-dev/kcp-operator/internal/resources"
+	"github.com/kcp-dev/kcp-operator/internal/resources/utils"
+	operatorv1alpha1 "github.com/kcp-dev/kcp-operator/sdk/apis/operator/v1alpha1"
+)
+
+const (
+	ClientPort  = 2379
+	PeerPort    = 2380
+	MetricsPort = 2381
+
+	dataVolumeName = "data"
+	DataMountPath  = "/var/lib/etcd"
+	tlsVolumeName  = "tls"
+	tlsMountPath   = "/etc/etcd/tls"
+)
+
+var defaultStorageSize = resource.MustParse("8Gi")
+
+var defaultResources = corev1.ResourceRequirements{
+	Requests: corev1.ResourceList{
+		corev1.ResourceCPU:    resource.MustParse("100m"),
+		corev1.ResourceMemory: resource.MustParse("256Mi"),
+	},
+	Limits: corev1.ResourceList{
+		corev1.ResourceMemory: resource.MustParse("1Gi"),
+	},
+}
+
+// StatefulSetReconciler renders the etcd cluster that backs the (root) shard with the given name.
+// The members bootstrap statically, so all of them are started in parallel and find each other via
+// the headless Service rendered by ServiceReconciler. dataDir is the directory on the data volumes
+// that holds the member data; stopped scales the StatefulSet down, e.g. while a snapshot is restored.
+func StatefulSetReconciler(shardName string, spec *operatorv1alpha1.ManagedEtcdSpec, dataDir string, stopped bool) reconciling.NamedStatefulSetReconcilerFactory {
+	name := resources.GetManagedEtcdName(shardName)
+	replicas := resources.GetManagedEtcdReplicas(spec)
+
+	return func() (string, reconciling.StatefulSetReconciler) {
+		return name, func(sts *appsv1.StatefulSet) (*appsv1.StatefulSet, error) {
+			labels := resources.GetManagedEtcdResourceLabels(shardName)
+			image, imagePullSecrets := resources.GetManagedEtcdImageSettings(spec.Image)
+
+			sts.SetLabels(labels)
+			sts.Spec.Replicas = ptr.To(replicas)
+			if stopped {
+				sts.Spec.Replicas = ptr.To[int32](0)
+			}
+			sts.Spec.ServiceName = name
+			sts.Spec.PodManagementPolicy = appsv1.ParallelPodManagement
+			sts.Spec.Selector = &metav1.LabelSelector{
+				MatchLabels: labels,
+			}
+			sts.Spec.Template.SetLabels(labels)
+
+			container := corev1.Container{
+				Name:    "etcd",
+				Image:   image,
+				Command: []string{"etcd"},
+				Args:    getArgs(shardName, replicas, dataDir),
+				Env: []corev1.EnvVar{{
+					Name: "POD_NAME",
+					ValueFrom: &corev1.EnvVarSource{
+						FieldRef: &corev1.ObjectFieldSelector{FieldPath: "metadata.name"},
+					},
+				}},
+				Ports: []corev1.ContainerPort{
+					{Name: "client", ContainerPort: ClientPort, Protocol: corev1.ProtocolTCP},
+					{Name: "peer", ContainerPort: PeerPort, Protocol: corev1.ProtocolTCP},
+					{Name: "metrics", ContainerPort: MetricsPort, Protocol: corev1.ProtocolTCP},
+				},
+				VolumeMounts: []corev1.VolumeMount{
+					{Name: dataVolumeName, MountPath: DataMountPath},
+					{Name: tlsVolumeName, MountPath: tlsMountPath, ReadOnly: true},
+				},
+				Resources: *defaultResources.DeepCopy(),
+				SecurityContext: &corev1.SecurityContext{
+					SeccompProfile: &corev1.SeccompProfile{
+						Type: corev1.SeccompProfileTypeRuntimeDefault,
+					},
+					AllowPrivilegeEscalation: ptr.To(false),
+					Capabilities: &corev1.Capabilities{
+						Drop: []corev1.Capability{"ALL"},
+					},
+				},
+				// /health only succeeds once the member is part of a cluster with an elected leader.
+				ReadinessProbe: &corev1.Probe{
+					FailureThreshold: 3,
+					PeriodSeconds:    10,
+					SuccessThreshold: 1,
+					TimeoutSeconds:   5,
+					ProbeHandler: corev1.ProbeHandler{
+						HTTPGet: &corev1.HTTPGetAction{
+							Path:   "/health",
+							Port:   intstr.FromString("metrics"),
+							Scheme: corev1.URISchemeHTTP,
+						},
+					},
+				},
+				LivenessProbe: &corev1.Probe{
+					FailureThreshold:    8,
+					InitialDelaySeconds: 30,
+					PeriodSeconds:       10,
+					SuccessThreshold:    1,
+					TimeoutSeconds:      5,
+					ProbeHandler: corev1.ProbeHandler{
+						HTTPGet: &corev1.HTTPGetAction{
+							Path:   "/health?serializable=true",
+							Port:   intstr.FromString("metrics"),
+							Scheme: corev1.URISchemeHTTP,
+						},
+					},
+				},
+			}
+
+			if spec.Resources != nil {
+				container = utils.ApplyResources(container, spec.Resources)
+			}
+
+			sts.Spec.Template.Spec.Containers = []corev1.Container{container}
+			sts.Spec.Template.Spec.ImagePullSecrets = imagePullSecrets
+			sts.Spec.Template.Spec.Volumes = []corev1.Volume{{
+				Name: tlsVolumeName,
+				VolumeSource: corev1.VolumeSource{
+					Secret: &corev1.SecretVolumeSource{
+						SecretName: resources.GetManagedEtcdCertificateName(shardName, operatorv1alpha1.EtcdServerCertificate),
+					},
+				},
+			}}
+
+			// spread the members across nodes if possible
+			sts.Spec.Template.Spec.Affinity = &corev1.Affinity{
+				PodAntiAffinity: &corev1.PodAntiAffinity{
+					PreferredDuringSchedulingIgnoredDuringExecution: []corev1.WeightedPodAffinityTerm{{
+						Weight: 100,
+						PodAffinityTerm: corev1.PodAffinityTerm{
+							LabelSelector: &metav1.LabelSelector{MatchLabels: labels},
+							TopologyKey:   corev1.LabelHostname,
+						},
+					}},
+				},
+			}
+
+			// volumeClaimTemplates are immutable, so only set them when creating the StatefulSet
+			if len(sts.Spec.VolumeClaimTemplates) == 0 {
+				sts.Spec.VolumeClaimTemplates = []corev1.PersistentVolumeClaim{getVolumeClaimTemplate(spec.Storage)}
+			}
+
+			return sts, nil
+		}
+	}
+}
+
+func getVolumeClaimTemplate(storage *operatorv1alpha1.ManagedEtcdStorageSpec) corev1.PersistentVolumeClaim {
+	size := defaultStorageSize
+	var storageClassName *string
+
+	if storage != nil {
+		if storage.Size != nil {
+			size = *storage.Size
+		}
+		storageClassName = storage.StorageClassName
+	}
+
+	return corev1.PersistentVolumeClaim{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: dataVolumeName,
+		},
+		Spec: corev1.PersistentVolumeClaimSpec{
+			AccessModes:      []corev1.PersistentVolumeAccessMode{corev1.ReadWriteOnce},
+			StorageClassName: storageClassName,
+			Resources: corev1.VolumeResourceRequirements{
+				Requests: corev1.ResourceList{
+					corev1.ResourceStorage: size,
+				},
+			},
+		},
+	}
+}
+
+// MemberName returns the etcd member name of the given member, which equals its Pod name.
+func MemberName(shardName string, member int32) string {
+	return fmt.Sprintf("%s-%d", resources.GetManagedEtcdName(shardName), member)
+}
+
+// MemberPeerURL returns the URL that the given member advertises to its peers.
+func MemberPeerURL(shardName string, member int32) string {
+	return fmt.Sprintf("https://%s:%d", resources.GetManagedEtcdMemberHost(shardName, member), PeerPort)
+}
+
+// InitialCluster returns the value for etcd's --initial-cluster flag.
+func InitialCluster(shardName string, replicas int32) string {
+	initialCluster := make([]string, 0, replicas)
+	for i := range replicas {
+		initialCluster = append(initialCluster, fmt.Sprintf("%s=%s", MemberName(shardName, i), MemberPeerURL(shardName, i)))
+	}
+
+	return strings.Join(initialCluster, ",")
+}
+
+// DataDir returns the absolute path of the given data directory inside the etcd containers.
+func DataDir(dataDir string) string {
+	return fmt.Sprintf("%s/%s", DataMountPath, dataDir)
+}
+
+func getArgs(shardName string, replicas int32, dataDir string) []string {
+	name := resources.GetManagedEtcdName(shardName)
+
+	return []string{
+		"--name=$(POD_NAME)",
+		fmt.Sprintf("--data-dir=%s", DataDir(dataDir)),
+		fmt.Sprintf("--listen-client-urls=https://0.0.0.0:%d", ClientPort),
+		fmt.Sprintf("--advertise-client-urls=https://$(POD_NAME).%s:%d", name, ClientPort),
+		fmt.Sprintf("--listen-peer-urls=https://0.0.0.0:%d", PeerPort),
+		fmt.Sprintf("--initial-advertise-peer-urls=https://$(POD_NAME).%s:%d", name, PeerPort),
+		fmt.Sprintf("--listen-metrics-urls=http://0.0.0.0:%d", MetricsPort),
+		fmt.Sprintf("--initial-cluster=%s", InitialCluster(shardName, replicas)),
+		fmt.Sprintf("--initial-cluster-token=%s", name),
+		"--initial-cluster-state=new",
+		"--client-cert-auth",
+		fmt.Sprintf("--trusted-ca-file=%s/ca.crt", tlsMountPath),
+		fmt.Sprintf("--cert-file=%s/tls.crt", tlsMountPath),
+		fmt.Sprintf("--key-file=%s/tls.key", tlsMountPath),
+		"--peer-client-cert-auth",
+		fmt.Sprintf("--peer-trusted-ca-file=%s/ca.crt", tlsMountPath),
+		fmt.Sprintf("--peer-cert-file=%s/tls.crt", tlsMountPath),
+		fmt.Sprintf("--peer-key-file=%s/tls.key", tlsMountPath),
+		"--auto-compaction-mode=periodic",
+		"--auto-compaction-retention=1h",
+	}
+}
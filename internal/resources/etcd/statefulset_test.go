@@ -0,0 +1,99 @@
+/*
+Copyright 2026 The kcp Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package etcd
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/utils/ptr"
+
+	operatorv1alpha1 "github.com/kcp-dev/kcp-operator/sdk/apis/operator/v1alpha1"
+)
+
+func TestStatefulSetReconciler(t *testing.T) {
+	spec := &operatorv1alpha1.ManagedEtcdSpec{
+		Replicas: ptr.To[int32](3),
+		Storage: &operatorv1alpha1.ManagedEtcdStorageSpec{
+			StorageClassName: ptr.To("fast"),
+			Size:             ptr.To(resource.MustParse("20Gi")),
+		},
+	}
+
+	name, reconciler := StatefulSetReconciler("shardy", spec, "data", false)()
+	require.Equal(t, "shardy-etcd", name)
+
+	sts, err := reconciler(&appsv1.StatefulSet{})
+	require.NoError(t, err)
+
+	assert.Equal(t, int32(3), *sts.Spec.Replicas)
+	assert.Equal(t, "shardy-etcd", sts.Spec.ServiceName)
+	assert.Equal(t, appsv1.ParallelPodManagement, sts.Spec.PodManagementPolicy)
+	assert.Equal(t, sts.Spec.Selector.MatchLabels, sts.Spec.Template.Labels)
+
+	require.Len(t, sts.Spec.Template.Spec.Containers, 1)
+	container := sts.Spec.Template.Spec.Containers[0]
+	assert.Equal(t, "quay.io/coreos/etcd:v3.5.21", container.Image)
+	assert.Contains(t, container.Args, "--data-dir=/var/lib/etcd/data")
+	assert.Contains(t, container.Args, "--initial-cluster=shardy-etcd-0=https://shardy-etcd-0.shardy-etcd:2380,shardy-etcd-1=https://shardy-etcd-1.shardy-etcd:2380,shardy-etcd-2=https://shardy-etcd-2.shardy-etcd:2380")
+
+	require.Len(t, sts.Spec.Template.Spec.Volumes, 1)
+	assert.Equal(t, "shardy-etcd-server", sts.Spec.Template.Spec.Volumes[0].Secret.SecretName)
+
+	require.Len(t, sts.Spec.VolumeClaimTemplates, 1)
+	pvc := sts.Spec.VolumeClaimTemplates[0]
+	assert.Equal(t, "fast", *pvc.Spec.StorageClassName)
+	assert.Equal(t, resource.MustParse("20Gi"), pvc.Spec.Resources.Requests[corev1.ResourceStorage])
+}
+
+func TestStatefulSetReconcilerKeepsVolumeClaimTemplates(t *testing.T) {
+	_, reconciler := StatefulSetReconciler("shardy", &operatorv1alpha1.ManagedEtcdSpec{}, "data", false)()
+
+	existing, err := reconciler(&appsv1.StatefulSet{})
+	require.NoError(t, err)
+	require.Equal(t, int32(3), *existing.Spec.Replicas)
+
+	// changing the storage afterwards must not touch the immutable claim templates
+	_, reconciler = StatefulSetReconciler("shardy", &operatorv1alpha1.ManagedEtcdSpec{
+		Storage: &operatorv1alpha1.ManagedEtcdStorageSpec{Size: ptr.To(resource.MustParse("100Gi"))},
+	}, "data", false)()
+
+	updated, err := reconciler(existing.DeepCopy())
+	require.NoError(t, err)
+	assert.Equal(t, existing.Spec.VolumeClaimTemplates, updated.Spec.VolumeClaimTemplates)
+}
+
+func TestStatefulSetReconcilerRestore(t *testing.T) {
+	_, reconciler := StatefulSetReconciler("shardy", &operatorv1alpha1.ManagedEtcdSpec{}, "data", true)()
+
+	sts, err := reconciler(&appsv1.StatefulSet{})
+	require.NoError(t, err)
+	assert.Equal(t, int32(0), *sts.Spec.Replicas)
+
+	// after the restore, the members start from the restored data directory
+	_, reconciler = StatefulSetReconciler("shardy", &operatorv1alpha1.ManagedEtcdSpec{}, "my-restore", false)()
+
+	sts, err = reconciler(sts)
+	require.NoError(t, err)
+	assert.Equal(t, int32(3), *sts.Spec.Replicas)
+	assert.Contains(t, sts.Spec.Template.Spec.Containers[0].Args, "--data-dir=/var/lib/etcd/my-restore")
+}
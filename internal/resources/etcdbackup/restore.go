@@ -0,0 +1,104 @@
+/*
+Copyright 2026 The kcp Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package etcdbackup
+
+import (
+	"fmt"
+	"path"
+
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+
+	"github.com/kcp-dev/kcp-operator/internal/resources"
+	"github.com/kcp-dev/kcp-operator/internal/resources/etcd"
+	operatorv1alpha1 "github.com/kcp-dev/kcp-operator/sdk/apis/operator/v1alpha1"
+)
+
+const dataVolumeName = "data"
+
+// RestoreJob returns the Job that restores the snapshot onto the data volume of a single member
+// of an operator-managed etcd cluster. The data is restored into a new directory named after
+// the EtcdRestore, so a failed restore never touches the existing member data.
+func RestoreJob(restore *operatorv1alpha1.EtcdRestore, snapshot *operatorv1alpha1.EtcdSnapshot, shardName string, spec *operatorv1alpha1.ManagedEtcdSpec, member int32) (*batchv1.Job, error) {
+	image, imagePullSecrets := resources.GetManagedEtcdImageSettings(spec.Image)
+	volume, mount := snapshotVolume(snapshot.Spec.Storage)
+	fileName := resources.GetEtcdSnapshotFileName(snapshot)
+
+	mount.ReadOnly = snapshot.Spec.Storage.PersistentVolumeClaim != nil
+
+	etcdutl := corev1.Container{
+		Name:  "etcdutl",
+		Image: image,
+		Command: []string{
+			"etcdutl", "snapshot", "restore", path.Join(snapshotMountPath, fileName),
+			fmt.Sprintf("--name=%s", etcd.MemberName(shardName, member)),
+			fmt.Sprintf("--initial-cluster=%s", etcd.InitialCluster(shardName, resources.GetManagedEtcdReplicas(spec))),
+			fmt.Sprintf("--initial-cluster-token=%s", resources.GetManagedEtcdName(shardName)),
+			fmt.Sprintf("--initial-advertise-peer-urls=%s", etcd.MemberPeerURL(shardName, member)),
+			fmt.Sprintf("--data-dir=%s", etcd.DataDir(restore.Name)),
+		},
+		VolumeMounts: []corev1.VolumeMount{
+			mount,
+			{Name: dataVolumeName, MountPath: etcd.DataMountPath},
+		},
+		SecurityContext: containerSecurityContext(),
+	}
+
+	podSpec := corev1.PodSpec{
+		RestartPolicy:    corev1.RestartPolicyNever,
+		ImagePullSecrets: imagePullSecrets,
+		Containers:       []corev1.Container{etcdutl},
+		Volumes: []corev1.Volume{
+			volume,
+			{
+				Name: dataVolumeName,
+				VolumeSource: corev1.VolumeSource{
+					PersistentVolumeClaim: &corev1.PersistentVolumeClaimVolumeSource{
+						ClaimName: resources.GetManagedEtcdDataVolumeClaimName(shardName, member),
+					},
+				},
+			},
+		},
+	}
+
+	if s3 := snapshot.Spec.Storage.S3; s3 != nil {
+		config, download, mcVolume, mcPullSecrets, err := minioClientContainers("download", s3, s3ObjectPath(s3, fileName), path.Join(snapshotMountPath, fileName))
+		if err != nil {
+			return nil, err
+		}
+
+		podSpec.InitContainers = []corev1.Container{config, download}
+		podSpec.Volumes = append(podSpec.Volumes, mcVolume)
+		podSpec.ImagePullSecrets = append(podSpec.ImagePullSecrets, mcPullSecrets...)
+	}
+
+	return &batchv1.Job{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      resources.GetEtcdRestoreJobName(restore, member),
+			Namespace: restore.Namespace,
+		},
+		Spec: batchv1.JobSpec{
+			// etcdutl refuses to restore into an existing directory, so retrying is pointless.
+			BackoffLimit: ptr.To[int32](0),
+			Template: corev1.PodTemplateSpec{
+				Spec: podSpec,
+			},
+		},
+	}, nil
+}
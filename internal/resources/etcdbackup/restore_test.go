@@ -0,0 +1,58 @@
+/*
+Copyright 2026 The kcp Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package etcdbackup
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+
+	operatorv1alpha1 "github.com/kcp-dev/kcp-operator/sdk/apis/operator/v1alpha1"
+)
+
+func TestRestoreJob(t *testing.T) {
+	restore := &operatorv1alpha1.EtcdRestore{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "resty",
+			Namespace: "default",
+		},
+	}
+
+	job, err := RestoreJob(restore, newSnapshot(s3Storage), "shardy", &operatorv1alpha1.ManagedEtcdSpec{Replicas: ptr.To[int32](3)}, 1)
+	require.NoError(t, err)
+
+	assert.Equal(t, "resty-etcd-restore-1", job.Name)
+	assert.Equal(t, int32(0), *job.Spec.BackoffLimit)
+
+	podSpec := job.Spec.Template.Spec
+	require.Len(t, podSpec.InitContainers, 2)
+	assert.Equal(t, "download-config", podSpec.InitContainers[0].Name)
+	assert.Equal(t, []string{"cp", "target/backups/kcp/snappy.db", "/snapshots/snappy.db"}, podSpec.InitContainers[1].Command[3:])
+
+	etcdutl := podSpec.Containers[0]
+	assert.Contains(t, etcdutl.Command, "--name=shardy-etcd-1")
+	assert.Contains(t, etcdutl.Command, "--initial-advertise-peer-urls=https://shardy-etcd-1.shardy-etcd:2380")
+	assert.Contains(t, etcdutl.Command, "--data-dir=/var/lib/etcd/resty")
+
+	require.Len(t, podSpec.Volumes, 3)
+	assert.Equal(t, "data-shardy-etcd-1", podSpec.Volumes[1].PersistentVolumeClaim.ClaimName)
+	assert.NotNil(t, podSpec.Volumes[2].EmptyDir)
+}
@@ -0,0 +1,144 @@
+/*
+Copyright 2026 The kcp Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package etcdbackup
+
+import (
+	"errors"
+	"fmt"
+	"path"
+
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+
+	"github.com/kcp-dev/kcp-operator/internal/resources"
+	operatorv1alpha1 "github.com/kcp-dev/kcp-operator/sdk/apis/operator/v1alpha1"
+)
+
+// Endpoints returns the endpoints of the etcd that is configured for the (root) shard with the
+// given name, in the order in which snapshots are attempted.
+func Endpoints(shardName string, config operatorv1alpha1.EtcdConfig) []string {
+	endpoints, _ := clientConfig(shardName, config)
+	return endpoints
+}
+
+// SnapshotJob returns the Job that takes the given snapshot from the etcd endpoint with the given
+// index of the etcd that is configured for the (root) shard with the given name. etcdctl writes
+// the snapshot either directly onto the target PVC, or into a scratch volume from which it is
+// uploaded to S3 afterwards.
+func SnapshotJob(snapshot *operatorv1alpha1.EtcdSnapshot, shardName string, config operatorv1alpha1.EtcdConfig, endpoint int) (*batchv1.Job, error) {
+	endpoints, tlsSecret := clientConfig(shardName, config)
+	if len(endpoints) == 0 {
+		return nil, errors.New("no etcd endpoints configured")
+	}
+	if endpoint < 0 || endpoint >= len(endpoints) {
+		return nil, fmt.Errorf("etcd endpoint %d is not configured", endpoint)
+	}
+
+	image, imagePullSecrets := resources.GetManagedEtcdImageSettings(snapshot.Spec.Image)
+	volume, mount := snapshotVolume(snapshot.Spec.Storage)
+	fileName := resources.GetEtcdSnapshotFileName(snapshot)
+
+	// `etcdctl snapshot save` only accepts a single endpoint, so each endpoint gets a Job of its own.
+	env := []corev1.EnvVar{
+		{Name: "ETCDCTL_API", Value: "3"},
+		{Name: "ETCDCTL_ENDPOINTS", Value: endpoints[endpoint]},
+	}
+
+	etcdctl := corev1.Container{
+		Name:            "etcdctl",
+		Image:           image,
+		Command:         []string{"etcdctl", "snapshot", "save", path.Join(snapshotMountPath, fileName)},
+		VolumeMounts:    []corev1.VolumeMount{mount},
+		SecurityContext: containerSecurityContext(),
+	}
+
+	volumes := []corev1.Volume{volume}
+
+	if tlsSecret != "" {
+		env = append(env,
+			corev1.EnvVar{Name: "ETCDCTL_CACERT", Value: path.Join(tlsMountPath, "ca.crt")},
+			corev1.EnvVar{Name: "ETCDCTL_CERT", Value: path.Join(tlsMountPath, "tls.crt")},
+			corev1.EnvVar{Name: "ETCDCTL_KEY", Value: path.Join(tlsMountPath, "tls.key")},
+		)
+
+		etcdctl.VolumeMounts = append(etcdctl.VolumeMounts, corev1.VolumeMount{
+			Name:      tlsVolumeName,
+			MountPath: tlsMountPath,
+			ReadOnly:  true,
+		})
+
+		volumes = append(volumes, corev1.Volume{
+			Name: tlsVolumeName,
+			VolumeSource: corev1.VolumeSource{
+				Secret: &corev1.SecretVolumeSource{
+					SecretName: tlsSecret,
+				},
+			},
+		})
+	}
+
+	etcdctl.Env = env
+
+	podSpec := corev1.PodSpec{
+		RestartPolicy:    corev1.RestartPolicyNever,
+		ImagePullSecrets: imagePullSecrets,
+		Containers:       []corev1.Container{etcdctl},
+		Volumes:          volumes,
+	}
+
+	if s3 := snapshot.Spec.Storage.S3; s3 != nil {
+		config, upload, mcVolume, mcPullSecrets, err := minioClientContainers("upload", s3, path.Join(snapshotMountPath, fileName), s3ObjectPath(s3, fileName))
+		if err != nil {
+			return nil, err
+		}
+
+		podSpec.InitContainers = []corev1.Container{etcdctl, config}
+		podSpec.Containers = []corev1.Container{upload}
+		podSpec.Volumes = append(podSpec.Volumes, mcVolume)
+		podSpec.ImagePullSecrets = append(podSpec.ImagePullSecrets, mcPullSecrets...)
+	}
+
+	return &batchv1.Job{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      resources.GetEtcdSnapshotJobName(snapshot, endpoint),
+			Namespace: snapshot.Namespace,
+		},
+		Spec: batchv1.JobSpec{
+			BackoffLimit: ptr.To[int32](2),
+			Template: corev1.PodTemplateSpec{
+				Spec: podSpec,
+			},
+		},
+	}, nil
+}
+
+// clientConfig returns the endpoints of the etcd cluster and the name of the Secret that
+// holds the client certificate, if any.
+func clientConfig(shardName string, config operatorv1alpha1.EtcdConfig) ([]string, string) {
+	if config.Managed != nil {
+		return resources.GetManagedEtcdEndpoints(shardName, config.Managed),
+			resources.GetManagedEtcdCertificateName(shardName, operatorv1alpha1.EtcdClientCertificate)
+	}
+
+	if config.TLSConfig != nil {
+		return config.Endpoints, config.TLSConfig.SecretRef.Name
+	}
+
+	return config.Endpoints, ""
+}
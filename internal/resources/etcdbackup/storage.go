@@ -0,0 +1,190 @@
+/*
+Copyright 2026 The kcp Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package etcdbackup
+
+import (
+	"fmt"
+	"net/url"
+	"path"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/utils/ptr"
+
+	"github.com/kcp-dev/kcp-operator/internal/resources"
+	operatorv1alpha1 "github.com/kcp-dev/kcp-operator/sdk/apis/operator/v1alpha1"
+)
+
+const (
+	snapshotVolumeName = "snapshots"
+	snapshotMountPath  = "/snapshots"
+
+	tlsVolumeName = "etcd-client-cert"
+	tlsMountPath  = "/etc/etcd/tls"
+
+	mcConfigVolumeName = "mc-config"
+	mcConfigMountPath  = "/etc/mc"
+
+	// s3Alias is the name under which the S3 endpoint is configured for the MinIO client.
+	s3Alias = "target"
+)
+
+// Location returns a human readable description of where the snapshot is stored.
+func Location(snapshot *operatorv1alpha1.EtcdSnapshot, storage operatorv1alpha1.EtcdSnapshotStorage) string {
+	fileName := resources.GetEtcdSnapshotFileName(snapshot)
+
+	if s3 := storage.S3; s3 != nil {
+		return fmt.Sprintf("s3://%s/%s", s3.Bucket, objectKey(s3, fileName))
+	}
+
+	return fmt.Sprintf("pvc://%s/%s", storage.PersistentVolumeClaim.ClaimName, path.Join(storage.PersistentVolumeClaim.SubPath, fileName))
+}
+
+func objectKey(s3 *operatorv1alpha1.S3SnapshotStorage, fileName string) string {
+	return path.Join(s3.Prefix, fileName)
+}
+
+// snapshotVolume returns the volume that holds snapshot files inside the Pod. For PVC storage,
+// this is the PVC itself; for S3, snapshots are staged in an emptyDir.
+func snapshotVolume(storage operatorv1alpha1.EtcdSnapshotStorage) (corev1.Volume, corev1.VolumeMount) {
+	mount := corev1.VolumeMount{
+		Name:      snapshotVolumeName,
+		MountPath: snapshotMountPath,
+	}
+
+	if pvc := storage.PersistentVolumeClaim; pvc != nil {
+		mount.SubPath = pvc.SubPath
+
+		return corev1.Volume{
+			Name: snapshotVolumeName,
+			VolumeSource: corev1.VolumeSource{
+				PersistentVolumeClaim: &corev1.PersistentVolumeClaimVolumeSource{
+					ClaimName: pvc.ClaimName,
+				},
+			},
+		}, mount
+	}
+
+	return corev1.Volume{
+		Name: snapshotVolumeName,
+		VolumeSource: corev1.VolumeSource{
+			EmptyDir: &corev1.EmptyDirVolumeSource{},
+		},
+	}, mount
+}
+
+// minioClientContainers returns the containers that copy a file from src to dst using the
+// MinIO client. The first one configures the S3 endpoint with the credentials in an emptyDir
+// and needs to run as an init container before the second one, which runs `mc cp`. Passing the
+// credentials as arguments instead of embedding them in a URL (like with MC_HOST_<alias>)
+// means they do not need to be escaped.
+func minioClientContainers(name string, s3 *operatorv1alpha1.S3SnapshotStorage, src, dst string) (corev1.Container, corev1.Container, corev1.Volume, []corev1.LocalObjectReference, error) {
+	endpoint, err := s3EndpointURL(s3.Endpoint)
+	if err != nil {
+		return corev1.Container{}, corev1.Container{}, corev1.Volume{}, nil, err
+	}
+
+	image, imagePullSecrets := resources.GetMinIOClientImageSettings(s3.Image)
+
+	secretKeyRef := func(key string) *corev1.EnvVarSource {
+		return &corev1.EnvVarSource{
+			SecretKeyRef: &corev1.SecretKeySelector{
+				LocalObjectReference: s3.CredentialsSecretRef,
+				Key:                  key,
+			},
+		}
+	}
+
+	mount := corev1.VolumeMount{
+		Name:      mcConfigVolumeName,
+		MountPath: mcConfigMountPath,
+	}
+
+	alias := corev1.Container{
+		Name:  name + "-config",
+		Image: image,
+		Command: []string{
+			"mc", "--config-dir", mcConfigMountPath, "alias", "set", s3Alias, endpoint,
+			"$(AWS_ACCESS_KEY_ID)", "$(AWS_SECRET_ACCESS_KEY)", "--api", "S3v4",
+		},
+		Env: []corev1.EnvVar{
+			{Name: "AWS_ACCESS_KEY_ID", ValueFrom: secretKeyRef(operatorv1alpha1.S3AccessKeyIDKey)},
+			{Name: "AWS_SECRET_ACCESS_KEY", ValueFrom: secretKeyRef(operatorv1alpha1.S3SecretAccessKeyKey)},
+		},
+		VolumeMounts:    []corev1.VolumeMount{mount},
+		SecurityContext: containerSecurityContext(),
+	}
+
+	cp := corev1.Container{
+		Name:    name,
+		Image:   image,
+		Command: []string{"mc", "--config-dir", mcConfigMountPath, "cp", src, dst},
+		VolumeMounts: []corev1.VolumeMount{
+			mount,
+			{
+				Name:      snapshotVolumeName,
+				MountPath: snapshotMountPath,
+			},
+		},
+		SecurityContext: containerSecurityContext(),
+	}
+
+	volume := corev1.Volume{
+		Name: mcConfigVolumeName,
+		VolumeSource: corev1.VolumeSource{
+			EmptyDir: &corev1.EmptyDirVolumeSource{},
+		},
+	}
+
+	return alias, cp, volume, imagePullSecrets, nil
+}
+
+// s3EndpointURL returns the endpoint in the form the MinIO client expects. Endpoints with a
+// path are rejected, as the MinIO client would silently ignore it.
+func s3EndpointURL(endpoint string) (string, error) {
+	u, err := url.Parse(endpoint)
+	if err != nil {
+		return "", fmt.Errorf("invalid S3 endpoint: %w", err)
+	}
+
+	if u.Scheme != "http" && u.Scheme != "https" || u.Host == "" {
+		return "", fmt.Errorf("invalid S3 endpoint %q: must be an http or https URL", endpoint)
+	}
+
+	if strings.Trim(u.Path, "/") != "" || u.RawQuery != "" || u.User != nil {
+		return "", fmt.Errorf("invalid S3 endpoint %q: must not contain a path, query or credentials", endpoint)
+	}
+
+	return fmt.Sprintf("%s://%s", u.Scheme, u.Host), nil
+}
+
+// s3ObjectPath returns the path of the snapshot object as understood by the MinIO client.
+func s3ObjectPath(s3 *operatorv1alpha1.S3SnapshotStorage, fileName string) string {
+	return path.Join(s3Alias, s3.Bucket, objectKey(s3, fileName))
+}
+
+func containerSecurityContext() *corev1.SecurityContext {
+	return &corev1.SecurityContext{
+		SeccompProfile: &corev1.SeccompProfile{
+			Type: corev1.SeccompProfileTypeRuntimeDefault,
+		},
+		AllowPrivilegeEscalation: ptr.To(false),
+		Capabilities: &corev1.Capabilities{
+			Drop: []corev1.Capability{"ALL"},
+		},
+	}
+}
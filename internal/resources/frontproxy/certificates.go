@@ -99,6 +99,7 @@ func (r *reconciler) serverCertificateReconciler() reconciling.NamedCertificateR
 	return func() (string, reconciling.CertificateReconciler) {
 		return name, func(cert *certmanagerv1.Certificate) (*certmanagerv1.Certificate, error) {
 			cert.SetLabels(r.resourceLabels)
+			utils.SetCertificateKind(cert, certKind)
 			cert.Spec = certmanagerv1.CertificateSpec{
 				SecretName: name,
 				SecretTemplate: &certmanagerv1.CertificateSecretTemplate{
@@ -141,6 +142,7 @@ func (r *reconciler) kubeconfigCertificateReconciler() reconciling.NamedCertific
 	return func() (string, reconciling.CertificateReconciler) {
 		return name, func(cert *certmanagerv1.Certificate) (*certmanagerv1.Certificate, error) {
 			cert.SetLabels(r.resourceLabels)
+			utils.SetCertificateKind(cert, certKind)
 			cert.Spec = certmanagerv1.CertificateSpec{
 				SecretName: name,
 				SecretTemplate: &certmanagerv1.CertificateSecretTemplate{
@@ -185,6 +187,7 @@ func (r *reconciler) requestHeaderCertificateReconciler() reconciling.NamedCerti
 	return func() (string, reconciling.CertificateReconciler) {
 		return name, func(cert *certmanagerv1.Certificate) (*certmanagerv1.Certificate, error) {
 			cert.SetLabels(r.resourceLabels)
+			utils.SetCertificateKind(cert, certKind)
 			cert.Spec = certmanagerv1.CertificateSpec{
 				SecretName: name,
 				SecretTemplate: &certmanagerv1.CertificateSecretTemplate{
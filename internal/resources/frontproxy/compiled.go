@@ -47,12 +47,14 @@ func CompiledFrontProxyReconciler(frontProxy *operatorv1alpha1.FrontProxy, rootS
 			}
 			obj.Labels[resources.FrontProxyLabel] = frontProxy.Name
 			obj.Labels[resources.RootShardLabel] = rootShard.Name
+			resources.SetPlacementLabel(obj.Labels, frontProxy.Spec.Placement)
 
 			obj.Spec.FrontProxy = frontProxy.Spec
 
 			obj.Spec.RootShard = deployv1alpha1.NamedRootShardSpec{
-				Name: rootShard.Name,
-				Spec: rootShard.Spec,
+				Name:         rootShard.Name,
+				Spec:         rootShard.Spec,
+				TrustBundles: resources.RootShardTrustsPreviousCAs(rootShard),
 			}
 
 			obj.Spec.Shards = utils.ShardNames(shards)
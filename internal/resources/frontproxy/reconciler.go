@@ -29,6 +29,8 @@ import (
 	ctrlruntimeclient "sigs.k8s.io/controller-runtime/pkg/client"
 
 	"github.com/kcp-dev/kcp-operator/internal/resources"
+	"github.com/kcp-dev/kcp-operator/internal/resources/utils"
+	"github.com/kcp-dev/kcp-operator/pkg/pki"
 	"github.com/kcp-dev/kcp-operator/pkg/reconciling"
 	operatorv1alpha1 "github.com/kcp-dev/kcp-operator/sdk/apis/operator/v1alpha1"
 )
@@ -69,12 +71,13 @@ func (r *reconciler) serviceName() string {
 	return resources.GetRootShardProxyServiceName(r.rootShard)
 }
 
-// getCABundleSecretRef returns the CABundleSecretRef from either the FrontProxy or RootShard spec.
-func (r *reconciler) getCABundleSecretRef() *corev1.LocalObjectReference {
+// getCABundleRefs returns the CABundleSecretRef and CABundleConfigMapRef from either the
+// FrontProxy or RootShard spec.
+func (r *reconciler) getCABundleRefs() (*corev1.LocalObjectReference, *operatorv1alpha1.LocalDataKeyReference) {
 	if r.frontProxy != nil {
-		return r.frontProxy.Spec.CABundleSecretRef
+		return r.frontProxy.Spec.CABundleSecretRef, r.frontProxy.Spec.CABundleConfigMapRef
 	}
-	return r.rootShard.Spec.CABundleSecretRef
+	return r.rootShard.Spec.CABundleSecretRef, r.rootShard.Spec.CABundleConfigMapRef
 }
 
 // getClientCABundleSecretRef returns the ClientCABundleRef from the FrontProxy spec.
@@ -92,7 +95,7 @@ func (r *reconciler) getClientCABundleSecretRef() *corev1.LocalObjectReference {
 
 // Reconcile renders the proxy's PKI. Extra modifiers are applied to the Certificates so the
 // caller can observe them; the workloads are rendered from the compiled object.
-func (r *reconciler) Reconcile(ctx context.Context, client ctrlruntimeclient.Client, namespace string, certModifiers ...k8creconciling.ObjectModifier) error {
+func (r *reconciler) Reconcile(ctx context.Context, client ctrlruntimeclient.Client, backend pki.Backend, namespace string, certModifiers ...k8creconciling.ObjectModifier) error {
 	var errs []error
 
 	var ref *metav1.OwnerReference
@@ -114,7 +117,7 @@ func (r *reconciler) Reconcile(ctx context.Context, client ctrlruntimeclient.Cli
 	}
 
 	// Fetch server CA bundle if needed
-	if r.getCABundleSecretRef() != nil {
+	if utils.HasCABundle(r.getCABundleRefs()) {
 		serverCACert, userCABundle, err := r.fetchBackendCAs(ctx, client)
 		if err != nil {
 			return err
@@ -132,7 +135,7 @@ func (r *reconciler) Reconcile(ctx context.Context, client ctrlruntimeclient.Cli
 		errs = append(errs, err)
 	}
 
-	if err := reconciling.ReconcileCertificates(ctx, certReconcilers, namespace, client, append([]k8creconciling.ObjectModifier{ownerRefWrapper}, certModifiers...)...); err != nil {
+	if err := backend.ReconcileCertificates(ctx, certReconcilers, namespace, client, append([]k8creconciling.ObjectModifier{ownerRefWrapper}, certModifiers...)...); err != nil {
 		errs = append(errs, err)
 	}
 
@@ -146,7 +149,7 @@ func (r *reconciler) fetchClientCACerts(ctx context.Context, client ctrlruntimec
 	certs := [][]byte{}
 
 	// fetch the shared, global client CA
-	clientCA, err := r.fetchTLSCert(ctx, client, resources.GetRootShardCAName(r.rootShard, operatorv1alpha1.ClientCA))
+	clientCA, err := r.fetchTLSCert(ctx, client, resources.GetRootShardCATrustName(r.rootShard, operatorv1alpha1.ClientCA))
 	if err != nil {
 		return nil, fmt.Errorf("failed to fetch ClientCA certificate: %w", err)
 	}
@@ -177,17 +180,16 @@ func (r *reconciler) fetchClientCACerts(ctx context.Context, client ctrlruntimec
 // fetchBackendCAs fetches the ServerCA certificate and the user-provided CA bundle.
 func (r *reconciler) fetchBackendCAs(ctx context.Context, client ctrlruntimeclient.Client) (serverCA, userCABundle []byte, err error) {
 	// fetch ServerCA
-	serverCA, err = r.fetchTLSCert(ctx, client, resources.GetRootShardCAName(r.rootShard, operatorv1alpha1.ServerCA))
+	serverCA, err = r.fetchTLSCert(ctx, client, resources.GetRootShardCATrustName(r.rootShard, operatorv1alpha1.ServerCA))
 	if err != nil {
 		return nil, nil, fmt.Errorf("failed to fetch ServerCA certificate: %w", err)
 	}
 
 	// fetch user-provided CA bundle
-	if ref := r.getCABundleSecretRef(); ref != nil {
-		userCABundle, err = r.fetchTLSCert(ctx, client, ref.Name)
-		if err != nil {
-			return nil, nil, fmt.Errorf("failed to fetch user CA bundle: %w", err)
-		}
+	secretRef, configMapRef := r.getCABundleRefs()
+	userCABundle, err = utils.FetchCABundle(ctx, client, r.rootShard.Namespace, secretRef, configMapRef)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to fetch user CA bundle: %w", err)
 	}
 
 	return serverCA, userCABundle, nil
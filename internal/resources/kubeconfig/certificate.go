@@ -35,6 +35,7 @@ func ClientCertificateReconciler(kubeConfig *operatorv1alpha1.Kubeconfig, issuer
 	return func() (string, reconciling.CertificateReconciler) {
 		return kubeConfig.GetCertificateName(), func(cert *certmanagerv1.Certificate) (*certmanagerv1.Certificate, error) {
 			cert.SetLabels(kubeConfig.Labels)
+			utils.SetCertificateKind(cert, operatorv1alpha1.ClientCertificate)
 			cert.Spec = certmanagerv1.CertificateSpec{
 				SecretName: kubeConfig.GetCertificateName(),
 				SecretTemplate: &certmanagerv1.CertificateSecretTemplate{
@@ -45,16 +45,17 @@ func KubeconfigSecretReconciler(
 	frontProxy operatorv1alpha1.FrontProxy,
 	caSecret *corev1.Secret,
 	certSecret *corev1.Secret,
-	caBundle *corev1.Secret, // can be nil
+	caBundle []byte, // can be nil
 ) (reconciling.NamedSecretReconcilerFactory, error) {
-	if caBundle != nil && caBundle.Data["tls.crt"] == nil {
-		return nil, fmt.Errorf("the CA bundle secret %s/%s does not contain a `tls.crt` key", caBundle.Namespace, caBundle.Name)
+	if err := utils.ValidatePEMCertificate(caBundle); err != nil {
+		return nil, fmt.Errorf("invalid CA bundle: %w", err)
 	}
 
-	caData, err := utils.MergeCertificateSecrets(caSecret, caBundle)
+	serverCA, err := utils.MergeCertificateSecrets(caSecret)
 	if err != nil {
 		return nil, fmt.Errorf("failed to merge CA bundles: %w", err)
 	}
+	caData := utils.MergeCertificates(serverCA, caBundle)
 
 	config := &clientcmdapi.Config{
 		Clusters: map[string]*clientcmdapi.Cluster{},
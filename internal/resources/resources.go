@@ -18,10 +18,12 @@ package resources
 
 import (
 	"fmt"
+	"slices"
 
 	"github.com/Masterminds/semver/v3"
 
 	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	deployv1alpha1 "github.com/kcp-dev/kcp-operator/sdk/apis/deploy/v1alpha1"
 	operatorv1alpha1 "github.com/kcp-dev/kcp-operator/sdk/apis/operator/v1alpha1"
@@ -46,6 +48,19 @@ const (
 	// the .prow.yaml accordingly and shift the jobs.
 	ImageTag = "v0.32.3"
 
+	// EtcdImageRepository is the default image repository for operator-managed etcd clusters.
+	EtcdImageRepository = "quay.io/coreos/etcd"
+
+	// EtcdImageTag is the default tag for operator-managed etcd clusters.
+	EtcdImageTag = "v3.5.21"
+
+	// MinIOClientImageRepository is the default image repository for the MinIO client that
+	// transfers etcd snapshots from and to S3-compatible object storages.
+	MinIOClientImageRepository = "quay.io/minio/mc"
+
+	// MinIOClientImageTag is the default tag for the MinIO client.
+	MinIOClientImageTag = "RELEASE.2025-07-21T05-28-08Z"
+
 	appNameLabel      = "app.kubernetes.io/name"
 	appInstanceLabel  = "app.kubernetes.io/instance"
 	appManagedByLabel = "app.kubernetes.io/managed-by"
@@ -60,6 +75,22 @@ const (
 	CacheServerLabel      = "operator.kcp.io/cache-server"
 	VirtualWorkspaceLabel = "operator.kcp.io/virtual-workspace"
 
+	// CertificateLabel and CALabel are placed on Certificates and hold the
+	// operatorv1alpha1.Certificate or operatorv1alpha1.CA they implement.
+	CertificateLabel = "operator.kcp.io/certificate"
+	CALabel          = "operator.kcp.io/ca"
+
+	// PlacementLabel is placed on Compiled* objects that are to be deployed into another
+	// cluster. Its value is the name of the workload cluster, see operatorv1alpha1.Placement.
+	PlacementLabel = "operator.kcp.io/placement"
+
+	// EtcdBackupScheduleLabel is placed on EtcdSnapshots created by an EtcdBackupSchedule.
+	EtcdBackupScheduleLabel = "operator.kcp.io/etcd-backup-schedule"
+
+	// RootShardNamespaceLabel is placed together with RootShardLabel on cluster-scoped objects,
+	// which cannot be owned by their RootShard.
+	RootShardNamespaceLabel = "operator.kcp.io/rootshard-namespace"
+
 	// OperatorUsername is the common name embedded in the operator's admin certificate
 	// that is created for each RootShard. This name alone has no special meaning, as
 	// the certificate also has system:masters as an organization, which is what ultimately
@@ -91,6 +122,63 @@ func GetImageSettings(imageSpec *operatorv1alpha1.ImageSpec) (string, []corev1.L
 	return fmt.Sprintf("%s:%s", repository, tag), imagePullSecrets, version
 }
 
+// GetManagedEtcdImageSettings is like GetImageSettings, but for the etcd image of an
+// operator-managed etcd cluster.
+func GetManagedEtcdImageSettings(imageSpec *operatorv1alpha1.ImageSpec) (string, []corev1.LocalObjectReference) {
+	repository := EtcdImageRepository
+	if imageSpec != nil && imageSpec.Repository != "" {
+		repository = imageSpec.Repository
+	}
+
+	tag := EtcdImageTag
+	if imageSpec != nil && imageSpec.Tag != "" {
+		tag = imageSpec.Tag
+	}
+
+	imagePullSecrets := []corev1.LocalObjectReference{}
+	if imageSpec != nil && len(imageSpec.ImagePullSecrets) > 0 {
+		imagePullSecrets = imageSpec.ImagePullSecrets
+	}
+
+	return fmt.Sprintf("%s:%s", repository, tag), imagePullSecrets
+}
+
+// GetMinIOClientImageSettings is like GetImageSettings, but for the MinIO client image.
+func GetMinIOClientImageSettings(imageSpec *operatorv1alpha1.ImageSpec) (string, []corev1.LocalObjectReference) {
+	repository := MinIOClientImageRepository
+	if imageSpec != nil && imageSpec.Repository != "" {
+		repository = imageSpec.Repository
+	}
+
+	tag := MinIOClientImageTag
+	if imageSpec != nil && imageSpec.Tag != "" {
+		tag = imageSpec.Tag
+	}
+
+	imagePullSecrets := []corev1.LocalObjectReference{}
+	if imageSpec != nil && len(imageSpec.ImagePullSecrets) > 0 {
+		imagePullSecrets = imageSpec.ImagePullSecrets
+	}
+
+	return fmt.Sprintf("%s:%s", repository, tag), imagePullSecrets
+}
+
+// SetPlacementLabel adds or removes the PlacementLabel on the given labels according to the
+// placement.
+func SetPlacementLabel(labels map[string]string, placement *operatorv1alpha1.Placement) {
+	if placement != nil && placement.Cluster != "" {
+		labels[PlacementLabel] = placement.Cluster
+	} else {
+		delete(labels, PlacementLabel)
+	}
+}
+
+// GetPlacementCluster returns the name of the workload cluster the object has been placed
+// into, or an empty string if it is deployed into the cluster it lives in.
+func GetPlacementCluster(obj metav1.Object) string {
+	return obj.GetLabels()[PlacementLabel]
+}
+
 func GetRootShardDeploymentName(r *operatorv1alpha1.RootShard) string {
 	return fmt.Sprintf("%s-kcp", r.Name)
 }
@@ -499,6 +587,78 @@ func GetNamedRootShardCAName(r deployv1alpha1.NamedRootShardSpec, caName operato
 	return fmt.Sprintf("%s-%s-ca", r.Name, caName)
 }
 
+// RotatedCAs are the CAs of a root shard that are replaced by a CA rotation. The etcd CA is
+// only trusted by etcd itself and is left alone.
+var RotatedCAs = []operatorv1alpha1.CA{
+	operatorv1alpha1.RootCA,
+	operatorv1alpha1.ServerCA,
+	operatorv1alpha1.RequestHeaderClientCA,
+	operatorv1alpha1.ClientCA,
+	operatorv1alpha1.ServiceAccountCA,
+}
+
+// RootShardTrustsPreviousCAs returns true while a CA rotation requires all components to trust
+// both the current and the previous CAs.
+func RootShardTrustsPreviousCAs(r *operatorv1alpha1.RootShard) bool {
+	if r.Status.CARotation == nil {
+		return false
+	}
+
+	switch r.Status.CARotation.Phase {
+	case operatorv1alpha1.CARotationPhasePublishingTrust, operatorv1alpha1.CARotationPhaseIssuingCAs, operatorv1alpha1.CARotationPhaseReissuingCertificates:
+		return true
+	default:
+		return false
+	}
+}
+
+// GetRootShardPreviousCAsName returns the name of the Secret that keeps the CA certificates
+// from before a CA rotation, keyed by CA.
+func GetRootShardPreviousCAsName(r *operatorv1alpha1.RootShard) string {
+	return fmt.Sprintf("%s-previous-cas", r.Name)
+}
+
+// GetRootShardTrustBundleName returns the name of the Secret that contains both the current
+// and the previous certificate of a CA during a CA rotation.
+func GetRootShardTrustBundleName(rootShardName string, caName operatorv1alpha1.CA) string {
+	if caName == operatorv1alpha1.RootCA {
+		return fmt.Sprintf("%s-ca-trust", rootShardName)
+	}
+	return fmt.Sprintf("%s-%s-ca-trust", rootShardName, caName)
+}
+
+// GetRootShardCATrustName returns the name of the Secret whose tls.crt should be trusted for
+// the given CA: the trust bundle during a CA rotation and the CA itself otherwise.
+func GetRootShardCATrustName(r *operatorv1alpha1.RootShard, caName operatorv1alpha1.CA) string {
+	if RootShardTrustsPreviousCAs(r) && slices.Contains(RotatedCAs, caName) {
+		return GetRootShardTrustBundleName(r.Name, caName)
+	}
+	return GetRootShardCAName(r, caName)
+}
+
+func GetCompiledRootShardCATrustName(r *deployv1alpha1.CompiledRootShard, caName operatorv1alpha1.CA) string {
+	if r.Spec.TrustBundles && slices.Contains(RotatedCAs, caName) {
+		return GetRootShardTrustBundleName(r.Name, caName)
+	}
+	return GetCompiledRootShardCAName(r, caName)
+}
+
+func GetNamedRootShardCATrustName(r deployv1alpha1.NamedRootShardSpec, caName operatorv1alpha1.CA) string {
+	if r.TrustBundles && slices.Contains(RotatedCAs, caName) {
+		return GetRootShardTrustBundleName(r.Name, caName)
+	}
+	return GetNamedRootShardCAName(r, caName)
+}
+
+// GetRootShardServerCABundleName returns the name of the cluster-scoped trust-manager Bundle
+// that distributes the server CA of a RootShard.
+func GetRootShardServerCABundleName(r *operatorv1alpha1.RootShard) string {
+	if r.Spec.TrustBundle != nil && r.Spec.TrustBundle.Name != "" {
+		return r.Spec.TrustBundle.Name
+	}
+	return fmt.Sprintf("%s-%s-server-ca", r.Namespace, r.Name)
+}
+
 func GetCacheServerCAName(cacheServerName string, caName operatorv1alpha1.CA) string {
 	if caName == operatorv1alpha1.RootCA {
 		return fmt.Sprintf("%s-ca", cacheServerName)
@@ -506,6 +666,106 @@ func GetCacheServerCAName(cacheServerName string, caName operatorv1alpha1.CA) st
 	return fmt.Sprintf("%s-%s-ca", cacheServerName, caName)
 }
 
+// GetManagedEtcdName returns the name of the StatefulSet and headless Service of the
+// operator-managed etcd cluster that belongs to the (root) shard with the given name.
+func GetManagedEtcdName(shardName string) string {
+	return fmt.Sprintf("%s-etcd", shardName)
+}
+
+func GetManagedEtcdResourceLabels(shardName string) map[string]string {
+	return getResourceLabels(shardName, "etcd")
+}
+
+func GetManagedEtcdCertificateName(shardName string, certName operatorv1alpha1.Certificate) string {
+	return fmt.Sprintf("%s-%s", shardName, certName)
+}
+
+// GetManagedEtcdReplicas returns the configured number of etcd members, defaulting to 3.
+func GetManagedEtcdReplicas(spec *operatorv1alpha1.ManagedEtcdSpec) int32 {
+	if spec != nil && spec.Replicas != nil {
+		return *spec.Replicas
+	}
+
+	return 3
+}
+
+// GetManagedEtcdMemberHost returns the hostname of a single etcd member, as resolvable
+// from within the namespace.
+func GetManagedEtcdMemberHost(shardName string, member int32) string {
+	name := GetManagedEtcdName(shardName)
+	return fmt.Sprintf("%s-%d.%s", name, member, name)
+}
+
+// GetManagedEtcdEndpoints returns the client URLs of all members of an operator-managed etcd cluster.
+func GetManagedEtcdEndpoints(shardName string, spec *operatorv1alpha1.ManagedEtcdSpec) []string {
+	replicas := GetManagedEtcdReplicas(spec)
+
+	endpoints := make([]string, 0, replicas)
+	for i := range replicas {
+		endpoints = append(endpoints, fmt.Sprintf("https://%s:2379", GetManagedEtcdMemberHost(shardName, i)))
+	}
+
+	return endpoints
+}
+
+// GetManagedEtcdDataVolumeClaimName returns the name of the PersistentVolumeClaim that the
+// StatefulSet creates for the given etcd member.
+func GetManagedEtcdDataVolumeClaimName(shardName string, member int32) string {
+	return fmt.Sprintf("data-%s-%d", GetManagedEtcdName(shardName), member)
+}
+
+// GetManagedEtcdDataDir returns the directory on the etcd data volumes that holds the
+// member data. It only changes when a snapshot has been restored.
+func GetManagedEtcdDataDir(annotations map[string]string) string {
+	if dir := annotations[operatorv1alpha1.EtcdDataDirAnnotation]; dir != "" {
+		return dir
+	}
+
+	return "data"
+}
+
+// IsEtcdRestoreInProgress returns true if the object carrying the given annotations is
+// currently being restored from an EtcdSnapshot.
+func IsEtcdRestoreInProgress(annotations map[string]string) bool {
+	return annotations[operatorv1alpha1.EtcdRestoreAnnotation] != ""
+}
+
+// GetEtcdSnapshotJobName returns the name of the Job that takes the snapshot from the etcd
+// endpoint with the given index.
+func GetEtcdSnapshotJobName(snapshot *operatorv1alpha1.EtcdSnapshot, endpoint int) string {
+	if endpoint == 0 {
+		return fmt.Sprintf("%s-etcd-snapshot", snapshot.Name)
+	}
+
+	return fmt.Sprintf("%s-etcd-snapshot-%d", snapshot.Name, endpoint)
+}
+
+// GetEtcdSnapshotFileName returns the name of the file (or S3 object, minus the prefix)
+// that the snapshot is stored as.
+func GetEtcdSnapshotFileName(snapshot *operatorv1alpha1.EtcdSnapshot) string {
+	return fmt.Sprintf("%s.db", snapshot.Name)
+}
+
+func GetEtcdRestoreJobName(restore *operatorv1alpha1.EtcdRestore, member int32) string {
+	return fmt.Sprintf("%s-etcd-restore-%d", restore.Name, member)
+}
+
+// UsesManagedEtcd returns true if the RootShard or any of its Shards relies on an operator-managed
+// etcd cluster and thereby needs the etcd CA.
+func UsesManagedEtcd(rootShard *operatorv1alpha1.RootShard, shards []operatorv1alpha1.Shard) bool {
+	if rootShard.Spec.Etcd.Managed != nil {
+		return true
+	}
+
+	for _, shard := range shards {
+		if shard.Spec.Etcd.Managed != nil {
+			return true
+		}
+	}
+
+	return false
+}
+
 func GetFrontProxyResourceLabels(f *operatorv1alpha1.FrontProxy) map[string]string {
 	return getResourceLabels(f.Name, "front-proxy")
 }
@@ -590,6 +850,17 @@ func GetCompiledFrontProxyServiceName(f *deployv1alpha1.CompiledFrontProxy) stri
 	return fmt.Sprintf("%s-front-proxy", f.Name)
 }
 
+// GetFrontProxyBaseHost returns the in-cluster hostname of a front-proxy, which lives in the
+// cluster domain of its root shard.
+func GetFrontProxyBaseHost(f *operatorv1alpha1.FrontProxy, r *operatorv1alpha1.RootShard) string {
+	clusterDomain := r.Spec.ClusterDomain
+	if clusterDomain == "" {
+		clusterDomain = defaultClusterDomain
+	}
+
+	return fmt.Sprintf("%s.%s.svc.%s", GetFrontProxyServiceName(f), f.Namespace, clusterDomain)
+}
+
 func GetRootShardProxyServiceName(r *operatorv1alpha1.RootShard) string {
 	return fmt.Sprintf("%s-proxy", r.Name)
 }
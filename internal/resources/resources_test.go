@@ -96,6 +96,59 @@ func TestGetImageSettings(t *testing.T) {
 // with the github.com/kcp-dev/sdk dependency. When bumping kcp to a new minor
 // version, both the go.mod dependency and the ImageTag constant must be updated
 // together; this test (run as part of CI) guards against forgetting one of them.
+func TestGetRootShardCATrustName(t *testing.T) {
+	tests := []struct {
+		name     string
+		phase    operatorv1alpha1.CARotationPhase
+		ca       operatorv1alpha1.CA
+		expected string
+	}{
+		{
+			name:     "no rotation",
+			ca:       operatorv1alpha1.ServerCA,
+			expected: "root-server-ca",
+		},
+		{
+			name:     "root CA during rotation",
+			phase:    operatorv1alpha1.CARotationPhasePublishingTrust,
+			ca:       operatorv1alpha1.RootCA,
+			expected: "root-ca-trust",
+		},
+		{
+			name:     "intermediate CA during rotation",
+			phase:    operatorv1alpha1.CARotationPhaseReissuingCertificates,
+			ca:       operatorv1alpha1.ClientCA,
+			expected: "root-client-ca-trust",
+		},
+		{
+			name:     "etcd CA is not rotated",
+			phase:    operatorv1alpha1.CARotationPhaseIssuingCAs,
+			ca:       operatorv1alpha1.EtcdCA,
+			expected: "root-etcd-ca",
+		},
+		{
+			name:     "trust is removed at the end of the rotation",
+			phase:    operatorv1alpha1.CARotationPhaseRemovingTrust,
+			ca:       operatorv1alpha1.ServerCA,
+			expected: "root-server-ca",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rootShard := &operatorv1alpha1.RootShard{}
+			rootShard.Name = "root"
+			if tt.phase != "" {
+				rootShard.Status.CARotation = &operatorv1alpha1.CARotationStatus{Phase: tt.phase}
+			}
+
+			if name := GetRootShardCATrustName(rootShard, tt.ca); name != tt.expected {
+				t.Errorf("expected %q, got %q", tt.expected, name)
+			}
+		})
+	}
+}
+
 func TestImageTagMatchesSDKVersion(t *testing.T) {
 	const sdkModule = "github.com/kcp-dev/sdk"
 
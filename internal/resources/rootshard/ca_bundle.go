@@ -40,18 +40,15 @@ func MergedCABundleSecretReconciler(ctx context.Context, rootShard *operatorv1al
 			}
 
 			// Get ServerCA certificate
-			serverCACert, err := fetchTLSCert(ctx, kubeClient, rootShard.Namespace, resources.GetRootShardCAName(rootShard, operatorv1alpha1.ServerCA))
+			serverCACert, err := fetchTLSCert(ctx, kubeClient, rootShard.Namespace, resources.GetRootShardCATrustName(rootShard, operatorv1alpha1.ServerCA))
 			if err != nil {
 				return nil, fmt.Errorf("failed to get ServerCA: %w", err)
 			}
 
 			// Get user-provided CA bundle if specified
-			var userCABundle []byte
-			if rootShard.Spec.CABundleSecretRef != nil {
-				userCABundle, err = fetchTLSCert(ctx, kubeClient, rootShard.Namespace, rootShard.Spec.CABundleSecretRef.Name)
-				if err != nil {
-					return nil, fmt.Errorf("failed to get user CA bundle: %w", err)
-				}
+			userCABundle, err := utils.FetchCABundle(ctx, kubeClient, rootShard.Namespace, rootShard.Spec.CABundleSecretRef, rootShard.Spec.CABundleConfigMapRef)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get user CA bundle: %w", err)
 			}
 
 			secret.Data["tls.crt"] = utils.MergeCertificates(serverCACert, userCABundle)
@@ -76,7 +73,7 @@ func MergedClientCABundleSecretReconciler(ctx context.Context, rootShard *operat
 			}
 
 			// Get ClientCA certificate
-			clientCACert, err := fetchTLSCert(ctx, kubeClient, rootShard.Namespace, resources.GetRootShardCAName(rootShard, operatorv1alpha1.ClientCA))
+			clientCACert, err := fetchTLSCert(ctx, kubeClient, rootShard.Namespace, resources.GetRootShardCATrustName(rootShard, operatorv1alpha1.ClientCA))
 			if err != nil {
 				return nil, fmt.Errorf("failed to get ClientCA: %w", err)
 			}
@@ -38,6 +38,7 @@ func RootCACertificateReconciler(rootShard *operatorv1alpha1.RootShard) reconcil
 	return func() (string, reconciling.CertificateReconciler) {
 		return name, func(cert *certmanagerv1.Certificate) (*certmanagerv1.Certificate, error) {
 			cert.SetLabels(resources.GetRootShardResourceLabels(rootShard))
+			utils.SetCAKind(cert, operatorv1alpha1.RootCA)
 
 			cert.Spec = certmanagerv1.CertificateSpec{
 				IsCA:       true,
@@ -75,6 +76,7 @@ func CACertificateReconciler(rootShard *operatorv1alpha1.RootShard, ca operatorv
 	return func() (string, reconciling.CertificateReconciler) {
 		return name, func(cert *certmanagerv1.Certificate) (*certmanagerv1.Certificate, error) {
 			cert.SetLabels(resources.GetRootShardResourceLabels(rootShard))
+			utils.SetCAKind(cert, ca)
 			cert.Spec = certmanagerv1.CertificateSpec{
 				IsCA:       true,
 				CommonName: name,
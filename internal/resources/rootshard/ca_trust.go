@@ -0,0 +1,96 @@
+/*
+Copyright 2026 The kcp Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package rootshard
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+
+	k8creconciling "k8c.io/reconciler/pkg/reconciling"
+
+	corev1 "k8s.io/api/core/v1"
+	ctrlruntimeclient "sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/kcp-dev/kcp-operator/internal/resources"
+	"github.com/kcp-dev/kcp-operator/internal/resources/utils"
+	operatorv1alpha1 "github.com/kcp-dev/kcp-operator/sdk/apis/operator/v1alpha1"
+)
+
+// PreviousCAsSecretReconciler records the certificates of the rotated CAs, keyed by CA, before
+// they are replaced. CAs that have already been recorded are never overwritten, so the
+// Secret keeps the CAs from the start of the rotation.
+func PreviousCAsSecretReconciler(ctx context.Context, rootShard *operatorv1alpha1.RootShard, kubeClient ctrlruntimeclient.Client) k8creconciling.NamedSecretReconcilerFactory {
+	return func() (string, k8creconciling.SecretReconciler) {
+		return resources.GetRootShardPreviousCAsName(rootShard), func(secret *corev1.Secret) (*corev1.Secret, error) {
+			if secret.Data == nil {
+				secret.Data = make(map[string][]byte)
+			}
+
+			for _, ca := range resources.RotatedCAs {
+				if _, exists := secret.Data[string(ca)]; exists {
+					continue
+				}
+
+				cert, err := fetchTLSCert(ctx, kubeClient, rootShard.Namespace, resources.GetRootShardCAName(rootShard, ca))
+				if err != nil {
+					return nil, fmt.Errorf("failed to get %s CA: %w", ca, err)
+				}
+
+				secret.Data[string(ca)] = cert
+			}
+
+			return secret, nil
+		}
+	}
+}
+
+// CATrustBundleSecretReconciler merges the current and the previous certificate of a CA, so that
+// certificates issued by either are trusted while the CA is rotated.
+func CATrustBundleSecretReconciler(ctx context.Context, rootShard *operatorv1alpha1.RootShard, ca operatorv1alpha1.CA, kubeClient ctrlruntimeclient.Client) k8creconciling.NamedSecretReconcilerFactory {
+	return func() (string, k8creconciling.SecretReconciler) {
+		return resources.GetRootShardTrustBundleName(rootShard.Name, ca), func(secret *corev1.Secret) (*corev1.Secret, error) {
+			current, err := fetchTLSCert(ctx, kubeClient, rootShard.Namespace, resources.GetRootShardCAName(rootShard, ca))
+			if err != nil {
+				return nil, fmt.Errorf("failed to get %s CA: %w", ca, err)
+			}
+
+			previous := &corev1.Secret{}
+			if err := kubeClient.Get(ctx, ctrlruntimeclient.ObjectKey{Namespace: rootShard.Namespace, Name: resources.GetRootShardPreviousCAsName(rootShard)}, previous); err != nil {
+				return nil, fmt.Errorf("failed to get previous CAs: %w", err)
+			}
+
+			// Until the CA has been replaced, both are the same.
+			previousCert := previous.Data[string(ca)]
+			if bytes.Equal(current, previousCert) {
+				previousCert = nil
+			}
+
+			secret.Data = map[string][]byte{
+				"tls.crt": utils.MergeCertificates(current, previousCert),
+			}
+
+			// The syncer copies the trust bundles along with the other Secrets of the root shard.
+			if secret.Labels == nil {
+				secret.Labels = make(map[string]string)
+			}
+			secret.Labels[resources.RootShardLabel] = rootShard.Name
+
+			return secret, nil
+		}
+	}
+}
@@ -37,6 +37,7 @@ func ServerCertificateReconciler(rootShard *operatorv1alpha1.RootShard) reconcil
 	return func() (string, reconciling.CertificateReconciler) {
 		return name, func(cert *certmanagerv1.Certificate) (*certmanagerv1.Certificate, error) {
 			cert.SetLabels(resources.GetRootShardResourceLabels(rootShard))
+			utils.SetCertificateKind(cert, certKind)
 			cert.Spec = certmanagerv1.CertificateSpec{
 				SecretName: name,
 				SecretTemplate: &certmanagerv1.CertificateSecretTemplate{
@@ -81,6 +82,7 @@ func VirtualWorkspacesCertificateReconciler(rootShard *operatorv1alpha1.RootShar
 	return func() (string, reconciling.CertificateReconciler) {
 		return name, func(cert *certmanagerv1.Certificate) (*certmanagerv1.Certificate, error) {
 			cert.SetLabels(resources.GetRootShardResourceLabels(rootShard))
+			utils.SetCertificateKind(cert, certKind)
 			cert.Spec = certmanagerv1.CertificateSpec{
 				SecretName: name,
 				SecretTemplate: &certmanagerv1.CertificateSecretTemplate{
@@ -125,6 +127,7 @@ func ServiceAccountCertificateReconciler(rootShard *operatorv1alpha1.RootShard)
 	return func() (string, reconciling.CertificateReconciler) {
 		return name, func(cert *certmanagerv1.Certificate) (*certmanagerv1.Certificate, error) {
 			cert.SetLabels(resources.GetRootShardResourceLabels(rootShard))
+			utils.SetCertificateKind(cert, certKind)
 			cert.Spec = certmanagerv1.CertificateSpec{
 				CommonName: name,
 				SecretName: name,
@@ -167,6 +170,7 @@ func LogicalClusterAdminCertificateReconciler(rootShard *operatorv1alpha1.RootSh
 	return func() (string, reconciling.CertificateReconciler) {
 		return name, func(cert *certmanagerv1.Certificate) (*certmanagerv1.Certificate, error) {
 			cert.SetLabels(resources.GetRootShardResourceLabels(rootShard))
+			utils.SetCertificateKind(cert, certKind)
 			cert.Spec = certmanagerv1.CertificateSpec{
 				CommonName: "logical-cluster-admin",
 				SecretName: name,
@@ -212,6 +216,7 @@ func ExternalLogicalClusterAdminCertificateReconciler(rootShard *operatorv1alpha
 	return func() (string, reconciling.CertificateReconciler) {
 		return name, func(cert *certmanagerv1.Certificate) (*certmanagerv1.Certificate, error) {
 			cert.SetLabels(resources.GetRootShardResourceLabels(rootShard))
+			utils.SetCertificateKind(cert, certKind)
 			cert.Spec = certmanagerv1.CertificateSpec{
 				CommonName: "external-logical-cluster-admin",
 				SecretName: name,
@@ -257,6 +262,7 @@ func OperatorClientCertificateReconciler(rootShard *operatorv1alpha1.RootShard)
 	return func() (string, reconciling.CertificateReconciler) {
 		return name, func(cert *certmanagerv1.Certificate) (*certmanagerv1.Certificate, error) {
 			cert.SetLabels(resources.GetRootShardResourceLabels(rootShard))
+			utils.SetCertificateKind(cert, certKind)
 			cert.Spec = certmanagerv1.CertificateSpec{
 				CommonName: resources.OperatorUsername,
 				SecretName: name,
@@ -308,6 +314,7 @@ func ClientCertificateReconciler(rootShard *operatorv1alpha1.RootShard) reconcil
 	return func() (string, reconciling.CertificateReconciler) {
 		return name, func(cert *certmanagerv1.Certificate) (*certmanagerv1.Certificate, error) {
 			cert.SetLabels(resources.GetRootShardResourceLabels(rootShard))
+			utils.SetCertificateKind(cert, certKind)
 			cert.Spec = certmanagerv1.CertificateSpec{
 				SecretName: name,
 				SecretTemplate: &certmanagerv1.CertificateSecretTemplate{
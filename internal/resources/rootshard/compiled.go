@@ -46,6 +46,7 @@ func CompiledRootShardReconciler(rootShard *operatorv1alpha1.RootShard, kcpVW *o
 				obj.Labels = make(map[string]string)
 			}
 			obj.Labels[resources.RootShardLabel] = rootShard.Name
+			resources.SetPlacementLabel(obj.Labels, rootShard.Spec.Placement)
 
 			obj.Spec.RootShard = rootShard.Spec
 
@@ -58,6 +59,7 @@ func CompiledRootShardReconciler(rootShard *operatorv1alpha1.RootShard, kcpVW *o
 			}
 
 			obj.Spec.Shards = utils.ShardNames(shards)
+			obj.Spec.TrustBundles = resources.RootShardTrustsPreviousCAs(rootShard)
 
 			return obj, nil
 		}
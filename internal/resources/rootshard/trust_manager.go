@@ -0,0 +1,95 @@
+/*
+Copyright 2026 The kcp Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package rootshard
+
+import (
+	"fmt"
+	"maps"
+
+	k8creconciling "k8c.io/reconciler/pkg/reconciling"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	"github.com/kcp-dev/kcp-operator/internal/resources"
+	operatorv1alpha1 "github.com/kcp-dev/kcp-operator/sdk/apis/operator/v1alpha1"
+)
+
+// trust-manager is an optional dependency, so its Bundles are handled as unstructured objects.
+const (
+	TrustBundleAPIVersion = "trust.cert-manager.io/v1alpha1"
+	TrustBundleKind       = "Bundle"
+
+	defaultTrustBundleKey = "ca.crt"
+)
+
+// TrustBundleLabels returns the labels that identify the Bundles of a RootShard. Bundles are
+// cluster-scoped and can therefore not be owned by the RootShard.
+func TrustBundleLabels(rootShard *operatorv1alpha1.RootShard) map[string]string {
+	return map[string]string{
+		resources.RootShardLabel:          rootShard.Name,
+		resources.RootShardNamespaceLabel: rootShard.Namespace,
+	}
+}
+
+// ServerCABundleReconciler publishes the given CA certificates as a trust-manager Bundle.
+// trust-manager only reads Secrets and ConfigMaps from its own trust namespace, so the
+// certificates are embedded into the Bundle instead of referencing the CA Secret.
+func ServerCABundleReconciler(rootShard *operatorv1alpha1.RootShard, caBundle []byte) k8creconciling.NamedUnstructuredReconcilerFactory {
+	return func() (string, string, string, k8creconciling.UnstructuredReconciler) {
+		return resources.GetRootShardServerCABundleName(rootShard), TrustBundleKind, TrustBundleAPIVersion, func(bundle *unstructured.Unstructured) (*unstructured.Unstructured, error) {
+			spec := rootShard.Spec.TrustBundle
+
+			key := spec.Key
+			if key == "" {
+				key = defaultTrustBundleKey
+			}
+
+			target := map[string]any{
+				"configMap": map[string]any{
+					"key": key,
+				},
+			}
+
+			if spec.NamespaceSelector != nil {
+				selector, err := runtime.DefaultUnstructuredConverter.ToUnstructured(spec.NamespaceSelector)
+				if err != nil {
+					return nil, fmt.Errorf("failed to convert namespace selector: %w", err)
+				}
+				target["namespaceSelector"] = selector
+			}
+
+			labels := bundle.GetLabels()
+			if labels == nil {
+				labels = map[string]string{}
+			}
+			maps.Copy(labels, TrustBundleLabels(rootShard))
+			bundle.SetLabels(labels)
+
+			if err := unstructured.SetNestedField(bundle.Object, map[string]any{
+				"sources": []any{
+					map[string]any{"inLine": string(caBundle)},
+				},
+				"target": target,
+			}, "spec"); err != nil {
+				return nil, fmt.Errorf("failed to set Bundle spec: %w", err)
+			}
+
+			return bundle, nil
+		}
+	}
+}
@@ -46,12 +46,9 @@ func MergedCABundleSecretReconciler(ctx context.Context, shard *operatorv1alpha1
 			}
 
 			// Get user-provided CA bundle if specified
-			var userCABundle []byte
-			if shard.Spec.CABundleSecretRef != nil {
-				userCABundle, err = fetchTLSCert(ctx, kubeClient, shard.Namespace, shard.Spec.CABundleSecretRef.Name)
-				if err != nil {
-					return nil, fmt.Errorf("failed to get user CA bundle: %w", err)
-				}
+			userCABundle, err := utils.FetchCABundle(ctx, kubeClient, shard.Namespace, shard.Spec.CABundleSecretRef, shard.Spec.CABundleConfigMapRef)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get user CA bundle: %w", err)
 			}
 
 			secret.Data["tls.crt"] = utils.MergeCertificates(serverCACert, userCABundle)
@@ -76,7 +73,7 @@ func MergedClientCABundleSecretReconciler(ctx context.Context, shard *operatorv1
 			}
 
 			// Get ClientCA certificate from RootShard
-			clientCACert, err := fetchTLSCert(ctx, kubeClient, rootShard.Namespace, resources.GetRootShardCAName(rootShard, operatorv1alpha1.ClientCA))
+			clientCACert, err := fetchTLSCert(ctx, kubeClient, rootShard.Namespace, resources.GetRootShardCATrustName(rootShard, operatorv1alpha1.ClientCA))
 			if err != nil {
 				return nil, fmt.Errorf("failed to get ClientCA: %w", err)
 			}
@@ -47,12 +47,14 @@ func CompiledShardReconciler(shard *operatorv1alpha1.Shard, rootShard *operatorv
 			}
 			obj.Labels[resources.ShardLabel] = shard.Name
 			obj.Labels[resources.RootShardLabel] = rootShard.Name
+			resources.SetPlacementLabel(obj.Labels, shard.Spec.Placement)
 
 			obj.Spec.Shard = shard.Spec
 
 			obj.Spec.RootShard = deployv1alpha1.NamedRootShardSpec{
-				Name: rootShard.Name,
-				Spec: rootShard.Spec,
+				Name:         rootShard.Name,
+				Spec:         rootShard.Spec,
+				TrustBundles: resources.RootShardTrustsPreviousCAs(rootShard),
 			}
 
 			obj.Spec.VirtualWorkspace = nil
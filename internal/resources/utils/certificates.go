@@ -18,6 +18,7 @@ package utils
 
 import (
 	"bytes"
+	"context"
 	"crypto/x509"
 	"encoding/pem"
 	"fmt"
@@ -27,8 +28,11 @@ import (
 	cmmeta "github.com/cert-manager/cert-manager/pkg/apis/meta/v1"
 
 	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/apimachinery/pkg/util/sets"
+	ctrlruntimeclient "sigs.k8s.io/controller-runtime/pkg/client"
 
+	"github.com/kcp-dev/kcp-operator/internal/resources"
 	operatorv1alpha1 "github.com/kcp-dev/kcp-operator/sdk/apis/operator/v1alpha1"
 )
 
@@ -51,6 +55,16 @@ func mergeSlices(a, b []string) []string {
 	return sets.List(sets.New(a...).Insert(b...))
 }
 
+// SetCertificateKind labels cert with the operatorv1alpha1.Certificate it implements.
+func SetCertificateKind(cert *certmanagerv1.Certificate, kind operatorv1alpha1.Certificate) {
+	cert.Labels = mergeMaps(map[string]string{resources.CertificateLabel: string(kind)}, cert.Labels)
+}
+
+// SetCAKind labels cert with the operatorv1alpha1.CA it implements.
+func SetCAKind(cert *certmanagerv1.Certificate, ca operatorv1alpha1.CA) {
+	cert.Labels = mergeMaps(map[string]string{resources.CALabel: string(ca)}, cert.Labels)
+}
+
 func ApplyCertificateTemplate(cert *certmanagerv1.Certificate, tpl *operatorv1alpha1.CertificateTemplate) *certmanagerv1.Certificate {
 	if tpl == nil {
 		return cert
@@ -167,8 +181,8 @@ func applyCertificateSubjectTemplate(subj *certmanagerv1.X509Subject, tpl *opera
 	return subj
 }
 
-// validatePEMCertificate validates that the given data contains valid PEM-encoded certificates.
-func validatePEMCertificate(data []byte) error {
+// ValidatePEMCertificate validates that the given data contains valid PEM-encoded certificates.
+func ValidatePEMCertificate(data []byte) error {
 	if len(data) == 0 {
 		return nil
 	}
@@ -223,6 +237,47 @@ func MergeCertificateSecrets(secrets ...*corev1.Secret) ([]byte, error) {
 	return MergeCertificates(certs...), nil
 }
 
+// HasCABundle returns true if a user-provided CA bundle is configured, either in a Secret or
+// in a ConfigMap.
+func HasCABundle(secretRef *corev1.LocalObjectReference, configMapRef *operatorv1alpha1.LocalDataKeyReference) bool {
+	return secretRef != nil || configMapRef != nil
+}
+
+// FetchCABundle returns the user-provided CA bundle, which is either stored in the `tls.crt` key
+// of a Secret or in the given key of a ConfigMap, e.g. one that trust-manager creates for a
+// Bundle. It returns nil if no CA bundle is configured.
+func FetchCABundle(ctx context.Context, client ctrlruntimeclient.Client, namespace string, secretRef *corev1.LocalObjectReference, configMapRef *operatorv1alpha1.LocalDataKeyReference) ([]byte, error) {
+	switch {
+	case secretRef != nil:
+		secret := &corev1.Secret{}
+		if err := client.Get(ctx, types.NamespacedName{Namespace: namespace, Name: secretRef.Name}, secret); err != nil {
+			return nil, fmt.Errorf("failed to get secret %s: %w", secretRef.Name, err)
+		}
+
+		data, exists := secret.Data["tls.crt"]
+		if !exists {
+			return nil, fmt.Errorf("secret %s missing tls.crt", secretRef.Name)
+		}
+
+		return data, nil
+
+	case configMapRef != nil:
+		configMap := &corev1.ConfigMap{}
+		if err := client.Get(ctx, types.NamespacedName{Namespace: namespace, Name: configMapRef.Name}, configMap); err != nil {
+			return nil, fmt.Errorf("failed to get configmap %s: %w", configMapRef.Name, err)
+		}
+
+		data, exists := configMap.Data[configMapRef.Key]
+		if !exists {
+			return nil, fmt.Errorf("configmap %s missing %s", configMapRef.Name, configMapRef.Key)
+		}
+
+		return []byte(data), nil
+	}
+
+	return nil, nil
+}
+
 func getCertFromSecret(secret *corev1.Secret) ([]byte, error) {
 	if secret == nil {
 		return nil, nil
@@ -233,7 +288,7 @@ func getCertFromSecret(secret *corev1.Secret) ([]byte, error) {
 		return nil, nil
 	}
 
-	if err := validatePEMCertificate(cert); err != nil {
+	if err := ValidatePEMCertificate(cert); err != nil {
 		return nil, err
 	}
 
@@ -19,7 +19,11 @@ package utils
 import (
 	"testing"
 
+	certmanagerv1 "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
 	"github.com/stretchr/testify/assert"
+
+	"github.com/kcp-dev/kcp-operator/internal/resources"
+	operatorv1alpha1 "github.com/kcp-dev/kcp-operator/sdk/apis/operator/v1alpha1"
 )
 
 func TestValidatePEMCertificate(t *testing.T) {
@@ -109,7 +113,7 @@ YWJjZGVmZ2hpams=
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			err := validatePEMCertificate(tt.data)
+			err := ValidatePEMCertificate(tt.data)
 
 			if tt.wantErr {
 				assert.Error(t, err)
@@ -122,3 +126,20 @@ YWJjZGVmZ2hpams=
 		})
 	}
 }
+
+func TestSetCertificateKind(t *testing.T) {
+	// Some reconcilers use the labels of their owner, which must not be modified.
+	ownerLabels := map[string]string{"foo": "bar"}
+
+	cert := &certmanagerv1.Certificate{}
+	cert.SetLabels(ownerLabels)
+	SetCertificateKind(cert, operatorv1alpha1.ClientCertificate)
+
+	assert.Equal(t, map[string]string{"foo": "bar", resources.CertificateLabel: "client"}, cert.Labels)
+	assert.Equal(t, map[string]string{"foo": "bar"}, ownerLabels)
+
+	ca := &certmanagerv1.Certificate{}
+	SetCAKind(ca, operatorv1alpha1.RootCA)
+
+	assert.Equal(t, map[string]string{resources.CALabel: "root"}, ca.Labels)
+}
@@ -0,0 +1,34 @@
+/*
+Copyright 2026 The kcp Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package utils
+
+import (
+	"context"
+
+	ctrlruntimeclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// DeleteIfExists deletes the named object, which is used for optional resources that are no longer
+// wanted. The object is looked up first, so that an object that does not exist (the common case)
+// does not cause a request to the API server.
+func DeleteIfExists(ctx context.Context, client ctrlruntimeclient.Client, namespace, name string, obj ctrlruntimeclient.Object) error {
+	if err := client.Get(ctx, ctrlruntimeclient.ObjectKey{Namespace: namespace, Name: name}, obj); err != nil {
+		return ctrlruntimeclient.IgnoreNotFound(err)
+	}
+
+	return ctrlruntimeclient.IgnoreNotFound(client.Delete(ctx, obj))
+}
@@ -0,0 +1,109 @@
+/*
+Copyright 2026 The kcp Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package utils
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	appsv1 "k8s.io/api/apps/v1"
+	"k8s.io/utils/ptr"
+
+	operatorv1alpha1 "github.com/kcp-dev/kcp-operator/sdk/apis/operator/v1alpha1"
+)
+
+func TestApplyEtcdConfiguration(t *testing.T) {
+	tests := []struct {
+		name           string
+		config         operatorv1alpha1.EtcdConfig
+		expectedArgs   []string
+		expectedSecret string
+	}{
+		{
+			name: "external etcd without TLS",
+			config: operatorv1alpha1.EtcdConfig{
+				Endpoints: []string{"https://etcd-a:2379", "https://etcd-b:2379"},
+			},
+			expectedArgs: []string{
+				"--etcd-servers=https://etcd-a:2379,https://etcd-b:2379",
+			},
+		},
+		{
+			name: "external etcd with TLS",
+			config: operatorv1alpha1.EtcdConfig{
+				Endpoints: []string{"https://etcd:2379"},
+				TLSConfig: &operatorv1alpha1.EtcdTLSConfig{},
+				Prefix:    "/kcp",
+			},
+			expectedArgs: []string{
+				"--etcd-servers=https://etcd:2379",
+				"--etcd-prefix=/kcp",
+				"--etcd-certfile=/etc/etcd/tls/tls.crt",
+			},
+		},
+		{
+			name: "managed etcd",
+			config: operatorv1alpha1.EtcdConfig{
+				Managed: &operatorv1alpha1.ManagedEtcdSpec{
+					Replicas: ptr.To[int32](3),
+				},
+			},
+			expectedArgs: []string{
+				"--etcd-servers=https://shardy-etcd-0.shardy-etcd:2379,https://shardy-etcd-1.shardy-etcd:2379,https://shardy-etcd-2.shardy-etcd:2379",
+				"--etcd-cafile=/etc/etcd/tls/ca.crt",
+			},
+			expectedSecret: "shardy-etcd-client",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			deployment := applyEtcdConfiguration(newTestDeploymentWithContainer(), "shardy", tt.config)
+
+			args := deployment.Spec.Template.Spec.Containers[0].Args
+			for _, arg := range tt.expectedArgs {
+				assert.Contains(t, args, arg)
+			}
+
+			if tt.expectedSecret != "" {
+				volumes := deployment.Spec.Template.Spec.Volumes
+				require.Len(t, volumes, 1)
+				assert.Equal(t, tt.expectedSecret, volumes[0].Secret.SecretName)
+			}
+		})
+	}
+}
+
+func TestApplyEtcdRestoreState(t *testing.T) {
+	dep := &appsv1.Deployment{
+		Spec: appsv1.DeploymentSpec{Replicas: ptr.To[int32](4)},
+	}
+
+	dep = ApplyEtcdRestoreState(dep, true)
+	require.Equal(t, int32(0), *dep.Spec.Replicas)
+	require.Equal(t, "4", dep.Annotations[replicasBeforeRestoreAnnotation])
+
+	// reconciling again while restoring must not forget the original replicas
+	dep = ApplyEtcdRestoreState(dep, true)
+	require.Equal(t, "4", dep.Annotations[replicasBeforeRestoreAnnotation])
+
+	dep = ApplyEtcdRestoreState(dep, false)
+	require.Equal(t, int32(4), *dep.Spec.Replicas)
+	require.NotContains(t, dep.Annotations, replicasBeforeRestoreAnnotation)
+}
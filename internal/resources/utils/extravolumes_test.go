@@ -152,7 +152,7 @@ func TestApplyCommonShardConfigAppliesExtraVolumes(t *testing.T) {
 		}},
 	}
 
-	result := ApplyCommonShardConfig(deployment, spec)
+	result := ApplyCommonShardConfig(deployment, "test", spec)
 
 	volumeNames := make(map[string]corev1.Volume)
 	for _, v := range result.Spec.Template.Spec.Volumes {
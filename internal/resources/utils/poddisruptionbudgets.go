@@ -0,0 +1,89 @@
+/*
+Copyright 2026 The kcp Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package utils
+
+import (
+	"context"
+
+	"k8c.io/reconciler/pkg/reconciling"
+
+	policyv1 "k8s.io/api/policy/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/utils/ptr"
+	ctrlruntimeclient "sigs.k8s.io/controller-runtime/pkg/client"
+
+	operatorv1alpha1 "github.com/kcp-dev/kcp-operator/sdk/apis/operator/v1alpha1"
+)
+
+// PodDisruptionBudgetReconciler returns a reconciler for a PodDisruptionBudget covering all Pods
+// with the given labels. Unless the template says otherwise, one Pod may be unavailable at a time.
+func PodDisruptionBudgetReconciler(name string, labels map[string]string, tpl *operatorv1alpha1.PodDisruptionBudgetTemplate) reconciling.NamedPodDisruptionBudgetReconcilerFactory {
+	return func() (string, reconciling.PodDisruptionBudgetReconciler) {
+		return name, func(pdb *policyv1.PodDisruptionBudget) (*policyv1.PodDisruptionBudget, error) {
+			pdb.SetLabels(labels)
+			pdb.Spec.Selector = &metav1.LabelSelector{
+				MatchLabels: labels,
+			}
+			pdb.Spec.MinAvailable = nil
+			pdb.Spec.MaxUnavailable = ptr.To(intstr.FromInt32(1))
+			pdb.Spec.UnhealthyPodEvictionPolicy = nil
+
+			return ApplyPodDisruptionBudgetTemplate(pdb, tpl), nil
+		}
+	}
+}
+
+func ApplyPodDisruptionBudgetTemplate(pdb *policyv1.PodDisruptionBudget, tpl *operatorv1alpha1.PodDisruptionBudgetTemplate) *policyv1.PodDisruptionBudget {
+	if tpl == nil {
+		return pdb
+	}
+
+	if metadata := tpl.Metadata; metadata != nil {
+		pdb.Annotations = mergeMaps(pdb.Annotations, metadata.Annotations)
+		pdb.Labels = mergeMaps(pdb.Labels, metadata.Labels)
+	}
+
+	if spec := tpl.Spec; spec != nil {
+		switch {
+		case spec.MinAvailable != nil:
+			pdb.Spec.MinAvailable = spec.MinAvailable
+			pdb.Spec.MaxUnavailable = nil
+		case spec.MaxUnavailable != nil:
+			pdb.Spec.MaxUnavailable = spec.MaxUnavailable
+		}
+
+		if spec.UnhealthyPodEvictionPolicy != nil {
+			pdb.Spec.UnhealthyPodEvictionPolicy = spec.UnhealthyPodEvictionPolicy
+		}
+	}
+
+	return pdb
+}
+
+// ReconcilePodDisruptionBudget creates or updates the PodDisruptionBudget if the workload runs
+// more than one replica. A budget for a single replica would either block node drains forever
+// or protect nothing, so in that case any previously created budget is deleted instead.
+func ReconcilePodDisruptionBudget(ctx context.Context, client ctrlruntimeclient.Client, namespace string, replicas int32, factory reconciling.NamedPodDisruptionBudgetReconcilerFactory, objectModifiers ...reconciling.ObjectModifier) error {
+	if replicas > 1 {
+		return reconciling.ReconcilePodDisruptionBudgets(ctx, []reconciling.NamedPodDisruptionBudgetReconcilerFactory{factory}, namespace, client, objectModifiers...)
+	}
+
+	name, _ := factory()
+
+	return DeleteIfExists(ctx, client, namespace, name, &policyv1.PodDisruptionBudget{})
+}
@@ -0,0 +1,124 @@
+/*
+Copyright 2026 The kcp Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package utils
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	policyv1 "k8s.io/api/policy/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/utils/ptr"
+	ctrlruntimeclient "sigs.k8s.io/controller-runtime/pkg/client"
+	ctrlruntimefakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	operatorv1alpha1 "github.com/kcp-dev/kcp-operator/sdk/apis/operator/v1alpha1"
+)
+
+func TestPodDisruptionBudgetReconciler(t *testing.T) {
+	labels := map[string]string{"app.kubernetes.io/instance": "test"}
+
+	tests := []struct {
+		name     string
+		tpl      *operatorv1alpha1.PodDisruptionBudgetTemplate
+		existing *policyv1.PodDisruptionBudgetSpec
+		expected policyv1.PodDisruptionBudgetSpec
+	}{
+		{
+			name: "defaults to one unavailable Pod",
+			expected: policyv1.PodDisruptionBudgetSpec{
+				MaxUnavailable: ptr.To(intstr.FromInt32(1)),
+			},
+		},
+		{
+			name: "minAvailable replaces the default",
+			tpl: &operatorv1alpha1.PodDisruptionBudgetTemplate{
+				Spec: &operatorv1alpha1.PodDisruptionBudgetSpecTemplate{
+					MinAvailable: ptr.To(intstr.FromString("50%")),
+				},
+			},
+			expected: policyv1.PodDisruptionBudgetSpec{
+				MinAvailable: ptr.To(intstr.FromString("50%")),
+			},
+		},
+		{
+			name: "maxUnavailable and eviction policy are applied",
+			tpl: &operatorv1alpha1.PodDisruptionBudgetTemplate{
+				Spec: &operatorv1alpha1.PodDisruptionBudgetSpecTemplate{
+					MaxUnavailable:             ptr.To(intstr.FromInt32(2)),
+					UnhealthyPodEvictionPolicy: ptr.To(policyv1.AlwaysAllow),
+				},
+			},
+			expected: policyv1.PodDisruptionBudgetSpec{
+				MaxUnavailable:             ptr.To(intstr.FromInt32(2)),
+				UnhealthyPodEvictionPolicy: ptr.To(policyv1.AlwaysAllow),
+			},
+		},
+		{
+			name: "removing the template resets previous settings",
+			existing: &policyv1.PodDisruptionBudgetSpec{
+				MinAvailable:               ptr.To(intstr.FromInt32(3)),
+				UnhealthyPodEvictionPolicy: ptr.To(policyv1.AlwaysAllow),
+			},
+			expected: policyv1.PodDisruptionBudgetSpec{
+				MaxUnavailable: ptr.To(intstr.FromInt32(1)),
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			pdb := &policyv1.PodDisruptionBudget{}
+			if tt.existing != nil {
+				pdb.Spec = *tt.existing
+			}
+
+			name, reconciler := PodDisruptionBudgetReconciler("test", labels, tt.tpl)()
+			require.Equal(t, "test", name)
+
+			pdb, err := reconciler(pdb)
+			require.NoError(t, err)
+
+			tt.expected.Selector = &metav1.LabelSelector{MatchLabels: labels}
+			require.Equal(t, tt.expected, pdb.Spec)
+		})
+	}
+}
+
+func TestReconcilePodDisruptionBudget(t *testing.T) {
+	scheme := runtime.NewScheme()
+	require.NoError(t, policyv1.AddToScheme(scheme))
+
+	client := ctrlruntimefakeclient.NewClientBuilder().WithScheme(scheme).Build()
+	ctx := context.Background()
+	key := ctrlruntimeclient.ObjectKey{Namespace: "default", Name: "test"}
+	factory := PodDisruptionBudgetReconciler(key.Name, map[string]string{"app": "test"}, nil)
+
+	require.NoError(t, ReconcilePodDisruptionBudget(ctx, client, key.Namespace, 1, factory))
+	require.True(t, apierrors.IsNotFound(client.Get(ctx, key, &policyv1.PodDisruptionBudget{})), "no PodDisruptionBudget should exist for a single replica")
+
+	require.NoError(t, ReconcilePodDisruptionBudget(ctx, client, key.Namespace, 3, factory))
+	require.NoError(t, client.Get(ctx, key, &policyv1.PodDisruptionBudget{}))
+
+	require.NoError(t, ReconcilePodDisruptionBudget(ctx, client, key.Namespace, 1, factory))
+	require.True(t, apierrors.IsNotFound(client.Get(ctx, key, &policyv1.PodDisruptionBudget{})), "PodDisruptionBudget should have been deleted after scaling down")
+}
@@ -18,6 +18,7 @@ package utils
 
 import (
 	"fmt"
+	"strconv"
 	"strings"
 
 	appsv1 "k8s.io/api/apps/v1"
@@ -128,7 +129,10 @@ func ApplyCommonShardDeploymentProperties(deployment *appsv1.Deployment) *appsv1
 	return deployment
 }
 
-func ApplyCommonShardConfig(deployment *appsv1.Deployment, spec *operatorv1alpha1.CommonShardSpec) *appsv1.Deployment {
+// ApplyCommonShardConfig applies the settings shared by RootShards and Shards to the kcp
+// Deployment. shardName is the name of the (root) shard object and is used to locate
+// resources named after it, like an operator-managed etcd cluster.
+func ApplyCommonShardConfig(deployment *appsv1.Deployment, shardName string, spec *operatorv1alpha1.CommonShardSpec) *appsv1.Deployment {
 	if len(deployment.Spec.Template.Spec.Containers) == 0 {
 		panic("Deployment does not contain any containers.")
 	}
@@ -154,7 +158,7 @@ func ApplyCommonShardConfig(deployment *appsv1.Deployment, spec *operatorv1alpha
 	deployment.Spec.Template.Spec.ImagePullSecrets = imagePullSecrets
 	deployment.Spec.Template.Spec.Containers[0] = container
 
-	deployment = applyEtcdConfiguration(deployment, spec.Etcd)
+	deployment = applyEtcdConfiguration(deployment, shardName, spec.Etcd)
 	deployment = applyAuditConfiguration(deployment, spec.Audit)
 	deployment = applyAuthorizationConfiguration(deployment, spec.Authorization)
 	deployment = applyExtraVolumes(deployment, spec.ExtraVolumes, spec.ExtraVolumeMounts)
@@ -162,6 +166,42 @@ func ApplyCommonShardConfig(deployment *appsv1.Deployment, spec *operatorv1alpha
 	return deployment
 }
 
+// replicasBeforeRestoreAnnotation remembers the replica count of a kcp Deployment that has been
+// scaled down for an etcd restore, so it can be scaled back up afterwards even if the replicas
+// are not configured explicitly (e.g. because they are controlled by a HPA).
+const replicasBeforeRestoreAnnotation = "operator.kcp.io/replicas-before-restore"
+
+// ApplyEtcdRestoreState scales the kcp Deployment to zero while an etcd restore is in progress
+// and back to its previous replica count once it has finished. It must be applied after all
+// other functions that touch the replica count.
+func ApplyEtcdRestoreState(deployment *appsv1.Deployment, restoring bool) *appsv1.Deployment {
+	previous, scaledDown := deployment.Annotations[replicasBeforeRestoreAnnotation]
+
+	if restoring {
+		if !scaledDown {
+			if deployment.Annotations == nil {
+				deployment.Annotations = map[string]string{}
+			}
+			deployment.Annotations[replicasBeforeRestoreAnnotation] = strconv.Itoa(int(ptr.Deref(deployment.Spec.Replicas, 1)))
+		}
+		deployment.Spec.Replicas = ptr.To[int32](0)
+
+		return deployment
+	}
+
+	if scaledDown {
+		// replicas that are configured explicitly have already been applied
+		if ptr.Deref(deployment.Spec.Replicas, 0) == 0 {
+			if replicas, err := strconv.ParseInt(previous, 10, 32); err == nil {
+				deployment.Spec.Replicas = ptr.To(int32(replicas))
+			}
+		}
+		delete(deployment.Annotations, replicasBeforeRestoreAnnotation)
+	}
+
+	return deployment
+}
+
 // applyExtraVolumes appends user-configured extra volumes and volume mounts to the
 // shard container, e.g. to mount an EncryptionConfiguration Secret referenced by an
 // `--encryption-provider-config` flag set via ExtraArgs.
@@ -180,9 +220,20 @@ func applyExtraVolumes(deployment *appsv1.Deployment, volumes []corev1.Volume, v
 	return deployment
 }
 
-func applyEtcdConfiguration(deployment *appsv1.Deployment, config operatorv1alpha1.EtcdConfig) *appsv1.Deployment {
+func applyEtcdConfiguration(deployment *appsv1.Deployment, shardName string, config operatorv1alpha1.EtcdConfig) *appsv1.Deployment {
 	podSpec := deployment.Spec.Template.Spec
 
+	// An operator-managed etcd cluster is reached like any external one, using the
+	// client certificate the operator issued for it.
+	if config.Managed != nil {
+		config.Endpoints = resources.GetManagedEtcdEndpoints(shardName, config.Managed)
+		config.TLSConfig = &operatorv1alpha1.EtcdTLSConfig{
+			SecretRef: corev1.LocalObjectReference{
+				Name: resources.GetManagedEtcdCertificateName(shardName, operatorv1alpha1.EtcdClientCertificate),
+			},
+		}
+	}
+
 	podSpec.Containers[0].Args = append(
 		podSpec.Containers[0].Args,
 		fmt.Sprintf("--etcd-servers=%s", strings.Join(config.Endpoints, ",")),
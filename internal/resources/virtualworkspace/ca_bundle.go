@@ -40,7 +40,7 @@ func MergedClientCABundleSecretReconciler(ctx context.Context, vw *operatorv1alp
 			}
 
 			// Get ClientCA certificate from RootShard
-			clientCACert, err := fetchTLSCert(ctx, kubeClient, rootShard.Namespace, resources.GetRootShardCAName(rootShard, operatorv1alpha1.ClientCA))
+			clientCACert, err := fetchTLSCert(ctx, kubeClient, rootShard.Namespace, resources.GetRootShardCATrustName(rootShard, operatorv1alpha1.ClientCA))
 			if err != nil {
 				return nil, fmt.Errorf("failed to get ClientCA: %w", err)
 			}
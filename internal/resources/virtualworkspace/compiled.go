@@ -46,12 +46,14 @@ func CompiledVirtualWorkspaceReconciler(vw *operatorv1alpha1.VirtualWorkspace, r
 			}
 			obj.Labels[resources.VirtualWorkspaceLabel] = vw.Name
 			obj.Labels[resources.RootShardLabel] = rootShard.Name
+			resources.SetPlacementLabel(obj.Labels, vw.Spec.Placement)
 
 			obj.Spec.VirtualWorkspace = vw.Spec
 
 			obj.Spec.RootShard = deployv1alpha1.NamedRootShardSpec{
-				Name: rootShard.Name,
-				Spec: rootShard.Spec,
+				Name:         rootShard.Name,
+				Spec:         rootShard.Spec,
+				TrustBundles: resources.RootShardTrustsPreviousCAs(rootShard),
 			}
 
 			obj.Spec.Shard = nil
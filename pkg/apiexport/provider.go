@@ -0,0 +1,366 @@
+/*
+Copyright 2026 The kcp Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package apiexport implements a multicluster provider that engages every kcp workspace in
+// which the operator's APIExport has been bound. Each workspace is reached through the
+// APIExport's virtual workspace, so that the config controllers can reconcile the operator.kcp.io
+// objects that tenants create in their own workspaces.
+package apiexport
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/go-logr/logr"
+	"github.com/kcp-dev/logicalcluster/v3"
+	kcpclientset "github.com/kcp-dev/sdk/client/clientset/versioned"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/metadata"
+	"k8s.io/client-go/metadata/metadatainformer"
+	"k8s.io/client-go/rest"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/util/workqueue"
+	ctrlruntimeclient "sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/cluster"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/multicluster-runtime/pkg/multicluster"
+)
+
+const (
+	clusterIndex = "kcp-cluster"
+
+	defaultPollInterval = 30 * time.Second
+)
+
+// Options configures the provider.
+type Options struct {
+	// EndpointSlice is the name of the APIExportEndpointSlice of the operator's APIExport, in the
+	// workspace that the provider's rest.Config points to.
+	EndpointSlice string
+
+	// Scheme is used for the clients of the engaged clusters.
+	Scheme *runtime.Scheme
+
+	// Resources are the exported resources whose objects make a workspace known to the provider.
+	// A workspace is engaged as long as it contains at least one object of these resources.
+	Resources []schema.GroupVersionResource
+
+	// PollInterval is how often the APIExportEndpointSlice is checked for new endpoints.
+	// Defaults to 30 seconds.
+	PollInterval time.Duration
+}
+
+// Provider is a multicluster.Provider that engages one cluster per kcp workspace.
+type Provider struct {
+	config *rest.Config
+	opts   Options
+	log    logr.Logger
+
+	lock     sync.RWMutex
+	aware    multicluster.Aware
+	clusters map[multicluster.ClusterName]*activeCluster
+	indexers []index
+}
+
+var _ multicluster.Provider = &Provider{}
+var _ multicluster.ProviderRunnable = &Provider{}
+
+type activeCluster struct {
+	cluster  cluster.Cluster
+	endpoint string
+	cancel   context.CancelFunc
+}
+
+type index struct {
+	object       ctrlruntimeclient.Object
+	field        string
+	extractValue ctrlruntimeclient.IndexerFunc
+}
+
+// New returns a provider that discovers the virtual workspace endpoints using the given
+// rest.Config, which must point to the workspace that contains the APIExportEndpointSlice.
+func New(config *rest.Config, opts Options) (*Provider, error) {
+	if opts.EndpointSlice == "" {
+		return nil, errors.New("no APIExportEndpointSlice name given")
+	}
+	if len(opts.Resources) == 0 {
+		return nil, errors.New("no resources given")
+	}
+	if opts.PollInterval == 0 {
+		opts.PollInterval = defaultPollInterval
+	}
+
+	return &Provider{
+		config:   rest.CopyConfig(config),
+		opts:     opts,
+		log:      log.Log.WithName("apiexport-provider"),
+		clusters: map[multicluster.ClusterName]*activeCluster{},
+	}, nil
+}
+
+// Get returns the cluster for the given workspace.
+func (p *Provider) Get(_ context.Context, clusterName multicluster.ClusterName) (cluster.Cluster, error) {
+	p.lock.RLock()
+	defer p.lock.RUnlock()
+
+	if ac, ok := p.clusters[clusterName]; ok {
+		return ac.cluster, nil
+	}
+
+	return nil, multicluster.ErrClusterNotFound
+}
+
+// IndexField indexes the given object by the given field on all engaged clusters, current and future.
+func (p *Provider) IndexField(ctx context.Context, obj ctrlruntimeclient.Object, field string, extractValue ctrlruntimeclient.IndexerFunc) error {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+
+	p.indexers = append(p.indexers, index{object: obj, field: field, extractValue: extractValue})
+
+	var errs []error
+	for name, ac := range p.clusters {
+		if err := ac.cluster.GetFieldIndexer().IndexField(ctx, obj, field, extractValue); err != nil {
+			errs = append(errs, fmt.Errorf("failed to index field %q on cluster %q: %w", field, name, err))
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// Start watches the APIExportEndpointSlice and engages the workspaces behind each of its
+// endpoints with the given Aware. It blocks until the context is cancelled.
+func (p *Provider) Start(ctx context.Context, aware multicluster.Aware) error {
+	p.lock.Lock()
+	p.aware = aware
+	p.lock.Unlock()
+
+	client, err := kcpclientset.NewForConfig(p.config)
+	if err != nil {
+		return fmt.Errorf("failed to create kcp client: %w", err)
+	}
+
+	endpoints := map[string]context.CancelFunc{}
+
+	wait.UntilWithContext(ctx, func(ctx context.Context) {
+		slice, err := client.ApisV1alpha1().APIExportEndpointSlices().Get(ctx, p.opts.EndpointSlice, metav1.GetOptions{})
+		if err != nil {
+			p.log.Error(err, "Failed to get APIExportEndpointSlice", "name", p.opts.EndpointSlice)
+			return
+		}
+
+		current := sets.New[string]()
+		for _, endpoint := range slice.Status.APIExportEndpoints {
+			current.Insert(endpoint.URL)
+		}
+
+		for url, cancel := range endpoints {
+			if !current.Has(url) {
+				p.log.Info("Stopping to watch endpoint", "url", url)
+				cancel()
+				delete(endpoints, url)
+			}
+		}
+
+		for url := range current {
+			if _, ok := endpoints[url]; !ok {
+				p.log.Info("Starting to watch endpoint", "url", url)
+				endpointCtx, cancel := context.WithCancel(ctx)
+				endpoints[url] = cancel
+				go p.watchEndpoint(endpointCtx, url)
+			}
+		}
+	}, p.opts.PollInterval)
+
+	return nil
+}
+
+// watchEndpoint engages and disengages the workspaces behind one virtual workspace endpoint,
+// depending on whether they contain any objects of the configured resources.
+func (p *Provider) watchEndpoint(ctx context.Context, url string) {
+	defer p.disengageEndpoint(url)
+
+	config := rest.CopyConfig(p.config)
+	config.Host = strings.TrimSuffix(url, "/") + logicalcluster.Wildcard.RequestPath()
+
+	client, err := metadata.NewForConfig(config)
+	if err != nil {
+		p.log.Error(err, "Failed to create client", "url", url)
+		return
+	}
+
+	queue := workqueue.NewTypedRateLimitingQueue(workqueue.DefaultTypedControllerRateLimiter[logicalcluster.Name]())
+	defer queue.ShutDown()
+
+	enqueue := func(obj any) {
+		if tombstone, ok := obj.(cache.DeletedFinalStateUnknown); ok {
+			obj = tombstone.Obj
+		}
+		if o, ok := obj.(metav1.Object); ok {
+			if name := logicalcluster.From(o); !name.Empty() {
+				queue.Add(name)
+			}
+		}
+	}
+
+	var informers []cache.SharedIndexInformer
+	for _, gvr := range p.opts.Resources {
+		informer := metadatainformer.NewFilteredMetadataInformer(client, gvr, metav1.NamespaceAll, 0, cache.Indexers{clusterIndex: indexByCluster}, nil).Informer()
+		// The cluster of an object never changes, so updates can be ignored.
+		if _, err := informer.AddEventHandler(cache.ResourceEventHandlerFuncs{AddFunc: enqueue, DeleteFunc: enqueue}); err != nil {
+			p.log.Error(err, "Failed to add event handler", "url", url)
+			return
+		}
+		informers = append(informers, informer)
+	}
+
+	for _, informer := range informers {
+		go informer.Run(ctx.Done())
+	}
+
+	go func() {
+		<-ctx.Done()
+		queue.ShutDown()
+	}()
+
+	for {
+		name, shutdown := queue.Get()
+		if shutdown {
+			return
+		}
+
+		if err := p.sync(ctx, url, name, informers); err != nil {
+			p.log.Error(err, "Failed to sync workspace", "cluster", name)
+			queue.AddRateLimited(name)
+		} else {
+			queue.Forget(name)
+		}
+
+		queue.Done(name)
+	}
+}
+
+func (p *Provider) sync(ctx context.Context, url string, name logicalcluster.Name, informers []cache.SharedIndexInformer) error {
+	for _, informer := range informers {
+		objects, err := informer.GetIndexer().ByIndex(clusterIndex, name.String())
+		if err != nil {
+			return err
+		}
+
+		if len(objects) > 0 {
+			return p.engage(ctx, url, multicluster.ClusterName(name))
+		}
+	}
+
+	p.disengage(multicluster.ClusterName(name))
+
+	return nil
+}
+
+func (p *Provider) engage(ctx context.Context, url string, name multicluster.ClusterName) error {
+	p.lock.RLock()
+	_, exists := p.clusters[name]
+	indexers := p.indexers
+	p.lock.RUnlock()
+
+	if exists {
+		return nil
+	}
+
+	logger := p.log.WithValues("cluster", name)
+	logger.Info("Engaging workspace")
+
+	config := rest.CopyConfig(p.config)
+	config.Host = strings.TrimSuffix(url, "/") + logicalcluster.Name(name).Path().RequestPath()
+
+	cl, err := cluster.New(config, func(o *cluster.Options) {
+		o.Scheme = p.opts.Scheme
+	})
+	if err != nil {
+		return fmt.Errorf("failed to create cluster: %w", err)
+	}
+
+	for _, idx := range indexers {
+		if err := cl.GetFieldIndexer().IndexField(ctx, idx.object, idx.field, idx.extractValue); err != nil {
+			return fmt.Errorf("failed to index field %q: %w", idx.field, err)
+		}
+	}
+
+	clusterCtx, cancel := context.WithCancel(ctx)
+
+	go func() {
+		if err := cl.Start(clusterCtx); err != nil {
+			logger.Error(err, "Failed to start cluster")
+		}
+	}()
+
+	if !cl.GetCache().WaitForCacheSync(clusterCtx) {
+		cancel()
+		return errors.New("failed to wait for cache sync")
+	}
+
+	p.lock.Lock()
+	p.clusters[name] = &activeCluster{cluster: cl, endpoint: url, cancel: cancel}
+	aware := p.aware
+	p.lock.Unlock()
+
+	if err := aware.Engage(clusterCtx, name, cl); err != nil {
+		p.disengage(name)
+		return fmt.Errorf("failed to engage: %w", err)
+	}
+
+	return nil
+}
+
+func (p *Provider) disengage(name multicluster.ClusterName) {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+
+	if ac, ok := p.clusters[name]; ok {
+		p.log.Info("Disengaging workspace", "cluster", name)
+		ac.cancel()
+		delete(p.clusters, name)
+	}
+}
+
+func (p *Provider) disengageEndpoint(url string) {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+
+	for name, ac := range p.clusters {
+		if ac.endpoint == url {
+			ac.cancel()
+			delete(p.clusters, name)
+		}
+	}
+}
+
+func indexByCluster(obj any) ([]string, error) {
+	o, ok := obj.(metav1.Object)
+	if !ok {
+		return nil, fmt.Errorf("unexpected object of type %T", obj)
+	}
+
+	return []string{logicalcluster.From(o).String()}, nil
+}
@@ -0,0 +1,137 @@
+/*
+Copyright 2026 The kcp Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package apiexport
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/kcp-dev/logicalcluster/v3"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/rest"
+	"k8s.io/client-go/tools/cache"
+	"sigs.k8s.io/multicluster-runtime/pkg/multicluster"
+
+	operatorv1alpha1 "github.com/kcp-dev/kcp-operator/sdk/apis/operator/v1alpha1"
+)
+
+func TestNew(t *testing.T) {
+	resources := []schema.GroupVersionResource{operatorv1alpha1.SchemeGroupVersion.WithResource("rootshards")}
+
+	testcases := []struct {
+		name    string
+		opts    Options
+		wantErr bool
+	}{
+		{
+			name: "valid",
+			opts: Options{EndpointSlice: "operator.kcp.io", Resources: resources},
+		},
+		{
+			name:    "no endpoint slice",
+			opts:    Options{Resources: resources},
+			wantErr: true,
+		},
+		{
+			name:    "no resources",
+			opts:    Options{EndpointSlice: "operator.kcp.io"},
+			wantErr: true,
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			provider, err := New(&rest.Config{}, tc.opts)
+			if tc.wantErr {
+				if err == nil {
+					t.Fatal("expected error")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if provider.opts.PollInterval != defaultPollInterval {
+				t.Errorf("expected default poll interval, got %v", provider.opts.PollInterval)
+			}
+
+			if _, err := provider.Get(context.Background(), "root:tenant"); !errors.Is(err, multicluster.ErrClusterNotFound) {
+				t.Errorf("expected ErrClusterNotFound for unknown cluster, got %v", err)
+			}
+		})
+	}
+}
+
+func TestSyncDisengagesEmptyWorkspaces(t *testing.T) {
+	provider, err := New(&rest.Config{}, Options{
+		EndpointSlice: "operator.kcp.io",
+		Resources:     []schema.GroupVersionResource{operatorv1alpha1.SchemeGroupVersion.WithResource("rootshards")},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	cancelled := false
+	provider.clusters["tenant"] = &activeCluster{endpoint: "https://kcp/services/apiexport/root/operator.kcp.io", cancel: func() { cancelled = true }}
+
+	informer := cache.NewSharedIndexInformer(&cache.ListWatch{}, &metav1.PartialObjectMetadata{}, 0, cache.Indexers{clusterIndex: indexByCluster})
+	// Objects in other workspaces must not keep the workspace engaged.
+	if err := informer.GetIndexer().Add(&metav1.PartialObjectMetadata{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        "root",
+			Namespace:   "default",
+			Annotations: map[string]string{logicalcluster.AnnotationKey: "other"},
+		},
+	}); err != nil {
+		t.Fatalf("failed to add object: %v", err)
+	}
+
+	if err := provider.sync(context.Background(), "https://kcp/services/apiexport/root/operator.kcp.io", "tenant", []cache.SharedIndexInformer{informer}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if !cancelled {
+		t.Error("expected the cluster context to be cancelled")
+	}
+	if _, err := provider.Get(context.Background(), "tenant"); !errors.Is(err, multicluster.ErrClusterNotFound) {
+		t.Errorf("expected workspace to be disengaged, got %v", err)
+	}
+}
+
+func TestIndexByCluster(t *testing.T) {
+	obj := &metav1.PartialObjectMetadata{
+		ObjectMeta: metav1.ObjectMeta{
+			Annotations: map[string]string{logicalcluster.AnnotationKey: "1a2b3c"},
+		},
+	}
+
+	values, err := indexByCluster(obj)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(values) != 1 || values[0] != "1a2b3c" {
+		t.Errorf("expected [1a2b3c], got %v", values)
+	}
+
+	if _, err := indexByCluster("not an object"); err == nil {
+		t.Error("expected error for non-object")
+	}
+}
@@ -18,9 +18,11 @@ package client
 
 import (
 	"context"
+	"fmt"
 	"net"
 
 	"github.com/kcp-dev/kcp-operator/internal/resources"
+	"github.com/kcp-dev/kcp-operator/internal/resources/utils"
 	operatorv1alpha1 "github.com/kcp-dev/kcp-operator/sdk/apis/operator/v1alpha1"
 )
 
@@ -53,6 +55,15 @@ type Addresser interface {
 
 	// Shard addresses a shard.
 	Shard(shard *operatorv1alpha1.Shard) Endpoint
+
+	// FrontProxy addresses a front-proxy belonging to the given root shard.
+	FrontProxy(frontProxy *operatorv1alpha1.FrontProxy, rootShard *operatorv1alpha1.RootShard) Endpoint
+
+	// VirtualWorkspace addresses a virtual workspace server.
+	VirtualWorkspace(vw *operatorv1alpha1.VirtualWorkspace) Endpoint
+
+	// CacheServer addresses a standalone cache server.
+	CacheServer(server *operatorv1alpha1.CacheServer) Endpoint
 }
 
 // InCluster is the default implementation, targeting each component
@@ -78,3 +89,23 @@ func (InCluster) Shard(shard *operatorv1alpha1.Shard) Endpoint {
 		URL: resources.GetShardBaseURL(shard),
 	}
 }
+
+func (InCluster) FrontProxy(frontProxy *operatorv1alpha1.FrontProxy, rootShard *operatorv1alpha1.RootShard) Endpoint {
+	port := utils.GetFrontProxyExternalPort(frontProxy.Spec, rootShard.Spec)
+
+	return Endpoint{
+		URL: fmt.Sprintf("https://%s:%d", resources.GetFrontProxyBaseHost(frontProxy, rootShard), port),
+	}
+}
+
+func (InCluster) VirtualWorkspace(vw *operatorv1alpha1.VirtualWorkspace) Endpoint {
+	return Endpoint{
+		URL: resources.GetVirtualWorkspaceBaseURL(vw),
+	}
+}
+
+func (InCluster) CacheServer(server *operatorv1alpha1.CacheServer) Endpoint {
+	return Endpoint{
+		URL: resources.GetCacheServerBaseURL(server),
+	}
+}
@@ -0,0 +1,109 @@
+/*
+Copyright 2026 The kcp Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package client
+
+import (
+	"context"
+
+	"k8s.io/apimachinery/pkg/types"
+	ctrlruntimeclient "sigs.k8s.io/controller-runtime/pkg/client"
+
+	deployv1alpha1 "github.com/kcp-dev/kcp-operator/sdk/apis/deploy/v1alpha1"
+	operatorv1alpha1 "github.com/kcp-dev/kcp-operator/sdk/apis/operator/v1alpha1"
+)
+
+// Compiled addresses components based on the endpoint annotations on their
+// Compiled* objects (see operatorv1alpha1.EndpointURLAnnotation). This allows
+// whoever knows how a component is exposed in its workload cluster to publish
+// its address. Components without annotations, or whose Compiled* object
+// does not exist yet, are resolved using the fallback.
+type Compiled struct {
+	// Client is used to read Compiled* objects, usually the cache-backed
+	// client of the manager.
+	Client   ctrlruntimeclient.Reader
+	Fallback Addresser
+}
+
+var _ Addresser = &Compiled{}
+
+func (c *Compiled) lookup(obj ctrlruntimeclient.Object, namespace, name, urlAnnotation, serverNameAnnotation string) (Endpoint, bool) {
+	// The Addresser interface does not carry a context; lookups are served
+	// from the informer cache and do not block.
+	if err := c.Client.Get(context.Background(), types.NamespacedName{Namespace: namespace, Name: name}, obj); err != nil {
+		return Endpoint{}, false
+	}
+
+	annotations := obj.GetAnnotations()
+
+	url := annotations[urlAnnotation]
+	if url == "" {
+		return Endpoint{}, false
+	}
+
+	return Endpoint{
+		URL:        url,
+		ServerName: annotations[serverNameAnnotation],
+	}, true
+}
+
+func (c *Compiled) RootShard(rootShard *operatorv1alpha1.RootShard) Endpoint {
+	if endpoint, ok := c.lookup(&deployv1alpha1.CompiledRootShard{}, rootShard.Namespace, rootShard.Name, operatorv1alpha1.EndpointURLAnnotation, operatorv1alpha1.EndpointServerNameAnnotation); ok {
+		return endpoint
+	}
+
+	return c.Fallback.RootShard(rootShard)
+}
+
+func (c *Compiled) RootShardProxy(rootShard *operatorv1alpha1.RootShard) Endpoint {
+	if endpoint, ok := c.lookup(&deployv1alpha1.CompiledRootShard{}, rootShard.Namespace, rootShard.Name, operatorv1alpha1.ProxyEndpointURLAnnotation, operatorv1alpha1.ProxyEndpointServerNameAnnotation); ok {
+		return endpoint
+	}
+
+	return c.Fallback.RootShardProxy(rootShard)
+}
+
+func (c *Compiled) Shard(shard *operatorv1alpha1.Shard) Endpoint {
+	if endpoint, ok := c.lookup(&deployv1alpha1.CompiledShard{}, shard.Namespace, shard.Name, operatorv1alpha1.EndpointURLAnnotation, operatorv1alpha1.EndpointServerNameAnnotation); ok {
+		return endpoint
+	}
+
+	return c.Fallback.Shard(shard)
+}
+
+func (c *Compiled) FrontProxy(frontProxy *operatorv1alpha1.FrontProxy, rootShard *operatorv1alpha1.RootShard) Endpoint {
+	if endpoint, ok := c.lookup(&deployv1alpha1.CompiledFrontProxy{}, frontProxy.Namespace, frontProxy.Name, operatorv1alpha1.EndpointURLAnnotation, operatorv1alpha1.EndpointServerNameAnnotation); ok {
+		return endpoint
+	}
+
+	return c.Fallback.FrontProxy(frontProxy, rootShard)
+}
+
+func (c *Compiled) VirtualWorkspace(vw *operatorv1alpha1.VirtualWorkspace) Endpoint {
+	if endpoint, ok := c.lookup(&deployv1alpha1.CompiledVirtualWorkspace{}, vw.Namespace, vw.Name, operatorv1alpha1.EndpointURLAnnotation, operatorv1alpha1.EndpointServerNameAnnotation); ok {
+		return endpoint
+	}
+
+	return c.Fallback.VirtualWorkspace(vw)
+}
+
+func (c *Compiled) CacheServer(server *operatorv1alpha1.CacheServer) Endpoint {
+	if endpoint, ok := c.lookup(&deployv1alpha1.CompiledCacheServer{}, server.Namespace, server.Name, operatorv1alpha1.EndpointURLAnnotation, operatorv1alpha1.EndpointServerNameAnnotation); ok {
+		return endpoint
+	}
+
+	return c.Fallback.CacheServer(server)
+}
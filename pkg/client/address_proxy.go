@@ -0,0 +1,208 @@
+/*
+Copyright 2026 The kcp Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package client
+
+import (
+	"bufio"
+	"context"
+	"crypto/tls"
+	"encoding/base64"
+	"fmt"
+	"net"
+	"net/http"
+	"net/url"
+	"time"
+
+	"golang.org/x/net/proxy"
+
+	operatorv1alpha1 "github.com/kcp-dev/kcp-operator/sdk/apis/operator/v1alpha1"
+)
+
+// DialFunc matches the signature of [Endpoint.Dial].
+type DialFunc func(ctx context.Context, network, address string) (net.Conn, error)
+
+// Proxied wraps another Addresser and makes all connections to the resolved
+// endpoints go through a proxy, for example when components are running in
+// a network that the operator cannot route into directly.
+type Proxied struct {
+	Addresser Addresser
+	Dial      DialFunc
+}
+
+var _ Addresser = &Proxied{}
+
+// NewProxied returns an Addresser that dials all endpoints of the given
+// addresser through the proxy at proxyURL. Supported schemes are socks5,
+// socks5h, http and https; the latter two use HTTP CONNECT tunnels.
+func NewProxied(addresser Addresser, proxyURL string) (*Proxied, error) {
+	u, err := url.Parse(proxyURL)
+	if err != nil {
+		return nil, fmt.Errorf("invalid proxy URL: %w", err)
+	}
+
+	dial, err := NewProxyDialer(u)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Proxied{
+		Addresser: addresser,
+		Dial:      dial,
+	}, nil
+}
+
+// NewProxyDialer returns a DialFunc that tunnels connections through the
+// proxy at the given URL.
+func NewProxyDialer(u *url.URL) (DialFunc, error) {
+	if u.Host == "" {
+		return nil, fmt.Errorf("proxy URL %q has no host", u.Redacted())
+	}
+
+	switch u.Scheme {
+	case "socks5", "socks5h":
+		dialer, err := proxy.FromURL(u, proxy.Direct)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create SOCKS5 dialer: %w", err)
+		}
+
+		contextDialer, ok := dialer.(proxy.ContextDialer)
+		if !ok {
+			return nil, fmt.Errorf("SOCKS5 dialer does not support contexts")
+		}
+
+		return contextDialer.DialContext, nil
+
+	case "http", "https":
+		return (&connectDialer{proxy: u}).DialContext, nil
+
+	default:
+		return nil, fmt.Errorf("unsupported proxy scheme %q, must be one of socks5, socks5h, http or https", u.Scheme)
+	}
+}
+
+func (p *Proxied) wrap(endpoint Endpoint) Endpoint {
+	endpoint.Dial = p.Dial
+	return endpoint
+}
+
+func (p *Proxied) RootShard(rootShard *operatorv1alpha1.RootShard) Endpoint {
+	return p.wrap(p.Addresser.RootShard(rootShard))
+}
+
+func (p *Proxied) RootShardProxy(rootShard *operatorv1alpha1.RootShard) Endpoint {
+	return p.wrap(p.Addresser.RootShardProxy(rootShard))
+}
+
+func (p *Proxied) Shard(shard *operatorv1alpha1.Shard) Endpoint {
+	return p.wrap(p.Addresser.Shard(shard))
+}
+
+func (p *Proxied) FrontProxy(frontProxy *operatorv1alpha1.FrontProxy, rootShard *operatorv1alpha1.RootShard) Endpoint {
+	return p.wrap(p.Addresser.FrontProxy(frontProxy, rootShard))
+}
+
+func (p *Proxied) VirtualWorkspace(vw *operatorv1alpha1.VirtualWorkspace) Endpoint {
+	return p.wrap(p.Addresser.VirtualWorkspace(vw))
+}
+
+func (p *Proxied) CacheServer(server *operatorv1alpha1.CacheServer) Endpoint {
+	return p.wrap(p.Addresser.CacheServer(server))
+}
+
+// connectDialer establishes tunnels through an HTTP proxy using the CONNECT method.
+type connectDialer struct {
+	proxy *url.URL
+}
+
+func (d *connectDialer) DialContext(ctx context.Context, network, address string) (net.Conn, error) {
+	proxyAddress := d.proxy.Host
+	if d.proxy.Port() == "" {
+		port := "80"
+		if d.proxy.Scheme == "https" {
+			port = "443"
+		}
+		proxyAddress = net.JoinHostPort(d.proxy.Hostname(), port)
+	}
+
+	var dialer net.Dialer
+	conn, err := dialer.DialContext(ctx, network, proxyAddress)
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect to proxy: %w", err)
+	}
+
+	if d.proxy.Scheme == "https" {
+		tlsConn := tls.Client(conn, &tls.Config{ServerName: d.proxy.Hostname()})
+		if err := tlsConn.HandshakeContext(ctx); err != nil {
+			conn.Close()
+			return nil, fmt.Errorf("TLS handshake with proxy failed: %w", err)
+		}
+		conn = tlsConn
+	}
+
+	// Make sure a stuck proxy does not outlive the context.
+	if deadline, ok := ctx.Deadline(); ok {
+		_ = conn.SetDeadline(deadline)
+		defer func() { _ = conn.SetDeadline(time.Time{}) }()
+	}
+
+	req := &http.Request{
+		Method: http.MethodConnect,
+		URL:    &url.URL{Opaque: address},
+		Host:   address,
+		Header: http.Header{},
+	}
+	if user := d.proxy.User; user != nil {
+		password, _ := user.Password()
+		credentials := base64.StdEncoding.EncodeToString([]byte(user.Username() + ":" + password))
+		req.Header.Set("Proxy-Authorization", "Basic "+credentials)
+	}
+
+	if err := req.Write(conn); err != nil {
+		conn.Close()
+		return nil, fmt.Errorf("failed to send CONNECT request: %w", err)
+	}
+
+	reader := bufio.NewReader(conn)
+	resp, err := http.ReadResponse(reader, req)
+	if err != nil {
+		conn.Close()
+		return nil, fmt.Errorf("failed to read CONNECT response: %w", err)
+	}
+	resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		conn.Close()
+		return nil, fmt.Errorf("proxy refused to connect to %s: %s", address, resp.Status)
+	}
+
+	// The proxy might have sent bytes from the tunneled connection already.
+	if reader.Buffered() > 0 {
+		return &bufferedConn{Conn: conn, reader: reader}, nil
+	}
+
+	return conn, nil
+}
+
+// bufferedConn is a net.Conn that first drains a buffered reader.
+type bufferedConn struct {
+	net.Conn
+	reader *bufio.Reader
+}
+
+func (c *bufferedConn) Read(b []byte) (int, error) {
+	return c.reader.Read(b)
+}
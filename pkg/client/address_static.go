@@ -0,0 +1,158 @@
+/*
+Copyright 2026 The kcp Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package client
+
+import (
+	"fmt"
+	"os"
+
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/yaml"
+
+	operatorv1alpha1 "github.com/kcp-dev/kcp-operator/sdk/apis/operator/v1alpha1"
+)
+
+// StaticEndpoint is a single entry in a static address mapping.
+type StaticEndpoint struct {
+	// URL is the base URL of the component.
+	URL string `json:"url"`
+	// ServerName is the name verified during the TLS handshake. Empty verifies the URL's host.
+	ServerName string `json:"serverName,omitempty"`
+}
+
+// StaticMapping maps components, identified by "namespace/name", to their endpoints.
+type StaticMapping struct {
+	RootShards        map[string]StaticEndpoint `json:"rootShards,omitempty"`
+	RootShardProxies  map[string]StaticEndpoint `json:"rootShardProxies,omitempty"`
+	Shards            map[string]StaticEndpoint `json:"shards,omitempty"`
+	FrontProxies      map[string]StaticEndpoint `json:"frontProxies,omitempty"`
+	VirtualWorkspaces map[string]StaticEndpoint `json:"virtualWorkspaces,omitempty"`
+	CacheServers      map[string]StaticEndpoint `json:"cacheServers,omitempty"`
+}
+
+// Validate ensures that every entry in the mapping has a URL.
+func (m *StaticMapping) Validate() error {
+	sections := map[string]map[string]StaticEndpoint{
+		"rootShards":        m.RootShards,
+		"rootShardProxies":  m.RootShardProxies,
+		"shards":            m.Shards,
+		"frontProxies":      m.FrontProxies,
+		"virtualWorkspaces": m.VirtualWorkspaces,
+		"cacheServers":      m.CacheServers,
+	}
+
+	for section, endpoints := range sections {
+		for key, endpoint := range endpoints {
+			if endpoint.URL == "" {
+				return fmt.Errorf("%s[%q] has no URL", section, key)
+			}
+		}
+	}
+
+	return nil
+}
+
+// Static addresses components based on a fixed mapping. Components that are
+// not part of the mapping are resolved using the fallback.
+type Static struct {
+	Mapping  StaticMapping
+	Fallback Addresser
+}
+
+var _ Addresser = &Static{}
+
+// LoadStatic reads a YAML or JSON encoded StaticMapping from the given file.
+func LoadStatic(filename string, fallback Addresser) (*Static, error) {
+	content, err := os.ReadFile(filename)
+	if err != nil {
+		return nil, err
+	}
+
+	var mapping StaticMapping
+	if err := yaml.UnmarshalStrict(content, &mapping); err != nil {
+		return nil, fmt.Errorf("failed to parse %s: %w", filename, err)
+	}
+
+	if err := mapping.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid mapping in %s: %w", filename, err)
+	}
+
+	return &Static{
+		Mapping:  mapping,
+		Fallback: fallback,
+	}, nil
+}
+
+func (s *Static) lookup(endpoints map[string]StaticEndpoint, namespace, name string) (Endpoint, bool) {
+	endpoint, ok := endpoints[types.NamespacedName{Namespace: namespace, Name: name}.String()]
+	if !ok {
+		return Endpoint{}, false
+	}
+
+	return Endpoint{
+		URL:        endpoint.URL,
+		ServerName: endpoint.ServerName,
+	}, true
+}
+
+func (s *Static) RootShard(rootShard *operatorv1alpha1.RootShard) Endpoint {
+	if endpoint, ok := s.lookup(s.Mapping.RootShards, rootShard.Namespace, rootShard.Name); ok {
+		return endpoint
+	}
+
+	return s.Fallback.RootShard(rootShard)
+}
+
+func (s *Static) RootShardProxy(rootShard *operatorv1alpha1.RootShard) Endpoint {
+	if endpoint, ok := s.lookup(s.Mapping.RootShardProxies, rootShard.Namespace, rootShard.Name); ok {
+		return endpoint
+	}
+
+	return s.Fallback.RootShardProxy(rootShard)
+}
+
+func (s *Static) Shard(shard *operatorv1alpha1.Shard) Endpoint {
+	if endpoint, ok := s.lookup(s.Mapping.Shards, shard.Namespace, shard.Name); ok {
+		return endpoint
+	}
+
+	return s.Fallback.Shard(shard)
+}
+
+func (s *Static) FrontProxy(frontProxy *operatorv1alpha1.FrontProxy, rootShard *operatorv1alpha1.RootShard) Endpoint {
+	if endpoint, ok := s.lookup(s.Mapping.FrontProxies, frontProxy.Namespace, frontProxy.Name); ok {
+		return endpoint
+	}
+
+	return s.Fallback.FrontProxy(frontProxy, rootShard)
+}
+
+func (s *Static) VirtualWorkspace(vw *operatorv1alpha1.VirtualWorkspace) Endpoint {
+	if endpoint, ok := s.lookup(s.Mapping.VirtualWorkspaces, vw.Namespace, vw.Name); ok {
+		return endpoint
+	}
+
+	return s.Fallback.VirtualWorkspace(vw)
+}
+
+func (s *Static) CacheServer(server *operatorv1alpha1.CacheServer) Endpoint {
+	if endpoint, ok := s.lookup(s.Mapping.CacheServers, server.Namespace, server.Name); ok {
+		return endpoint
+	}
+
+	return s.Fallback.CacheServer(server)
+}
@@ -17,12 +17,18 @@ limitations under the License.
 package client
 
 import (
+	"os"
+	"path/filepath"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
 
+	"github.com/kcp-dev/kcp-operator/pkg/controller/util"
+	deployv1alpha1 "github.com/kcp-dev/kcp-operator/sdk/apis/deploy/v1alpha1"
 	operatorv1alpha1 "github.com/kcp-dev/kcp-operator/sdk/apis/operator/v1alpha1"
 )
 
@@ -35,12 +41,18 @@ func TestInCluster(t *testing.T) {
 		rootShard     string
 		proxy         string
 		shard         string
+		frontProxy    string
+		vw            string
+		cacheServer   string
 	}{
 		{
-			name:      "default cluster domain",
-			rootShard: "https://root-kcp.kcp.svc.cluster.local:6443",
-			proxy:     "https://root-proxy.kcp.svc.cluster.local:6443",
-			shard:     "https://root-shard-kcp.kcp.svc.cluster.local:6443",
+			name:        "default cluster domain",
+			rootShard:   "https://root-kcp.kcp.svc.cluster.local:6443",
+			proxy:       "https://root-proxy.kcp.svc.cluster.local:6443",
+			shard:       "https://root-shard-kcp.kcp.svc.cluster.local:6443",
+			frontProxy:  "https://root-front-proxy.kcp.svc.cluster.local:6443",
+			vw:          "https://root-virtual-workspace.kcp.svc.cluster.local:6443",
+			cacheServer: "https://root-cache-server.kcp.svc.cluster.local:6443",
 		},
 		{
 			// The whole cluster is addressed under the configured domain, and
@@ -51,6 +63,9 @@ func TestInCluster(t *testing.T) {
 			rootShard:     "https://root-kcp.kcp.svc.example.internal:6443",
 			proxy:         "https://root-proxy.kcp.svc.example.internal:6443",
 			shard:         "https://root-shard-kcp.kcp.svc.example.internal:6443",
+			frontProxy:    "https://root-front-proxy.kcp.svc.example.internal:6443",
+			vw:            "https://root-virtual-workspace.kcp.svc.example.internal:6443",
+			cacheServer:   "https://root-cache-server.kcp.svc.example.internal:6443",
 		},
 	}
 
@@ -69,9 +84,124 @@ func TestInCluster(t *testing.T) {
 				},
 			}
 
+			frontProxy := &operatorv1alpha1.FrontProxy{
+				ObjectMeta: meta,
+			}
+			vw := &operatorv1alpha1.VirtualWorkspace{
+				ObjectMeta: meta,
+				Spec: operatorv1alpha1.VirtualWorkspaceSpec{
+					ClusterDomain: tc.clusterDomain,
+				},
+			}
+			cacheServer := &operatorv1alpha1.CacheServer{
+				ObjectMeta: meta,
+				Spec: operatorv1alpha1.CacheServerSpec{
+					ClusterDomain: tc.clusterDomain,
+				},
+			}
+
 			assert.Equal(t, tc.rootShard, InCluster{}.RootShard(rootShard).URL)
 			assert.Equal(t, tc.proxy, InCluster{}.RootShardProxy(rootShard).URL)
 			assert.Equal(t, tc.shard, InCluster{}.Shard(shard).URL)
+			assert.Equal(t, tc.frontProxy, InCluster{}.FrontProxy(frontProxy, rootShard).URL)
+			assert.Equal(t, tc.vw, InCluster{}.VirtualWorkspace(vw).URL)
+			assert.Equal(t, tc.cacheServer, InCluster{}.CacheServer(cacheServer).URL)
 		})
 	}
 }
+
+func TestStatic(t *testing.T) {
+	mapping := `
+shards:
+  kcp/remote:
+    url: https://shard.remote.example.com:6443
+    serverName: remote-shard-kcp.kcp.svc.cluster.local
+rootShardProxies:
+  kcp/root:
+    url: https://proxy.example.com
+`
+	filename := filepath.Join(t.TempDir(), "mapping.yaml")
+	require.NoError(t, os.WriteFile(filename, []byte(mapping), 0600))
+
+	static, err := LoadStatic(filename, InCluster{})
+	require.NoError(t, err)
+
+	rootShard := &operatorv1alpha1.RootShard{ObjectMeta: metav1.ObjectMeta{Name: "root", Namespace: "kcp"}}
+	remote := &operatorv1alpha1.Shard{ObjectMeta: metav1.ObjectMeta{Name: "remote", Namespace: "kcp"}}
+	local := &operatorv1alpha1.Shard{ObjectMeta: metav1.ObjectMeta{Name: "local", Namespace: "kcp"}}
+
+	assert.Equal(t, Endpoint{
+		URL:        "https://shard.remote.example.com:6443",
+		ServerName: "remote-shard-kcp.kcp.svc.cluster.local",
+	}, static.Shard(remote))
+	assert.Equal(t, InCluster{}.Shard(local), static.Shard(local))
+	assert.Equal(t, "https://proxy.example.com", static.RootShardProxy(rootShard).URL)
+	assert.Equal(t, InCluster{}.RootShard(rootShard), static.RootShard(rootShard))
+}
+
+func TestLoadStaticInvalid(t *testing.T) {
+	testcases := []struct {
+		name    string
+		mapping string
+	}{
+		{
+			name:    "missing URL",
+			mapping: "shards:\n  kcp/remote:\n    serverName: foo\n",
+		},
+		{
+			name:    "unknown field",
+			mapping: "shard:\n  kcp/remote:\n    url: https://example.com\n",
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			filename := filepath.Join(t.TempDir(), "mapping.yaml")
+			require.NoError(t, os.WriteFile(filename, []byte(tc.mapping), 0600))
+
+			_, err := LoadStatic(filename, InCluster{})
+			assert.Error(t, err)
+		})
+	}
+}
+
+func TestCompiled(t *testing.T) {
+	meta := metav1.ObjectMeta{Name: "root", Namespace: "kcp"}
+
+	client := fake.NewClientBuilder().
+		WithScheme(util.GetTestScheme()).
+		WithObjects(
+			&deployv1alpha1.CompiledRootShard{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      meta.Name,
+					Namespace: meta.Namespace,
+					Annotations: map[string]string{
+						operatorv1alpha1.EndpointURLAnnotation:             "https://root.example.com",
+						operatorv1alpha1.EndpointServerNameAnnotation:      "root-kcp.kcp.svc.cluster.local",
+						operatorv1alpha1.ProxyEndpointURLAnnotation:        "https://proxy.example.com",
+						operatorv1alpha1.ProxyEndpointServerNameAnnotation: "root-proxy.kcp.svc.cluster.local",
+					},
+				},
+			},
+			// no annotations
+			&deployv1alpha1.CompiledShard{ObjectMeta: meta},
+		).
+		Build()
+
+	compiled := &Compiled{Client: client, Fallback: InCluster{}}
+
+	rootShard := &operatorv1alpha1.RootShard{ObjectMeta: meta}
+	shard := &operatorv1alpha1.Shard{ObjectMeta: meta}
+	vw := &operatorv1alpha1.VirtualWorkspace{ObjectMeta: meta}
+
+	assert.Equal(t, Endpoint{
+		URL:        "https://root.example.com",
+		ServerName: "root-kcp.kcp.svc.cluster.local",
+	}, compiled.RootShard(rootShard))
+	assert.Equal(t, Endpoint{
+		URL:        "https://proxy.example.com",
+		ServerName: "root-proxy.kcp.svc.cluster.local",
+	}, compiled.RootShardProxy(rootShard))
+	assert.Equal(t, InCluster{}.Shard(shard), compiled.Shard(shard))
+	assert.Equal(t, InCluster{}.VirtualWorkspace(vw), compiled.VirtualWorkspace(vw))
+}
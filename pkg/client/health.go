@@ -0,0 +1,152 @@
+/*
+Copyright 2026 The kcp Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package client
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/rest"
+	ctrlruntimeclient "sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/kcp-dev/kcp-operator/internal/resources"
+	operatorv1alpha1 "github.com/kcp-dev/kcp-operator/sdk/apis/operator/v1alpha1"
+)
+
+// healthTimeout limits how long the operator waits for all health endpoints of a component
+// together. They are probed from within reconciliations, so a hanging component must not
+// hold up a worker for long.
+const healthTimeout = 3 * time.Second
+
+// maxHealthResponseSize caps how much of a verbose health response is read. Even with all
+// checks failing these are only a few kilobytes.
+const maxHealthResponseSize = 64 * 1024
+
+// healthEndpoints are probed in order; the same check can appear on both.
+var healthEndpoints = []string{"livez", "readyz"}
+
+// GetFailedHealthChecks probes the /livez and /readyz endpoints of a kcp component,
+// authenticating with the operator's client certificate of the given root shard. It returns
+// the failing checks, prefixed with the endpoint that reported them (e.g. "readyz/etcd").
+// An error is returned if the component could not be probed at all.
+func GetFailedHealthChecks(ctx context.Context, c ctrlruntimeclient.Client, endpoint Endpoint, rootShard *operatorv1alpha1.RootShard) ([]string, error) {
+	tlsConfig, err := getTLSConfig(ctx, c, rootShard)
+	if err != nil {
+		return nil, fmt.Errorf("failed to determine TLS settings: %w", err)
+	}
+
+	return probeHealth(ctx, endpoint, tlsConfig)
+}
+
+// GetCacheServerFailedHealthChecks is like GetFailedHealthChecks, but authenticates with the
+// client certificate of the cache server, since cache servers are not tied to a root shard.
+func GetCacheServerFailedHealthChecks(ctx context.Context, c ctrlruntimeclient.Client, endpoint Endpoint, server *operatorv1alpha1.CacheServer) ([]string, error) {
+	key := types.NamespacedName{
+		Namespace: server.Namespace,
+		Name:      resources.GetCacheServerClientCertificateName(server),
+	}
+
+	certSecret := &corev1.Secret{}
+	if err := c.Get(ctx, key, certSecret); err != nil {
+		return nil, fmt.Errorf("failed to get cache server client certificate Secret: %w", err)
+	}
+
+	return probeHealth(ctx, endpoint, rest.TLSClientConfig{
+		CAData:   certSecret.Data["ca.crt"],
+		CertData: certSecret.Data["tls.crt"],
+		KeyData:  certSecret.Data["tls.key"],
+	})
+}
+
+func probeHealth(ctx context.Context, endpoint Endpoint, tlsConfig rest.TLSClientConfig) ([]string, error) {
+	httpClient, err := newHTTPClient(endpoint, tlsConfig, healthTimeout)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, healthTimeout)
+	defer cancel()
+
+	var failed []string
+	for _, path := range healthEndpoints {
+		checks, err := probeHealthEndpoint(ctx, httpClient, strings.TrimSuffix(endpoint.URL, "/")+"/"+path, path)
+		if err != nil {
+			return nil, fmt.Errorf("failed to probe /%s: %w", path, err)
+		}
+
+		failed = append(failed, checks...)
+	}
+
+	return failed, nil
+}
+
+func probeHealthEndpoint(ctx context.Context, httpClient *http.Client, url, path string) ([]string, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url+"?verbose", nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	switch resp.StatusCode {
+	case http.StatusOK:
+		return nil, nil
+
+	// The Kubernetes health handlers respond with 500 if any check fails.
+	case http.StatusInternalServerError:
+		return parseFailedChecks(io.LimitReader(resp.Body, maxHealthResponseSize), path), nil
+
+	default:
+		return nil, fmt.Errorf("unexpected status code %d", resp.StatusCode)
+	}
+}
+
+// parseFailedChecks extracts the names of failed checks from a verbose health response,
+// which lists each check as "[+]name ok" or "[-]name failed: reason". If no check can be
+// identified, the endpoint itself is reported as failed.
+func parseFailedChecks(body io.Reader, path string) []string {
+	var failed []string
+
+	scanner := bufio.NewScanner(body)
+	for scanner.Scan() {
+		line, found := strings.CutPrefix(scanner.Text(), "[-]")
+		if !found {
+			continue
+		}
+
+		if fields := strings.Fields(line); len(fields) > 0 {
+			failed = append(failed, path+"/"+fields[0])
+		}
+	}
+
+	if len(failed) == 0 {
+		failed = []string{path}
+	}
+
+	return failed
+}
@@ -0,0 +1,136 @@
+/*
+Copyright 2026 The kcp Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package client
+
+import (
+	"context"
+	"encoding/pem"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/kcp-dev/kcp-operator/internal/resources"
+	operatorv1alpha1 "github.com/kcp-dev/kcp-operator/sdk/apis/operator/v1alpha1"
+)
+
+func TestGetFailedHealthChecks(t *testing.T) {
+	testcases := []struct {
+		name     string
+		livez    string
+		readyz   string
+		expected []string
+		wantErr  bool
+	}{
+		{
+			name:   "all checks pass",
+			livez:  "[+]ping ok\n[+]etcd ok\nlivez check passed\n",
+			readyz: "[+]ping ok\n[+]etcd ok\nreadyz check passed\n",
+		},
+		{
+			name:     "failed checks are reported per endpoint",
+			livez:    "[+]ping ok\n[-]etcd failed: reason withheld\nlivez check failed\n",
+			readyz:   "[+]ping ok\n[-]etcd failed: reason withheld\n[-]informer-sync failed: reason withheld\nreadyz check failed\n",
+			expected: []string{"livez/etcd", "readyz/etcd", "readyz/informer-sync"},
+		},
+		{
+			name:     "failure without check list",
+			livez:    "[+]ping ok\nlivez check passed\n",
+			readyz:   "internal server error\n",
+			expected: []string{"readyz"},
+		},
+		{
+			name:    "unexpected status",
+			livez:   "forbidden",
+			wantErr: true,
+		},
+		{
+			name:    "hanging component",
+			livez:   "[+]ping ok\nlivez check passed\n",
+			readyz:  "hang",
+			wantErr: true,
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				var body string
+				switch r.URL.Path {
+				case "/livez":
+					body = tc.livez
+				case "/readyz":
+					body = tc.readyz
+				default:
+					w.WriteHeader(http.StatusNotFound)
+					return
+				}
+
+				switch {
+				case body == "hang":
+					<-r.Context().Done()
+					return
+				case body == "forbidden":
+					w.WriteHeader(http.StatusForbidden)
+				case strings.Contains(body, "passed"):
+					w.WriteHeader(http.StatusOK)
+				default:
+					w.WriteHeader(http.StatusInternalServerError)
+				}
+
+				fmt.Fprint(w, body)
+			}))
+			defer server.Close()
+
+			rootShard := &operatorv1alpha1.RootShard{
+				ObjectMeta: metav1.ObjectMeta{Name: "root", Namespace: "kcp"},
+			}
+
+			secret := &corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      resources.GetRootShardCertificateName(rootShard, operatorv1alpha1.OperatorCertificate),
+					Namespace: rootShard.Namespace,
+				},
+				Data: map[string][]byte{
+					"ca.crt": pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: server.Certificate().Raw}),
+				},
+			}
+
+			c := fake.NewClientBuilder().WithObjects(secret).Build()
+
+			start := time.Now()
+			failed, err := GetFailedHealthChecks(context.Background(), c, Endpoint{URL: server.URL}, rootShard)
+			require.Less(t, time.Since(start), 2*healthTimeout, "probing should be bounded by the overall timeout")
+
+			if tc.wantErr {
+				require.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tc.expected, failed)
+		})
+	}
+}
@@ -0,0 +1,90 @@
+/*
+Copyright 2026 The kcp Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"k8s.io/apimachinery/pkg/version"
+	"k8s.io/client-go/rest"
+	ctrlruntimeclient "sigs.k8s.io/controller-runtime/pkg/client"
+
+	operatorv1alpha1 "github.com/kcp-dev/kcp-operator/sdk/apis/operator/v1alpha1"
+)
+
+// versionTimeout limits how long the operator waits for a component's /version endpoint, as
+// it is queried from within reconciliations.
+const versionTimeout = 10 * time.Second
+
+// GetVersion queries the /version endpoint of a kcp component, authenticating with the
+// operator's client certificate of the given root shard.
+func GetVersion(ctx context.Context, c ctrlruntimeclient.Client, endpoint Endpoint, rootShard *operatorv1alpha1.RootShard) (*version.Info, error) {
+	tlsConfig, err := getTLSConfig(ctx, c, rootShard)
+	if err != nil {
+		return nil, fmt.Errorf("failed to determine TLS settings: %w", err)
+	}
+
+	httpClient, err := newHTTPClient(endpoint, tlsConfig, versionTimeout)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, strings.TrimSuffix(endpoint.URL, "/")+"/version", nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("unexpected status code %d", resp.StatusCode)
+	}
+
+	info := &version.Info{}
+	if err := json.NewDecoder(resp.Body).Decode(info); err != nil {
+		return nil, fmt.Errorf("failed to decode version: %w", err)
+	}
+
+	return info, nil
+}
+
+// newHTTPClient returns a client for talking to the given endpoint with the given
+// credentials.
+func newHTTPClient(endpoint Endpoint, tlsConfig rest.TLSClientConfig, timeout time.Duration) (*http.Client, error) {
+	tlsConfig.ServerName = endpoint.ServerName
+
+	httpClient, err := rest.HTTPClientFor(&rest.Config{
+		Host:            endpoint.URL,
+		TLSClientConfig: tlsConfig,
+		Dial:            endpoint.Dial,
+		Timeout:         timeout,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to create HTTP client: %w", err)
+	}
+
+	return httpClient, nil
+}
@@ -0,0 +1,110 @@
+/*
+Copyright 2026 The kcp Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package config
+
+import (
+	"fmt"
+
+	ctrlruntimeclient "sigs.k8s.io/controller-runtime/pkg/client"
+
+	operatorclient "github.com/kcp-dev/kcp-operator/pkg/client"
+)
+
+// AddresserType names a strategy for resolving the endpoints of kcp components.
+type AddresserType string
+
+const (
+	// AddresserInCluster reaches components via their in-cluster Service addresses.
+	AddresserInCluster AddresserType = "in-cluster"
+
+	// AddresserStatic reads endpoints from a mapping file and falls back to in-cluster
+	// addresses for components that are not listed.
+	AddresserStatic AddresserType = "static"
+
+	// AddresserCompiled reads endpoints from annotations on the Compiled* objects and falls
+	// back to in-cluster addresses for components without them.
+	AddresserCompiled AddresserType = "compiled"
+)
+
+// AddresserOptions configures how the operator reaches kcp components.
+type AddresserOptions struct {
+	// Addresser is the name of the strategy to use.
+	Addresser AddresserType
+
+	// StaticFile is the mapping file for the static addresser.
+	StaticFile string
+
+	// ProxyURL, if set, makes all connections to components go through a SOCKS5 or HTTP
+	// CONNECT proxy, regardless of the addresser.
+	ProxyURL string
+}
+
+// Validate checks the options for consistency.
+func (o *AddresserOptions) Validate() error {
+	switch o.Addresser {
+	case "", AddresserInCluster, AddresserCompiled:
+		if o.StaticFile != "" {
+			return fmt.Errorf("a static mapping file can only be used with the %q addresser", AddresserStatic)
+		}
+		return nil
+
+	case AddresserStatic:
+		if o.StaticFile == "" {
+			return fmt.Errorf("a mapping file must be configured when using the %q addresser", o.Addresser)
+		}
+		return nil
+
+	default:
+		return fmt.Errorf("unknown addresser %q, known addressers are %v", o.Addresser, []AddresserType{AddresserInCluster, AddresserStatic, AddresserCompiled})
+	}
+}
+
+// NewAddresser creates the configured Addresser. The reader is used by addressers that
+// need to look up objects, usually it is the cache-backed client of the manager.
+func (o *AddresserOptions) NewAddresser(reader ctrlruntimeclient.Reader) (operatorclient.Addresser, error) {
+	if err := o.Validate(); err != nil {
+		return nil, err
+	}
+
+	var (
+		addresser operatorclient.Addresser = operatorclient.InCluster{}
+		err       error
+	)
+
+	switch o.Addresser {
+	case AddresserStatic:
+		addresser, err = operatorclient.LoadStatic(o.StaticFile, operatorclient.InCluster{})
+		if err != nil {
+			return nil, err
+		}
+
+	case AddresserCompiled:
+		addresser = &operatorclient.Compiled{
+			Client:   reader,
+			Fallback: operatorclient.InCluster{},
+		}
+	}
+
+	if o.ProxyURL != "" {
+		addresser, err = operatorclient.NewProxied(addresser, o.ProxyURL)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return addresser, nil
+}
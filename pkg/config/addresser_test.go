@@ -0,0 +1,100 @@
+/*
+Copyright 2026 The kcp Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package config
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	operatorclient "github.com/kcp-dev/kcp-operator/pkg/client"
+)
+
+func TestNewAddresser(t *testing.T) {
+	mappingFile := filepath.Join(t.TempDir(), "mapping.yaml")
+	if err := os.WriteFile(mappingFile, []byte("shards: {}\n"), 0600); err != nil {
+		t.Fatalf("failed to write mapping: %v", err)
+	}
+
+	tests := []struct {
+		name    string
+		opts    AddresserOptions
+		want    operatorclient.Addresser
+		wantErr bool
+	}{
+		{
+			name: "in-cluster by default",
+			opts: AddresserOptions{},
+			want: operatorclient.InCluster{},
+		},
+		{
+			name: "static",
+			opts: AddresserOptions{Addresser: AddresserStatic, StaticFile: mappingFile},
+			want: &operatorclient.Static{},
+		},
+		{
+			name:    "static without mapping file",
+			opts:    AddresserOptions{Addresser: AddresserStatic},
+			wantErr: true,
+		},
+		{
+			name:    "mapping file without static addresser",
+			opts:    AddresserOptions{Addresser: AddresserCompiled, StaticFile: mappingFile},
+			wantErr: true,
+		},
+		{
+			name: "compiled",
+			opts: AddresserOptions{Addresser: AddresserCompiled},
+			want: &operatorclient.Compiled{},
+		},
+		{
+			name: "proxied",
+			opts: AddresserOptions{Addresser: AddresserCompiled, ProxyURL: "socks5://proxy.example.com:1080"},
+			want: &operatorclient.Proxied{},
+		},
+		{
+			name:    "invalid proxy",
+			opts:    AddresserOptions{ProxyURL: "ftp://proxy.example.com"},
+			wantErr: true,
+		},
+		{
+			name:    "unknown addresser",
+			opts:    AddresserOptions{Addresser: "bogus"},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			addresser, err := tt.opts.NewAddresser(nil)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatalf("expected error, got %T", addresser)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if got, want := fmt.Sprintf("%T", addresser), fmt.Sprintf("%T", tt.want); got != want {
+				t.Errorf("expected %s, got %s", want, got)
+			}
+		})
+	}
+}
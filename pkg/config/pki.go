@@ -0,0 +1,48 @@
+/*
+Copyright 2026 The kcp Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package config
+
+import (
+	"fmt"
+
+	"github.com/kcp-dev/kcp-operator/pkg/pki"
+)
+
+// PKIBackendType names an implementation of the certificates and issuers the operator manages.
+type PKIBackendType string
+
+const (
+	// PKIBackendCertManager manages cert-manager Certificates and Issuers.
+	PKIBackendCertManager PKIBackendType = "cert-manager"
+
+	// PKIBackendBuiltin issues certificates within the operator, without cert-manager.
+	PKIBackendBuiltin PKIBackendType = "builtin"
+)
+
+// NewPKIBackend creates the named PKI backend, cert-manager being the default.
+func NewPKIBackend(backend PKIBackendType) (pki.Backend, error) {
+	switch backend {
+	case "", PKIBackendCertManager:
+		return pki.CertManager{}, nil
+
+	case PKIBackendBuiltin:
+		return pki.Builtin{}, nil
+
+	default:
+		return nil, fmt.Errorf("unknown PKI backend %q, known backends are %v", backend, []PKIBackendType{PKIBackendCertManager, PKIBackendBuiltin})
+	}
+}
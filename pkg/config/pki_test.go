@@ -0,0 +1,72 @@
+/*
+Copyright 2026 The kcp Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package config
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/kcp-dev/kcp-operator/pkg/pki"
+)
+
+func TestNewPKIBackend(t *testing.T) {
+	tests := []struct {
+		name    string
+		backend PKIBackendType
+		want    pki.Backend
+		wantErr bool
+	}{
+		{
+			name: "cert-manager by default",
+			want: pki.CertManager{},
+		},
+		{
+			name:    "cert-manager",
+			backend: PKIBackendCertManager,
+			want:    pki.CertManager{},
+		},
+		{
+			name:    "builtin",
+			backend: PKIBackendBuiltin,
+			want:    pki.Builtin{},
+		},
+		{
+			name:    "unknown backend",
+			backend: "vault",
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			backend, err := NewPKIBackend(tt.backend)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatalf("expected error, got %T", backend)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if got, want := fmt.Sprintf("%T", backend), fmt.Sprintf("%T", tt.want); got != want {
+				t.Errorf("expected %s, got %s", want, got)
+			}
+		})
+	}
+}
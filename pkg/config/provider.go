@@ -0,0 +1,277 @@
+/*
+Copyright 2026 The kcp Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package config
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"golang.org/x/sync/errgroup"
+
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/clientcmd"
+	ctrlruntimeclient "sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/cluster"
+	mcbuilder "sigs.k8s.io/multicluster-runtime/pkg/builder"
+	mcmanager "sigs.k8s.io/multicluster-runtime/pkg/manager"
+	"sigs.k8s.io/multicluster-runtime/pkg/multicluster"
+	kubeconfigprovider "sigs.k8s.io/multicluster-runtime/providers/kubeconfig"
+
+	"github.com/kcp-dev/kcp-operator/pkg/apiexport"
+	operatorv1alpha1 "github.com/kcp-dev/kcp-operator/sdk/apis/operator/v1alpha1"
+)
+
+// ClusterProvider names a multicluster provider that supplies the clusters that the workload
+// controllers deploy into.
+type ClusterProvider string
+
+const (
+	// ClusterProviderNone runs all controllers against the cluster the operator runs in.
+	ClusterProviderNone ClusterProvider = "none"
+
+	// ClusterProviderKubeconfig discovers workload clusters from kubeconfig Secrets in a
+	// namespace of the cluster the operator runs in. Each Secret becomes one cluster, named
+	// after the Secret.
+	ClusterProviderKubeconfig ClusterProvider = "kubeconfig"
+)
+
+// ProviderOptions configures the multicluster provider for the workload controllers.
+type ProviderOptions struct {
+	// Provider is the name of the provider to use.
+	Provider ClusterProvider
+
+	// KubeconfigNamespace is the namespace in which the kubeconfig provider looks for Secrets.
+	KubeconfigNamespace string
+	// KubeconfigSecretLabel is the label (with value "true") that marks kubeconfig Secrets.
+	KubeconfigSecretLabel string
+	// KubeconfigSecretKey is the key in the Secret data that contains the kubeconfig.
+	KubeconfigSecretKey string
+
+	// EngageLocalCluster makes the workload controllers reconcile the local cluster in addition
+	// to the provider clusters.
+	EngageLocalCluster bool
+
+	// APIExportKubeconfig is the path to a kubeconfig for the kcp workspace that contains the
+	// operator's APIExport. If set, the config controllers reconcile the operator.kcp.io objects
+	// in all workspaces that bind the APIExport instead of those in the local cluster.
+	APIExportKubeconfig string
+	// APIExportEndpointSlice is the name of the APIExportEndpointSlice of the APIExport.
+	APIExportEndpointSlice string
+	// Scheme is used for the clients of the workspaces.
+	Scheme *runtime.Scheme
+
+	workloadProvider  multicluster.Provider
+	apiExportProvider multicluster.Provider
+}
+
+// apiExportResources are the operator.kcp.io resources that make a workspace known to the
+// APIExport provider.
+var apiExportResources = []string{
+	"rootshards",
+	"shards",
+	"frontproxies",
+	"cacheservers",
+	"virtualworkspaces",
+	"kubeconfigs",
+	"etcdbackupschedules",
+	"etcdsnapshots",
+	"etcdrestores",
+}
+
+// Validate checks the options for consistency.
+func (o *ProviderOptions) Validate() error {
+	if (o.APIExportKubeconfig == "") != (o.APIExportEndpointSlice == "") {
+		return errors.New("the APIExport kubeconfig and endpoint slice must be configured together")
+	}
+
+	switch o.Provider {
+	case "", ClusterProviderNone:
+		return nil
+
+	case ClusterProviderKubeconfig:
+		if o.KubeconfigNamespace == "" {
+			return fmt.Errorf("a namespace for kubeconfig Secrets must be configured when using the %q provider", o.Provider)
+		}
+		return nil
+
+	default:
+		return fmt.Errorf("unknown cluster provider %q, known providers are %v", o.Provider, []ClusterProvider{ClusterProviderNone, ClusterProviderKubeconfig})
+	}
+}
+
+// NewProvider creates the configured multicluster provider. It returns nil if no provider
+// is configured, which makes the manager only work with the local cluster.
+func (o *ProviderOptions) NewProvider() (multicluster.Provider, error) {
+	if err := o.Validate(); err != nil {
+		return nil, err
+	}
+
+	switch o.Provider {
+	case ClusterProviderKubeconfig:
+		o.workloadProvider = kubeconfigprovider.New(kubeconfigprovider.Options{
+			Namespace:             o.KubeconfigNamespace,
+			KubeconfigSecretLabel: o.KubeconfigSecretLabel,
+			KubeconfigSecretKey:   o.KubeconfigSecretKey,
+		})
+	}
+
+	if o.APIExportKubeconfig != "" {
+		config, err := clientcmd.BuildConfigFromFlags("", o.APIExportKubeconfig)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load APIExport kubeconfig: %w", err)
+		}
+
+		opts := apiexport.Options{
+			EndpointSlice: o.APIExportEndpointSlice,
+			Scheme:        o.Scheme,
+		}
+		for _, resource := range apiExportResources {
+			opts.Resources = append(opts.Resources, operatorv1alpha1.SchemeGroupVersion.WithResource(resource))
+		}
+
+		provider, err := apiexport.New(config, opts)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create APIExport provider: %w", err)
+		}
+		o.apiExportProvider = provider
+	}
+
+	switch {
+	case o.workloadProvider != nil && o.apiExportProvider != nil:
+		return &combinedProvider{providers: []multicluster.Provider{o.apiExportProvider, o.workloadProvider}}, nil
+	case o.apiExportProvider != nil:
+		return o.apiExportProvider, nil
+	default:
+		return o.workloadProvider, nil
+	}
+}
+
+// SetupProvider wires the provider into the manager, if it needs to watch resources in the
+// local cluster to discover clusters.
+func SetupProvider(ctx context.Context, provider multicluster.Provider, mgr mcmanager.Manager) error {
+	type setupWithManager interface {
+		SetupWithManager(ctx context.Context, mgr mcmanager.Manager) error
+	}
+
+	if p, ok := provider.(*combinedProvider); ok {
+		for _, provider := range p.providers {
+			if err := SetupProvider(ctx, provider, mgr); err != nil {
+				return err
+			}
+		}
+	}
+
+	if p, ok := provider.(setupWithManager); ok {
+		return p.SetupWithManager(ctx, mgr)
+	}
+
+	return nil
+}
+
+// ConfigEngageOptions returns the engage options for the config controllers. They work on the
+// cluster where the user-facing operator.kcp.io objects live: the local cluster, or the kcp
+// workspaces that bind the APIExport.
+func (o *ProviderOptions) ConfigEngageOptions() []mcbuilder.EngageOptions {
+	if o.apiExportProvider != nil {
+		return []mcbuilder.EngageOptions{
+			mcbuilder.WithEngageWithLocalCluster(false),
+			mcbuilder.WithEngageWithProviderClusters(true),
+			mcbuilder.WithClustersFromProvider(context.Background(), o.apiExportProvider),
+		}
+	}
+
+	return []mcbuilder.EngageOptions{
+		mcbuilder.WithEngageWithLocalCluster(true),
+		mcbuilder.WithEngageWithProviderClusters(false),
+	}
+}
+
+// WorkloadEngageOptions returns the engage options for the workload controllers. Without a
+// provider they work on the local cluster, otherwise on all provider clusters and optionally
+// the local cluster.
+func (o *ProviderOptions) WorkloadEngageOptions() []mcbuilder.EngageOptions {
+	if o.Provider == "" || o.Provider == ClusterProviderNone {
+		if o.apiExportProvider != nil {
+			return []mcbuilder.EngageOptions{
+				mcbuilder.WithEngageWithLocalCluster(true),
+				mcbuilder.WithEngageWithProviderClusters(false),
+			}
+		}
+
+		return nil
+	}
+
+	opts := []mcbuilder.EngageOptions{
+		mcbuilder.WithEngageWithLocalCluster(o.EngageLocalCluster),
+		mcbuilder.WithEngageWithProviderClusters(true),
+	}
+
+	// The workspaces of the APIExport provider are no workload clusters.
+	if o.apiExportProvider != nil && o.workloadProvider != nil {
+		opts = append(opts, mcbuilder.WithClustersFromProvider(context.Background(), o.workloadProvider))
+	}
+
+	return opts
+}
+
+// combinedProvider serves the clusters of multiple providers under their original names.
+// Unlike the multi provider from multicluster-runtime, it does not prefix cluster names, which
+// providers that engage clusters with the manager directly (like the kubeconfig provider) rely on.
+type combinedProvider struct {
+	providers []multicluster.Provider
+}
+
+var _ multicluster.Provider = &combinedProvider{}
+var _ multicluster.ProviderRunnable = &combinedProvider{}
+
+func (p *combinedProvider) Get(ctx context.Context, clusterName multicluster.ClusterName) (cluster.Cluster, error) {
+	for _, provider := range p.providers {
+		cl, err := provider.Get(ctx, clusterName)
+		if err == nil {
+			return cl, nil
+		}
+		if !errors.Is(err, multicluster.ErrClusterNotFound) {
+			return nil, err
+		}
+	}
+
+	return nil, multicluster.ErrClusterNotFound
+}
+
+func (p *combinedProvider) IndexField(ctx context.Context, obj ctrlruntimeclient.Object, field string, extractValue ctrlruntimeclient.IndexerFunc) error {
+	var errs []error
+	for _, provider := range p.providers {
+		errs = append(errs, provider.IndexField(ctx, obj, field, extractValue))
+	}
+
+	return errors.Join(errs...)
+}
+
+func (p *combinedProvider) Start(ctx context.Context, aware multicluster.Aware) error {
+	group, ctx := errgroup.WithContext(ctx)
+
+	for _, provider := range p.providers {
+		if runnable, ok := provider.(multicluster.ProviderRunnable); ok {
+			group.Go(func() error {
+				return runnable.Start(ctx, aware)
+			})
+		}
+	}
+
+	return group.Wait()
+}
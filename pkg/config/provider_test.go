@@ -0,0 +1,153 @@
+/*
+Copyright 2026 The kcp Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	kubeconfigprovider "sigs.k8s.io/multicluster-runtime/providers/kubeconfig"
+
+	"github.com/kcp-dev/kcp-operator/pkg/apiexport"
+)
+
+func TestNewProvider(t *testing.T) {
+	tests := []struct {
+		name         string
+		opts         ProviderOptions
+		wantProvider bool
+		wantErr      bool
+	}{
+		{
+			name: "no provider by default",
+			opts: ProviderOptions{},
+		},
+		{
+			name: "explicitly no provider",
+			opts: ProviderOptions{Provider: ClusterProviderNone},
+		},
+		{
+			name: "kubeconfig provider",
+			opts: ProviderOptions{
+				Provider:            ClusterProviderKubeconfig,
+				KubeconfigNamespace: "workload-clusters",
+			},
+			wantProvider: true,
+		},
+		{
+			name:    "kubeconfig provider without namespace",
+			opts:    ProviderOptions{Provider: ClusterProviderKubeconfig},
+			wantErr: true,
+		},
+		{
+			name:    "unknown provider",
+			opts:    ProviderOptions{Provider: "bogus"},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			provider, err := tt.opts.NewProvider()
+			if tt.wantErr {
+				if err == nil {
+					t.Fatalf("expected error, got %v", provider)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if tt.wantProvider {
+				if _, ok := provider.(*kubeconfigprovider.Provider); !ok {
+					t.Errorf("expected kubeconfig provider, got %T", provider)
+				}
+			} else if provider != nil {
+				t.Errorf("expected no provider, got %T", provider)
+			}
+		})
+	}
+}
+
+func TestWorkloadEngageOptions(t *testing.T) {
+	opts := ProviderOptions{}
+	if engage := opts.WorkloadEngageOptions(); len(engage) != 0 {
+		t.Errorf("expected no engage options without a provider, got %v", engage)
+	}
+
+	opts = ProviderOptions{Provider: ClusterProviderKubeconfig, KubeconfigNamespace: "workload-clusters"}
+	if engage := opts.WorkloadEngageOptions(); len(engage) == 0 {
+		t.Error("expected engage options with a provider")
+	}
+}
+
+func TestAPIExportProvider(t *testing.T) {
+	kubeconfig := filepath.Join(t.TempDir(), "kubeconfig")
+	if err := os.WriteFile(kubeconfig, []byte(`apiVersion: v1
+kind: Config
+clusters:
+- name: kcp
+  cluster:
+    server: https://kcp.example.com/clusters/root:operator
+contexts:
+- name: kcp
+  context:
+    cluster: kcp
+current-context: kcp
+`), 0600); err != nil {
+		t.Fatalf("failed to write kubeconfig: %v", err)
+	}
+
+	opts := ProviderOptions{APIExportKubeconfig: kubeconfig}
+	if _, err := opts.NewProvider(); err == nil {
+		t.Fatal("expected error without an endpoint slice")
+	}
+
+	opts = ProviderOptions{APIExportKubeconfig: kubeconfig, APIExportEndpointSlice: "operator.kcp.io"}
+	provider, err := opts.NewProvider()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if _, ok := provider.(*apiexport.Provider); !ok {
+		t.Errorf("expected APIExport provider, got %T", provider)
+	}
+	if engage := opts.ConfigEngageOptions(); len(engage) != 3 {
+		t.Errorf("expected the config controllers to be restricted to the workspaces, got %v", engage)
+	}
+	if engage := opts.WorkloadEngageOptions(); len(engage) == 0 {
+		t.Error("expected the workload controllers to be restricted to the local cluster")
+	}
+
+	opts = ProviderOptions{
+		Provider:               ClusterProviderKubeconfig,
+		KubeconfigNamespace:    "workload-clusters",
+		APIExportKubeconfig:    kubeconfig,
+		APIExportEndpointSlice: "operator.kcp.io",
+	}
+	provider, err = opts.NewProvider()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if _, ok := provider.(*combinedProvider); !ok {
+		t.Errorf("expected combined provider, got %T", provider)
+	}
+	if engage := opts.WorkloadEngageOptions(); len(engage) != 3 {
+		t.Errorf("expected the workload controllers to be restricted to the workload clusters, got %v", engage)
+	}
+}
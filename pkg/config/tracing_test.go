@@ -0,0 +1,74 @@
+/*
+Copyright 2026 The kcp Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package config
+
+import (
+	"context"
+	"testing"
+
+	"go.opentelemetry.io/otel"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+)
+
+func TestSetupTracing(t *testing.T) {
+	previous := otel.GetTracerProvider()
+	t.Cleanup(func() { otel.SetTracerProvider(previous) })
+
+	tests := []struct {
+		name    string
+		opts    TracingOptions
+		wantSDK bool
+		wantErr bool
+	}{
+		{
+			name: "disabled by default",
+			opts: TracingOptions{SamplingRatio: 1},
+		},
+		{
+			name:    "OTLP endpoint",
+			opts:    TracingOptions{Endpoint: "localhost:4317", Insecure: true, SamplingRatio: 0.5},
+			wantSDK: true,
+		},
+		{
+			name:    "invalid sampling ratio",
+			opts:    TracingOptions{Endpoint: "localhost:4317", SamplingRatio: 2},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			otel.SetTracerProvider(previous)
+
+			shutdown, err := tt.opts.SetupTracing(context.Background(), "test")
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("SetupTracing() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err != nil {
+				return
+			}
+
+			if _, isSDK := otel.GetTracerProvider().(*sdktrace.TracerProvider); isSDK != tt.wantSDK {
+				t.Errorf("expected SDK tracer provider: %v, got %T", tt.wantSDK, otel.GetTracerProvider())
+			}
+
+			if err := shutdown(context.Background()); err != nil {
+				t.Errorf("failed to shut down: %v", err)
+			}
+		})
+	}
+}
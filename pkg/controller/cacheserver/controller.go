@@ -19,12 +19,18 @@ package cacheserver
 import (
 	"context"
 	"fmt"
+	"time"
 
 	certmanagerv1 "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
 	k8creconciling "k8c.io/reconciler/pkg/reconciling"
 
 	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	apimeta "k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	kerrors "k8s.io/apimachinery/pkg/util/errors"
+	"k8s.io/apimachinery/pkg/util/sets"
 	ctrlruntime "sigs.k8s.io/controller-runtime"
 	ctrlruntimeclient "sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/cluster"
@@ -36,8 +42,11 @@ import (
 
 	"github.com/kcp-dev/kcp-operator/internal/resources/cacheserver"
 	"github.com/kcp-dev/kcp-operator/pkg/controller/util"
+	"github.com/kcp-dev/kcp-operator/pkg/metrics"
+	"github.com/kcp-dev/kcp-operator/pkg/pki"
 	"github.com/kcp-dev/kcp-operator/pkg/reconciling"
 	"github.com/kcp-dev/kcp-operator/pkg/reconciling/modifier"
+	"github.com/kcp-dev/kcp-operator/pkg/tracing"
 	deployv1alpha1 "github.com/kcp-dev/kcp-operator/sdk/apis/deploy/v1alpha1"
 	operatorv1alpha1 "github.com/kcp-dev/kcp-operator/sdk/apis/operator/v1alpha1"
 )
@@ -45,28 +54,45 @@ import (
 // CacheServerReconciler reconciles a CacheServer object
 type CacheServerReconciler struct {
 	GetCluster func(ctx context.Context, clusterName multicluster.ClusterName) (cluster.Cluster, error)
+	Events     *util.EventRecorder
+	PKI        pki.Backend
 }
 
 func (r *CacheServerReconciler) SetupWithManager(mgr mcmanager.Manager, opts ...mcbuilder.EngageOptions) error {
+	// The status lists the shards using a cache server, so any change to a shard's
+	// cache configuration has to be reflected on all cache servers in the namespace.
+	allServersHandler := util.EnqueueAllInNamespace(func() ctrlruntimeclient.ObjectList {
+		return &operatorv1alpha1.CacheServerList{}
+	})
+
 	return mcbuilder.ControllerManagedBy(mgr).
 		Named("cache-server").
 		For(&operatorv1alpha1.CacheServer{}, util.EngageFor(opts)...).
 		Owns(&deployv1alpha1.CompiledCacheServer{}, util.EngageOwns(opts)...).
 		Owns(&corev1.Secret{}, util.EngageOwns(opts)...).
-		Owns(&certmanagerv1.Certificate{}, util.EngageOwns(opts)...).
-		Complete(r)
+		Owns(r.PKI.CertificateType(), util.EngageOwns(opts)...).
+		Watches(&operatorv1alpha1.RootShard{}, allServersHandler, util.EngageWatches(opts)...).
+		Watches(&operatorv1alpha1.Shard{}, allServersHandler, util.EngageWatches(opts)...).
+		Complete(tracing.Reconciler("cache-server", r))
 }
 
 // +kubebuilder:rbac:groups=operator.kcp.io,resources=cacheservers,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups=operator.kcp.io,resources=cacheservers/status,verbs=get;update;patch
 // +kubebuilder:rbac:groups=operator.kcp.io,resources=cacheservers/finalizers,verbs=update
+// +kubebuilder:rbac:groups=operator.kcp.io,resources=rootshards;shards,verbs=get;list;watch
 // +kubebuilder:rbac:groups=cert-manager.io,resources=certificates,verbs=get;list;watch;create;update;patch
 // +kubebuilder:rbac:groups=cert-manager.io,resources=issuers,verbs=get;list;watch;create;update;patch
 // +kubebuilder:rbac:groups=deploy.operator.kcp.io,resources=compiledcacheservers,verbs=get;list;watch;create;update;patch
 // +kubebuilder:rbac:groups=deploy.operator.kcp.io,resources=compiledcacheservers/finalizers,verbs=update
 // +kubebuilder:rbac:groups=core,resources=secrets,verbs=get;list;watch;create;update;patch
 
-func (r *CacheServerReconciler) Reconcile(ctx context.Context, req mcreconcile.Request) (ctrlruntime.Result, error) {
+func (r *CacheServerReconciler) Reconcile(ctx context.Context, req mcreconcile.Request) (res ctrlruntime.Result, recErr error) {
+	startTime := time.Now()
+	defer func() {
+		duration := time.Since(startTime)
+		metrics.RecordReconciliationMetrics(metrics.CacheServerResourceType, duration.Seconds(), recErr)
+	}()
+
 	logger := log.FromContext(ctx).WithValues("cluster", req.ClusterName)
 	logger.V(4).Info("Reconciling")
 
@@ -77,33 +103,65 @@ func (r *CacheServerReconciler) Reconcile(ctx context.Context, req mcreconcile.R
 
 	server := &operatorv1alpha1.CacheServer{}
 	if err := cl.GetClient().Get(ctx, req.NamespacedName, server); err != nil {
-		return ctrlruntime.Result{}, ctrlruntimeclient.IgnoreNotFound(err)
-	}
+		if ctrlruntimeclient.IgnoreNotFound(err) != nil {
+			metrics.RecordReconciliationError(metrics.CacheServerResourceType, err.Error())
+			return ctrlruntime.Result{}, fmt.Errorf("failed to get CacheServer object: %w", err)
+		}
 
-	if server.DeletionTimestamp != nil {
+		// Object has apparently been deleted already.
 		return ctrlruntime.Result{}, nil
 	}
 
-	if err := r.reconcile(ctx, cl.GetClient(), server); err != nil {
-		return ctrlruntime.Result{}, err
+	recorder := r.Events.For(req.ClusterName, cl)
+
+	var certs []*certmanagerv1.Certificate
+	switch {
+	case util.IsPaused(server):
+		logger.V(4).Info("Skipping reconciliation because the object is paused")
+		recErr = util.PauseCompiled(ctx, cl.GetClient(), req.NamespacedName, &deployv1alpha1.CompiledCacheServer{})
+
+	case server.DeletionTimestamp == nil:
+		metrics.RecordGeneration(server)
+		recErr = r.reconcile(ctx, cl.GetClient(), recorder, server, &certs)
+	}
+
+	var conditions []metav1.Condition
+	certsCond, requeueAfter, err := util.GetCertificatesReadyCondition(ctx, cl.GetClient(), r.PKI, server)
+	if err != nil {
+		recErr = kerrors.NewAggregate([]error{recErr, err})
+	} else {
+		conditions = append(conditions, certsCond)
+	}
+
+	if err := r.reconcileStatus(ctx, cl.GetClient(), recorder, server, conditions, certs); err != nil {
+		recErr = kerrors.NewAggregate([]error{recErr, err})
 	}
 
-	return ctrlruntime.Result{}, nil
+	recorder.ReconcileFailed(server, recErr)
+
+	requeueAfter = util.MinRequeueAfter(requeueAfter, util.CertificateRotationRequeueAfter(server))
+
+	return ctrlruntime.Result{RequeueAfter: requeueAfter}, recErr
 }
 
-func (r *CacheServerReconciler) reconcile(ctx context.Context, client ctrlruntimeclient.Client, server *operatorv1alpha1.CacheServer) error {
+func (r *CacheServerReconciler) reconcile(ctx context.Context, client ctrlruntimeclient.Client, recorder *util.Recorder, server *operatorv1alpha1.CacheServer, certs *[]*certmanagerv1.Certificate) error {
 	ownerRefWrapper := k8creconciling.OwnerRefWrapper(*metav1.NewControllerRef(server, operatorv1alpha1.SchemeGroupVersion.WithKind("CacheServer")))
 
-	var certs []*certmanagerv1.Certificate
-	if err := reconciling.ReconcileCertificates(ctx, []reconciling.NamedCertificateReconcilerFactory{
-		cacheserver.RootCACertificateReconciler(server),
-		cacheserver.ServerCertificateReconciler(server),
-		cacheserver.ClientCertificateReconciler(server),
-	}, server.Namespace, client, ownerRefWrapper, modifier.Capture(&certs)); err != nil {
+	if err := tracing.Trace(ctx, "ReconcileCertificates", func(ctx context.Context) error {
+		return r.PKI.ReconcileCertificates(ctx, []reconciling.NamedCertificateReconcilerFactory{
+			cacheserver.RootCACertificateReconciler(server),
+			cacheserver.ServerCertificateReconciler(server),
+			cacheserver.ClientCertificateReconciler(server),
+		}, server.Namespace, client, ownerRefWrapper, modifier.Capture(certs))
+	}); err != nil {
+		return err
+	}
+
+	if err := util.RotateCertificates(ctx, client, r.PKI, recorder, server, server.Status.CertificateRotation, *certs); err != nil {
 		return err
 	}
 
-	if err := reconciling.ReconcileIssuers(ctx, []reconciling.NamedIssuerReconcilerFactory{
+	if err := r.PKI.ReconcileIssuers(ctx, []reconciling.NamedIssuerReconcilerFactory{
 		cacheserver.RootCAIssuerReconciler(server),
 	}, server.Namespace, client, ownerRefWrapper); err != nil {
 		return err
@@ -117,13 +175,161 @@ func (r *CacheServerReconciler) reconcile(ctx context.Context, client ctrlruntim
 
 	// Only publish the render input once every Certificate is ready, so that whoever consumes
 	// it can rely on the Secrets it mounts already existing.
-	revisions, certsReady := util.CertificateRevisions(certs)
+	revisions, certsReady := util.CertificateRevisions(*certs)
 	if !certsReady {
 		return nil
 	}
 
+	recorder.CertificatesIssued(server, len(*certs))
+
+	// The cache server is the first component to be upgraded, but it must not introduce an
+	// unsupported version skew in any of the installations using it.
+	var rootShards operatorv1alpha1.RootShardList
+	if err := client.List(ctx, &rootShards, ctrlruntimeclient.InNamespace(server.Namespace)); err != nil {
+		return fmt.Errorf("failed to list RootShards: %w", err)
+	}
+
+	compiledServer := server
+	for _, rootShard := range rootShards.Items {
+		if ref := rootShard.Spec.Cache.Reference; ref == nil || ref.Name != server.Name {
+			continue
+		}
+
+		plan, err := util.GetUpgradePlan(ctx, client, &rootShard)
+		if err != nil {
+			return fmt.Errorf("failed to determine upgrade plan: %w", err)
+		}
+
+		if decision := plan.Decide(util.UpgradeKindCacheServer, server.Name); decision.HeldBack() {
+			compiledServer = server.DeepCopy()
+			compiledServer.Spec.Image = decision.Image
+			break
+		}
+	}
+
 	// The workloads themselves are rendered by the CompiledCacheServer controller.
-	return reconciling.ReconcileCompiledCacheServers(ctx, []reconciling.NamedCompiledCacheServerReconcilerFactory{
-		cacheserver.CompiledCacheServerReconciler(server, util.MutateKeys(revisions, "cert-", "-revision")),
-	}, server.Namespace, client, ownerRefWrapper)
+	var published bool
+	if err := tracing.Trace(ctx, "PublishCompiled", func(ctx context.Context) error {
+		return reconciling.ReconcileCompiledCacheServers(ctx, []reconciling.NamedCompiledCacheServerReconcilerFactory{
+			cacheserver.CompiledCacheServerReconciler(compiledServer, util.MutateKeys(revisions, "cert-", "-revision")),
+		}, server.Namespace, client, ownerRefWrapper, modifier.PublishedAt(time.Now(), &published), modifier.TraceParent(ctx))
+	}); err != nil {
+		return err
+	}
+
+	metrics.RecordPublished(metrics.CacheServerResourceType, server, published)
+
+	return nil
+}
+
+func (r *CacheServerReconciler) reconcileStatus(ctx context.Context, client ctrlruntimeclient.Client, recorder *util.Recorder, oldServer *operatorv1alpha1.CacheServer, conditions []metav1.Condition, certs []*certmanagerv1.Certificate) error {
+	server := oldServer.DeepCopy()
+	var errs []error
+
+	// Certificates are only known if they have been reconciled.
+	if certs != nil {
+		server.Status.Certificates = util.CertificateInventory(certs)
+	}
+
+	compiled := &deployv1alpha1.CompiledCacheServer{}
+	key := types.NamespacedName{Namespace: server.Namespace, Name: server.Name}
+	if err := client.Get(ctx, key, compiled); ctrlruntimeclient.IgnoreNotFound(err) != nil {
+		errs = append(errs, err)
+	} else {
+		cond := util.GetCompiledAvailableCondition(compiled.Status.Conditions, "CompiledCacheServer "+server.Name)
+		cond.ObservedGeneration = server.Generation
+		server.Status.Conditions = util.UpdateCondition(server.Status.Conditions, cond)
+		server.Status.Placement = compiled.Status.Placement
+
+		if rotation, cond := util.CertificateRotation(server, server.Status.CertificateRotation, certs, compiled, compiled.Status.Conditions); cond != nil {
+			server.Status.CertificateRotation = rotation
+			cond.ObservedGeneration = server.Generation
+			apimeta.SetStatusCondition(&server.Status.Conditions, *cond)
+		}
+
+		recorder.CompiledPublished(server, compiled, "CompiledCacheServer")
+
+		if cond := util.GetCompiledCondition(compiled.Status.Conditions, operatorv1alpha1.ConditionTypeHealthy); cond != nil {
+			conditions = append(conditions, *cond)
+		}
+	}
+
+	rootShards, shards, err := getCacheServerConsumers(ctx, client, server)
+	if err != nil {
+		errs = append(errs, err)
+	} else {
+		server.Status.RootShards = rootShards
+		server.Status.Shards = shards
+	}
+
+	for _, condition := range conditions {
+		condition.ObservedGeneration = server.Generation
+		server.Status.Conditions = util.UpdateCondition(server.Status.Conditions, condition)
+	}
+	server.Status.Conditions = util.UpdatePausedCondition(server, server.Status.Conditions)
+	server.Status.ObservedGeneration = server.Generation
+
+	if server.DeletionTimestamp != nil {
+		server.Status.Phase = operatorv1alpha1.CacheServerPhaseDeleting
+	} else {
+		availableCond := apimeta.FindStatusCondition(server.Status.Conditions, string(operatorv1alpha1.ConditionTypeAvailable))
+
+		if availableCond != nil && availableCond.Status == metav1.ConditionTrue {
+			server.Status.Phase = operatorv1alpha1.CacheServerPhaseRunning
+		} else {
+			server.Status.Phase = operatorv1alpha1.CacheServerPhaseProvisioning
+		}
+	}
+
+	// only patch the status if there are actual changes.
+	if !equality.Semantic.DeepEqual(oldServer.Status, server.Status) {
+		if err := client.Status().Patch(ctx, server, ctrlruntimeclient.MergeFrom(oldServer)); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	return kerrors.NewAggregate(errs)
+}
+
+// getCacheServerConsumers returns the RootShards and Shards in the server's namespace that use it.
+// Shards without their own cache configuration inherit the one of their RootShard.
+func getCacheServerConsumers(ctx context.Context, client ctrlruntimeclient.Client, server *operatorv1alpha1.CacheServer) ([]operatorv1alpha1.ShardReference, []operatorv1alpha1.ShardReference, error) {
+	var rootShardList operatorv1alpha1.RootShardList
+	if err := client.List(ctx, &rootShardList, ctrlruntimeclient.InNamespace(server.Namespace)); err != nil {
+		return nil, nil, fmt.Errorf("failed to list RootShards: %w", err)
+	}
+
+	var shardList operatorv1alpha1.ShardList
+	if err := client.List(ctx, &shardList, ctrlruntimeclient.InNamespace(server.Namespace)); err != nil {
+		return nil, nil, fmt.Errorf("failed to list Shards: %w", err)
+	}
+
+	usingRootShards := sets.New[string]()
+	for _, rootShard := range rootShardList.Items {
+		if ref := rootShard.Spec.Cache.Reference; ref != nil && ref.Name == server.Name {
+			usingRootShards.Insert(rootShard.Name)
+		}
+	}
+
+	usingShards := sets.New[string]()
+	for _, shard := range shardList.Items {
+		if shard.Spec.Cache != nil && shard.Spec.Cache.Reference != nil {
+			if shard.Spec.Cache.Reference.Name == server.Name {
+				usingShards.Insert(shard.Name)
+			}
+		} else if ref := shard.Spec.RootShard.Reference; ref != nil && usingRootShards.Has(ref.Name) {
+			usingShards.Insert(shard.Name)
+		}
+	}
+
+	return toShardReferences(usingRootShards), toShardReferences(usingShards), nil
+}
+
+func toShardReferences(names sets.Set[string]) []operatorv1alpha1.ShardReference {
+	var refs []operatorv1alpha1.ShardReference
+	for _, name := range sets.List(names) {
+		refs = append(refs, operatorv1alpha1.ShardReference{Name: name})
+	}
+
+	return refs
 }
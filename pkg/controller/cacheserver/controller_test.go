@@ -22,6 +22,7 @@ import (
 
 	"github.com/stretchr/testify/require"
 
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	ctrlruntimeclient "sigs.k8s.io/controller-runtime/pkg/client"
 	ctrlruntimefakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
@@ -29,6 +30,7 @@ import (
 	mcreconcile "sigs.k8s.io/multicluster-runtime/pkg/reconcile"
 
 	"github.com/kcp-dev/kcp-operator/pkg/controller/util"
+	"github.com/kcp-dev/kcp-operator/pkg/pki"
 	operatorv1alpha1 "github.com/kcp-dev/kcp-operator/sdk/apis/operator/v1alpha1"
 )
 
@@ -74,6 +76,7 @@ func TestReconciling(t *testing.T) {
 
 			controllerReconciler := &CacheServerReconciler{
 				GetCluster: util.FakeSingleCluster(client),
+				PKI:        pki.CertManager{},
 			}
 
 			_, err := controllerReconciler.Reconcile(ctx, mcreconcile.Request{
@@ -85,3 +88,92 @@ func TestReconciling(t *testing.T) {
 		})
 	}
 }
+
+func TestStatusListsConsumers(t *testing.T) {
+	const namespace = "cacheserver-tests"
+
+	cacheServer := &operatorv1alpha1.CacheServer{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "cachy",
+			Namespace: namespace,
+		},
+		Spec: operatorv1alpha1.CacheServerSpec{
+			Certificates: operatorv1alpha1.Certificates{
+				IssuerRef: &operatorv1alpha1.ObjectReference{
+					Name: "test",
+				},
+			},
+		},
+	}
+
+	newRootShard := func(name, cache string) *operatorv1alpha1.RootShard {
+		rs := &operatorv1alpha1.RootShard{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      name,
+				Namespace: namespace,
+			},
+		}
+		if cache != "" {
+			rs.Spec.Cache.Reference = &corev1.LocalObjectReference{Name: cache}
+		}
+		return rs
+	}
+
+	newShard := func(name, rootShard, cache string) *operatorv1alpha1.Shard {
+		s := &operatorv1alpha1.Shard{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      name,
+				Namespace: namespace,
+			},
+			Spec: operatorv1alpha1.ShardSpec{
+				RootShard: operatorv1alpha1.RootShardConfig{
+					Reference: &corev1.LocalObjectReference{Name: rootShard},
+				},
+			},
+		}
+		if cache != "" {
+			s.Spec.Cache = &operatorv1alpha1.ShardCacheConfig{
+				Reference: &corev1.LocalObjectReference{Name: cache},
+			}
+		}
+		return s
+	}
+
+	client := ctrlruntimefakeclient.
+		NewClientBuilder().
+		WithScheme(util.GetTestScheme()).
+		WithStatusSubresource(cacheServer).
+		WithObjects(
+			cacheServer,
+			newRootShard("root-using-cache", "cachy"),
+			newRootShard("root-embedded", ""),
+			// inherits the cache from its root shard
+			newShard("inheriting", "root-using-cache", ""),
+			// overrides the cache of its root shard
+			newShard("overriding", "root-using-cache", "other-cache"),
+			newShard("explicit", "root-embedded", "cachy"),
+			newShard("unrelated", "root-embedded", ""),
+		).
+		Build()
+
+	ctx := context.Background()
+
+	controllerReconciler := &CacheServerReconciler{
+		GetCluster: util.FakeSingleCluster(client),
+		PKI:        pki.CertManager{},
+	}
+
+	_, err := controllerReconciler.Reconcile(ctx, mcreconcile.Request{
+		Request: reconcile.Request{
+			NamespacedName: ctrlruntimeclient.ObjectKeyFromObject(cacheServer),
+		},
+	})
+	require.NoError(t, err)
+
+	updated := &operatorv1alpha1.CacheServer{}
+	require.NoError(t, client.Get(ctx, ctrlruntimeclient.ObjectKeyFromObject(cacheServer), updated))
+
+	require.Equal(t, operatorv1alpha1.CacheServerPhaseProvisioning, updated.Status.Phase)
+	require.Equal(t, []operatorv1alpha1.ShardReference{{Name: "root-using-cache"}}, updated.Status.RootShards)
+	require.Equal(t, []operatorv1alpha1.ShardReference{{Name: "explicit"}, {Name: "inheriting"}}, updated.Status.Shards)
+}
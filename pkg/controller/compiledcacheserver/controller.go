@@ -20,12 +20,19 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"time"
 
 	k8creconciling "k8c.io/reconciler/pkg/reconciling"
 
 	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
+	policyv1 "k8s.io/api/policy/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	apimeta "k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	kerrors "k8s.io/apimachinery/pkg/util/errors"
+	"k8s.io/utils/ptr"
 	ctrlruntime "sigs.k8s.io/controller-runtime"
 	ctrlruntimeclient "sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/cluster"
@@ -35,15 +42,22 @@ import (
 	"sigs.k8s.io/multicluster-runtime/pkg/multicluster"
 	mcreconcile "sigs.k8s.io/multicluster-runtime/pkg/reconcile"
 
+	"github.com/kcp-dev/kcp-operator/internal/resources"
 	"github.com/kcp-dev/kcp-operator/internal/resources/compiledcacheserver"
+	"github.com/kcp-dev/kcp-operator/internal/resources/utils"
+	operatorclient "github.com/kcp-dev/kcp-operator/pkg/client"
 	"github.com/kcp-dev/kcp-operator/pkg/controller/util"
+	"github.com/kcp-dev/kcp-operator/pkg/metrics"
 	"github.com/kcp-dev/kcp-operator/pkg/reconciling/modifier"
+	"github.com/kcp-dev/kcp-operator/pkg/tracing"
 	deployv1alpha1 "github.com/kcp-dev/kcp-operator/sdk/apis/deploy/v1alpha1"
+	operatorv1alpha1 "github.com/kcp-dev/kcp-operator/sdk/apis/operator/v1alpha1"
 )
 
 // CompiledCacheServerReconciler reconciles a CompiledCacheServer object
 type CompiledCacheServerReconciler struct {
 	GetCluster func(ctx context.Context, clusterName multicluster.ClusterName) (cluster.Cluster, error)
+	Address    operatorclient.Addresser
 }
 
 func (r *CompiledCacheServerReconciler) SetupWithManager(mgr mcmanager.Manager, opts ...mcbuilder.EngageOptions) error {
@@ -58,17 +72,26 @@ func (r *CompiledCacheServerReconciler) SetupWithManager(mgr mcmanager.Manager,
 		For(&deployv1alpha1.CompiledCacheServer{}, util.EngageFor(opts)...).
 		Owns(&appsv1.Deployment{}, util.EngageOwns(opts)...).
 		Owns(&corev1.Service{}, util.EngageOwns(opts)...).
+		Owns(&policyv1.PodDisruptionBudget{}, util.EngageOwns(opts)...).
 		Watches(&corev1.Secret{}, mountHandler, util.EngageWatches(opts)...).
-		Complete(r)
+		Complete(tracing.Reconciler("compiled-cache-server", r))
 }
 
 // +kubebuilder:rbac:groups=deploy.operator.kcp.io,resources=compiledcacheservers,verbs=get;list;watch
+// +kubebuilder:rbac:groups=deploy.operator.kcp.io,resources=compiledcacheservers/status,verbs=get;update;patch
 // +kubebuilder:rbac:groups=deploy.operator.kcp.io,resources=compiledcacheservers/finalizers,verbs=update
 // +kubebuilder:rbac:groups=apps,resources=deployments,verbs=get;list;watch;create;update;patch
 // +kubebuilder:rbac:groups=core,resources=services,verbs=get;list;watch;create;update;patch
 // +kubebuilder:rbac:groups=core,resources=configmaps;secrets,verbs=get;list;watch
+// +kubebuilder:rbac:groups=policy,resources=poddisruptionbudgets,verbs=get;list;watch;create;update;patch;delete
+
+func (r *CompiledCacheServerReconciler) Reconcile(ctx context.Context, req mcreconcile.Request) (res ctrlruntime.Result, recErr error) {
+	startTime := time.Now()
+	defer func() {
+		duration := time.Since(startTime)
+		metrics.RecordReconciliationMetrics(metrics.CompiledCacheServerResourceType, duration.Seconds(), recErr)
+	}()
 
-func (r *CompiledCacheServerReconciler) Reconcile(ctx context.Context, req mcreconcile.Request) (ctrlruntime.Result, error) {
 	logger := log.FromContext(ctx).WithValues("cluster", req.ClusterName)
 	logger.V(4).Info("Reconciling")
 
@@ -79,21 +102,45 @@ func (r *CompiledCacheServerReconciler) Reconcile(ctx context.Context, req mcrec
 
 	server := &deployv1alpha1.CompiledCacheServer{}
 	if err := cl.GetClient().Get(ctx, req.NamespacedName, server); err != nil {
-		return ctrlruntime.Result{}, ctrlruntimeclient.IgnoreNotFound(err)
+		if ctrlruntimeclient.IgnoreNotFound(err) != nil {
+			metrics.RecordReconciliationError(metrics.CompiledCacheServerResourceType, err.Error())
+			return ctrlruntime.Result{}, fmt.Errorf("failed to find %s/%s: %w", req.Namespace, req.Name, err)
+		}
+
+		// Object has apparently been deleted already.
+		return ctrlruntime.Result{}, nil
 	}
 
-	if server.DeletionTimestamp != nil {
+	// Objects placed into another cluster are deployed by the workload controllers in that
+	// cluster, their status is copied back by the syncer.
+	if cluster := resources.GetPlacementCluster(server); cluster != "" {
+		logger.V(4).Info("Skipping reconciliation because the object is placed into another cluster", "placement", cluster)
 		return ctrlruntime.Result{}, nil
 	}
 
-	if err := r.reconcile(ctx, cl.GetClient(), server); err != nil {
-		return ctrlruntime.Result{}, err
+	// Link the workload side to the config reconcile that published this revision.
+	tracing.LinkCompiled(ctx, server)
+
+	if util.IsPaused(server) {
+		logger.V(4).Info("Skipping reconciliation because the object is paused")
+	} else {
+		renderCtx, span := tracing.Start(ctx, "RenderWorkloads")
+		recErr = r.reconcile(renderCtx, cl.GetClient(), server)
+		tracing.End(span, recErr)
+	}
+
+	if err := r.reconcileStatus(ctx, cl.GetClient(), server); err != nil {
+		recErr = kerrors.NewAggregate([]error{recErr, err})
 	}
 
-	return ctrlruntime.Result{}, nil
+	return ctrlruntime.Result{RequeueAfter: util.HealthProbeInterval}, recErr
 }
 
 func (r *CompiledCacheServerReconciler) reconcile(ctx context.Context, client ctrlruntimeclient.Client, server *deployv1alpha1.CompiledCacheServer) error {
+	if server.DeletionTimestamp != nil {
+		return nil
+	}
+
 	ownerRefWrapper := k8creconciling.OwnerRefWrapper(*metav1.NewControllerRef(server, deployv1alpha1.SchemeGroupVersion.WithKind("CompiledCacheServer")))
 	revisionLabels := modifier.RelatedRevisionsLabels(ctx, client)
 
@@ -102,7 +149,7 @@ func (r *CompiledCacheServerReconciler) reconcile(ctx context.Context, client ct
 	// after all Secrets have been reconciled.
 	if err := k8creconciling.ReconcileDeployments(ctx, []k8creconciling.NamedDeploymentReconcilerFactory{
 		compiledcacheserver.DeploymentReconciler(server),
-	}, server.Namespace, client, ownerRefWrapper, revisionLabels); err != nil {
+	}, server.Namespace, client, ownerRefWrapper, revisionLabels, modifier.CopyPublishedAt(server)); err != nil {
 		// Swallow these errors and instead rely on us watching Secrets and re-reconciling whenever they change.
 		if errors.Is(err, modifier.ErrMountNotFound) {
 			return nil
@@ -116,5 +163,72 @@ func (r *CompiledCacheServerReconciler) reconcile(ctx context.Context, client ct
 		return err
 	}
 
+	// With an embedded etcd, the cache server always runs a single replica.
+	replicas := int32(1)
+	if server.Spec.CacheServer.Etcd != nil {
+		replicas = ptr.Deref(server.Spec.CacheServer.Replicas, 2)
+	}
+
+	if err := utils.ReconcilePodDisruptionBudget(ctx, client, server.Namespace, replicas, compiledcacheserver.PodDisruptionBudgetReconciler(server), ownerRefWrapper); err != nil {
+		return err
+	}
+
 	return nil
 }
+
+// reconcileStatus sets both phase and conditions on the reconciled CompiledCacheServer object.
+func (r *CompiledCacheServerReconciler) reconcileStatus(ctx context.Context, client ctrlruntimeclient.Client, oldServer *deployv1alpha1.CompiledCacheServer) error {
+	server := oldServer.DeepCopy()
+	var errs []error
+
+	depKey := types.NamespacedName{Namespace: server.Namespace, Name: resources.GetCompiledCacheServerDeploymentName(server)}
+	cond, err := util.GetDeploymentAvailableCondition(ctx, client, depKey)
+	if err != nil {
+		errs = append(errs, err)
+	} else {
+		cond.ObservedGeneration = server.Generation
+		server.Status.Conditions = util.UpdateCondition(server.Status.Conditions, cond)
+	}
+
+	if err := util.RecordDeploymentRollout(ctx, client, metrics.CacheServerResourceType, server, depKey); err != nil {
+		errs = append(errs, err)
+	}
+
+	sourceServer := &operatorv1alpha1.CacheServer{
+		ObjectMeta: metav1.ObjectMeta{Name: server.Name, Namespace: server.Namespace},
+		Spec:       server.Spec.CacheServer,
+	}
+
+	cond, err = util.GetHealthyCondition(ctx, client, depKey, func(ctx context.Context) ([]string, error) {
+		return operatorclient.GetCacheServerFailedHealthChecks(ctx, client, r.Address.CacheServer(sourceServer), sourceServer)
+	})
+	if err != nil {
+		errs = append(errs, err)
+	} else {
+		cond.ObservedGeneration = server.Generation
+		server.Status.Conditions = util.UpdateCondition(server.Status.Conditions, cond)
+	}
+
+	server.Status.Conditions = util.UpdatePausedCondition(server, server.Status.Conditions)
+	server.Status.ObservedGeneration = server.Generation
+
+	if server.DeletionTimestamp != nil {
+		server.Status.Phase = operatorv1alpha1.CacheServerPhaseDeleting
+	} else {
+		availableCond := apimeta.FindStatusCondition(server.Status.Conditions, string(operatorv1alpha1.ConditionTypeAvailable))
+
+		if availableCond != nil && availableCond.Status == metav1.ConditionTrue {
+			server.Status.Phase = operatorv1alpha1.CacheServerPhaseRunning
+		} else {
+			server.Status.Phase = operatorv1alpha1.CacheServerPhaseProvisioning
+		}
+	}
+
+	if !equality.Semantic.DeepEqual(oldServer.Status, server.Status) {
+		if err := client.Status().Patch(ctx, server, ctrlruntimeclient.MergeFrom(oldServer)); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	return kerrors.NewAggregate(errs)
+}
@@ -85,6 +85,11 @@ func TestReconciling(t *testing.T) {
 				},
 			})
 			require.NoError(t, err)
+
+			updated := &deployv1alpha1.CompiledCacheServer{}
+			require.NoError(t, client.Get(ctx, ctrlruntimeclient.ObjectKeyFromObject(testcase.cacheServer), updated))
+			// The fake client never rolls out the Deployment.
+			require.Equal(t, operatorv1alpha1.CacheServerPhaseProvisioning, updated.Status.Phase)
 		})
 	}
 }
@@ -22,12 +22,15 @@ import (
 	"time"
 
 	appsv1 "k8s.io/api/apps/v1"
+	autoscalingv2 "k8s.io/api/autoscaling/v2"
 	corev1 "k8s.io/api/core/v1"
+	policyv1 "k8s.io/api/policy/v1"
 	"k8s.io/apimachinery/pkg/api/equality"
 	apimeta "k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
 	kerrors "k8s.io/apimachinery/pkg/util/errors"
+	"k8s.io/apimachinery/pkg/version"
 	ctrl "sigs.k8s.io/controller-runtime"
 	ctrlruntimeclient "sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/cluster"
@@ -39,8 +42,10 @@ import (
 
 	"github.com/kcp-dev/kcp-operator/internal/resources"
 	"github.com/kcp-dev/kcp-operator/internal/resources/compiledfrontproxy"
+	operatorclient "github.com/kcp-dev/kcp-operator/pkg/client"
 	"github.com/kcp-dev/kcp-operator/pkg/controller/util"
 	"github.com/kcp-dev/kcp-operator/pkg/metrics"
+	"github.com/kcp-dev/kcp-operator/pkg/tracing"
 	deployv1alpha1 "github.com/kcp-dev/kcp-operator/sdk/apis/deploy/v1alpha1"
 	operatorv1alpha1 "github.com/kcp-dev/kcp-operator/sdk/apis/operator/v1alpha1"
 )
@@ -48,6 +53,7 @@ import (
 // CompiledFrontProxyReconciler reconciles a CompiledFrontProxy object
 type CompiledFrontProxyReconciler struct {
 	GetCluster func(ctx context.Context, clusterName multicluster.ClusterName) (cluster.Cluster, error)
+	Address    operatorclient.Addresser
 }
 
 // SetupWithManager sets up the controller with the Manager.
@@ -64,8 +70,10 @@ func (r *CompiledFrontProxyReconciler) SetupWithManager(mgr mcmanager.Manager, o
 		Owns(&appsv1.Deployment{}, util.EngageOwns(opts)...).
 		Owns(&corev1.ConfigMap{}, util.EngageOwns(opts)...).
 		Owns(&corev1.Service{}, util.EngageOwns(opts)...).
+		Owns(&policyv1.PodDisruptionBudget{}, util.EngageOwns(opts)...).
+		Owns(&autoscalingv2.HorizontalPodAutoscaler{}, util.EngageOwns(opts)...).
 		Watches(&corev1.Secret{}, mountHandler, util.EngageWatches(opts)...).
-		Complete(r)
+		Complete(tracing.Reconciler("compiled-frontproxy", r))
 }
 
 // +kubebuilder:rbac:groups=deploy.operator.kcp.io,resources=compiledfrontproxies,verbs=get;list;watch
@@ -73,6 +81,8 @@ func (r *CompiledFrontProxyReconciler) SetupWithManager(mgr mcmanager.Manager, o
 // +kubebuilder:rbac:groups=deploy.operator.kcp.io,resources=compiledfrontproxies/finalizers,verbs=update
 // +kubebuilder:rbac:groups=apps,resources=deployments,verbs=get;list;watch;create;update;patch
 // +kubebuilder:rbac:groups=core,resources=services;configmaps;secrets,verbs=get;list;watch;create;update;patch
+// +kubebuilder:rbac:groups=policy,resources=poddisruptionbudgets,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=autoscaling,resources=horizontalpodautoscalers,verbs=get;list;watch;create;update;patch;delete
 
 func (r *CompiledFrontProxyReconciler) Reconcile(ctx context.Context, req mcreconcile.Request) (res ctrl.Result, recErr error) {
 	startTime := time.Now()
@@ -100,13 +110,31 @@ func (r *CompiledFrontProxyReconciler) Reconcile(ctx context.Context, req mcreco
 		return ctrl.Result{}, nil
 	}
 
-	conditions, recErr := r.reconcile(ctx, cl.GetClient(), &frontProxy)
+	// Objects placed into another cluster are deployed by the workload controllers in that
+	// cluster, their status is copied back by the syncer.
+	if cluster := resources.GetPlacementCluster(&frontProxy); cluster != "" {
+		logger.V(4).Info("Skipping reconciliation because the object is placed into another cluster", "placement", cluster)
+		return ctrl.Result{}, nil
+	}
 
-	if err := r.reconcileStatus(ctx, cl.GetClient(), &frontProxy, conditions); err != nil {
+	// Link the workload side to the config reconcile that published this revision.
+	tracing.LinkCompiled(ctx, &frontProxy)
+
+	var conditions []metav1.Condition
+	if util.IsPaused(&frontProxy) {
+		logger.V(4).Info("Skipping reconciliation because the object is paused")
+	} else {
+		renderCtx, span := tracing.Start(ctx, "RenderWorkloads")
+		conditions, recErr = r.reconcile(renderCtx, cl.GetClient(), &frontProxy)
+		tracing.End(span, recErr)
+	}
+
+	requeueAfter, err := r.reconcileStatus(ctx, cl.GetClient(), &frontProxy, conditions)
+	if err != nil {
 		recErr = kerrors.NewAggregate([]error{recErr, err})
 	}
 
-	return ctrl.Result{}, recErr
+	return ctrl.Result{RequeueAfter: util.MinRequeueAfter(util.HealthProbeInterval, requeueAfter)}, recErr
 }
 
 //nolint:unparam // Keep the controller working the same as all the others, even though currently it does always return nil conditions.
@@ -127,9 +155,12 @@ func (r *CompiledFrontProxyReconciler) reconcile(ctx context.Context, client ctr
 	return conditions, kerrors.NewAggregate(errs)
 }
 
-func (r *CompiledFrontProxyReconciler) reconcileStatus(ctx context.Context, client ctrlruntimeclient.Client, oldFrontProxy *deployv1alpha1.CompiledFrontProxy, conditions []metav1.Condition) error {
+func (r *CompiledFrontProxyReconciler) reconcileStatus(ctx context.Context, client ctrlruntimeclient.Client, oldFrontProxy *deployv1alpha1.CompiledFrontProxy, conditions []metav1.Condition) (time.Duration, error) {
 	frontProxy := oldFrontProxy.DeepCopy()
-	var errs []error
+	var (
+		errs         []error
+		requeueAfter time.Duration
+	)
 
 	depKey := types.NamespacedName{Namespace: frontProxy.Namespace, Name: resources.GetCompiledFrontProxyDeploymentName(frontProxy)}
 	cond, err := util.GetDeploymentAvailableCondition(ctx, client, depKey)
@@ -139,10 +170,40 @@ func (r *CompiledFrontProxyReconciler) reconcileStatus(ctx context.Context, clie
 		conditions = append(conditions, cond)
 	}
 
+	if err := util.RecordDeploymentRollout(ctx, client, metrics.FrontProxyResourceType, frontProxy, depKey); err != nil {
+		errs = append(errs, err)
+	}
+
+	rootShard := &operatorv1alpha1.RootShard{
+		ObjectMeta: metav1.ObjectMeta{Name: frontProxy.Spec.RootShard.Name, Namespace: frontProxy.Namespace},
+		Spec:       frontProxy.Spec.RootShard.Spec,
+	}
+	sourceFrontProxy := &operatorv1alpha1.FrontProxy{
+		ObjectMeta: metav1.ObjectMeta{Name: frontProxy.Name, Namespace: frontProxy.Namespace},
+		Spec:       frontProxy.Spec.FrontProxy,
+	}
+
+	frontProxy.Status.Version, requeueAfter, err = util.GetVersionStatus(ctx, client, depKey, compiledfrontproxy.ServerContainerName, frontProxy.Status.Version, func(ctx context.Context) (*version.Info, error) {
+		return operatorclient.GetVersion(ctx, client, r.Address.FrontProxy(sourceFrontProxy, rootShard), rootShard)
+	})
+	if err != nil {
+		errs = append(errs, err)
+	}
+
+	cond, err = util.GetHealthyCondition(ctx, client, depKey, func(ctx context.Context) ([]string, error) {
+		return operatorclient.GetFailedHealthChecks(ctx, client, r.Address.FrontProxy(sourceFrontProxy, rootShard), rootShard)
+	})
+	if err != nil {
+		errs = append(errs, err)
+	} else {
+		conditions = append(conditions, cond)
+	}
+
 	for _, condition := range conditions {
 		condition.ObservedGeneration = frontProxy.Generation
 		frontProxy.Status.Conditions = util.UpdateCondition(frontProxy.Status.Conditions, condition)
 	}
+	frontProxy.Status.Conditions = util.UpdatePausedCondition(frontProxy, frontProxy.Status.Conditions)
 
 	if frontProxy.DeletionTimestamp != nil {
 		frontProxy.Status.Phase = operatorv1alpha1.FrontProxyPhaseDeleting
@@ -163,5 +224,5 @@ func (r *CompiledFrontProxyReconciler) reconcileStatus(ctx context.Context, clie
 		}
 	}
 
-	return kerrors.NewAggregate(errs)
+	return requeueAfter, kerrors.NewAggregate(errs)
 }
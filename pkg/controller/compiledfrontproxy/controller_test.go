@@ -22,7 +22,11 @@ import (
 
 	"github.com/stretchr/testify/require"
 
+	policyv1 "k8s.io/api/policy/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/utils/ptr"
 	ctrlruntimeclient "sigs.k8s.io/controller-runtime/pkg/client"
 	ctrlruntimefakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
@@ -94,3 +98,69 @@ func TestReconciling(t *testing.T) {
 		})
 	}
 }
+
+func TestPodDisruptionBudget(t *testing.T) {
+	frontProxy := &deployv1alpha1.CompiledFrontProxy{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "frontal",
+			Namespace: "frontproxy-tests",
+		},
+		Spec: deployv1alpha1.CompiledFrontProxySpec{
+			FrontProxy: operatorv1alpha1.FrontProxySpec{
+				External: operatorv1alpha1.ExternalConfig{
+					Hostname: "example.kcp.io",
+					Port:     6443,
+				},
+				PodDisruptionBudget: &operatorv1alpha1.PodDisruptionBudgetTemplate{
+					Spec: &operatorv1alpha1.PodDisruptionBudgetSpecTemplate{
+						MinAvailable: ptr.To(intstr.FromInt32(1)),
+					},
+				},
+			},
+			RootShard: deployv1alpha1.NamedRootShardSpec{
+				Name: "rooty",
+				Spec: operatorv1alpha1.RootShardSpec{
+					External: operatorv1alpha1.ExternalConfig{
+						Hostname: "example.kcp.io",
+						Port:     6443,
+					},
+				},
+			},
+		},
+	}
+
+	client := ctrlruntimefakeclient.
+		NewClientBuilder().
+		WithScheme(util.GetTestScheme()).
+		WithStatusSubresource(frontProxy).
+		WithObjects(frontProxy).
+		Build()
+
+	ctx := context.Background()
+	controllerReconciler := &CompiledFrontProxyReconciler{
+		GetCluster: util.FakeSingleCluster(client),
+	}
+	request := mcreconcile.Request{
+		Request: reconcile.Request{
+			NamespacedName: ctrlruntimeclient.ObjectKeyFromObject(frontProxy),
+		},
+	}
+	pdbKey := ctrlruntimeclient.ObjectKey{Namespace: frontProxy.Namespace, Name: "frontal-front-proxy"}
+
+	_, err := controllerReconciler.Reconcile(ctx, request)
+	require.NoError(t, err)
+
+	pdb := &policyv1.PodDisruptionBudget{}
+	require.NoError(t, client.Get(ctx, pdbKey, pdb))
+	require.Equal(t, ptr.To(intstr.FromInt32(1)), pdb.Spec.MinAvailable)
+	require.Nil(t, pdb.Spec.MaxUnavailable)
+
+	// scaling down to a single replica removes the PodDisruptionBudget
+	require.NoError(t, client.Get(ctx, request.NamespacedName, frontProxy))
+	frontProxy.Spec.FrontProxy.Replicas = ptr.To[int32](1)
+	require.NoError(t, client.Update(ctx, frontProxy))
+
+	_, err = controllerReconciler.Reconcile(ctx, request)
+	require.NoError(t, err)
+	require.True(t, apierrors.IsNotFound(client.Get(ctx, pdbKey, &policyv1.PodDisruptionBudget{})))
+}
@@ -25,12 +25,16 @@ import (
 	k8creconciling "k8c.io/reconciler/pkg/reconciling"
 
 	appsv1 "k8s.io/api/apps/v1"
+	autoscalingv2 "k8s.io/api/autoscaling/v2"
 	corev1 "k8s.io/api/core/v1"
+	policyv1 "k8s.io/api/policy/v1"
 	"k8s.io/apimachinery/pkg/api/equality"
 	apimeta "k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
 	kerrors "k8s.io/apimachinery/pkg/util/errors"
+	"k8s.io/apimachinery/pkg/version"
+	"k8s.io/utils/ptr"
 	ctrl "sigs.k8s.io/controller-runtime"
 	ctrlruntimeclient "sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/cluster"
@@ -43,9 +47,13 @@ import (
 	"github.com/kcp-dev/kcp-operator/internal/resources"
 	"github.com/kcp-dev/kcp-operator/internal/resources/compiledfrontproxy"
 	"github.com/kcp-dev/kcp-operator/internal/resources/compiledrootshard"
+	"github.com/kcp-dev/kcp-operator/internal/resources/etcd"
+	"github.com/kcp-dev/kcp-operator/internal/resources/utils"
+	operatorclient "github.com/kcp-dev/kcp-operator/pkg/client"
 	"github.com/kcp-dev/kcp-operator/pkg/controller/util"
 	"github.com/kcp-dev/kcp-operator/pkg/metrics"
 	"github.com/kcp-dev/kcp-operator/pkg/reconciling/modifier"
+	"github.com/kcp-dev/kcp-operator/pkg/tracing"
 	deployv1alpha1 "github.com/kcp-dev/kcp-operator/sdk/apis/deploy/v1alpha1"
 	operatorv1alpha1 "github.com/kcp-dev/kcp-operator/sdk/apis/operator/v1alpha1"
 )
@@ -53,6 +61,7 @@ import (
 // CompiledRootShardReconciler reconciles a CompiledRootShard object
 type CompiledRootShardReconciler struct {
 	GetCluster func(ctx context.Context, clusterName multicluster.ClusterName) (cluster.Cluster, error)
+	Address    operatorclient.Addresser
 }
 
 // SetupWithManager sets up the controller with the Manager.
@@ -67,18 +76,24 @@ func (r *CompiledRootShardReconciler) SetupWithManager(mgr mcmanager.Manager, op
 		Named("compiled-rootshard").
 		For(&deployv1alpha1.CompiledRootShard{}, util.EngageFor(opts)...).
 		Owns(&appsv1.Deployment{}, util.EngageOwns(opts)...).
+		Owns(&appsv1.StatefulSet{}, util.EngageOwns(opts)...).
 		Owns(&corev1.ConfigMap{}, util.EngageOwns(opts)...).
 		Owns(&corev1.Service{}, util.EngageOwns(opts)...).
+		Owns(&policyv1.PodDisruptionBudget{}, util.EngageOwns(opts)...).
+		Owns(&autoscalingv2.HorizontalPodAutoscaler{}, util.EngageOwns(opts)...).
 		Watches(&corev1.Secret{}, mountHandler, util.EngageWatches(opts)...).
-		Complete(r)
+		Complete(tracing.Reconciler("compiled-rootshard", r))
 }
 
 // +kubebuilder:rbac:groups=deploy.operator.kcp.io,resources=compiledrootshards,verbs=get;list;watch
 // +kubebuilder:rbac:groups=deploy.operator.kcp.io,resources=compiledrootshards/status,verbs=get;update;patch
 // +kubebuilder:rbac:groups=deploy.operator.kcp.io,resources=compiledrootshards/finalizers,verbs=update
 // +kubebuilder:rbac:groups=apps,resources=deployments,verbs=get;list;watch;create;update;patch
+// +kubebuilder:rbac:groups=apps,resources=statefulsets,verbs=get;list;watch;create;update;patch
 // +kubebuilder:rbac:groups=core,resources=services,verbs=get;list;watch;create;update;patch
 // +kubebuilder:rbac:groups=core,resources=configmaps;secrets,verbs=get;list;watch;create;update;patch
+// +kubebuilder:rbac:groups=policy,resources=poddisruptionbudgets,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=autoscaling,resources=horizontalpodautoscalers,verbs=get;list;watch;create;update;patch;delete
 
 func (r *CompiledRootShardReconciler) Reconcile(ctx context.Context, req mcreconcile.Request) (res ctrl.Result, recErr error) {
 	startTime := time.Now()
@@ -106,13 +121,31 @@ func (r *CompiledRootShardReconciler) Reconcile(ctx context.Context, req mcrecon
 		return ctrl.Result{}, nil
 	}
 
-	conditions, recErr := r.reconcile(ctx, cl.GetClient(), &rootShard)
+	// Objects placed into another cluster are deployed by the workload controllers in that
+	// cluster, their status is copied back by the syncer.
+	if cluster := resources.GetPlacementCluster(&rootShard); cluster != "" {
+		logger.V(4).Info("Skipping reconciliation because the object is placed into another cluster", "placement", cluster)
+		return ctrl.Result{}, nil
+	}
+
+	// Link the workload side to the config reconcile that published this revision.
+	tracing.LinkCompiled(ctx, &rootShard)
+
+	var conditions []metav1.Condition
+	if util.IsPaused(&rootShard) {
+		logger.V(4).Info("Skipping reconciliation because the object is paused")
+	} else {
+		renderCtx, span := tracing.Start(ctx, "RenderWorkloads")
+		conditions, recErr = r.reconcile(renderCtx, cl.GetClient(), &rootShard)
+		tracing.End(span, recErr)
+	}
 
-	if err := r.reconcileStatus(ctx, cl.GetClient(), &rootShard, conditions); err != nil {
+	requeueAfter, err := r.reconcileStatus(ctx, cl.GetClient(), &rootShard, conditions)
+	if err != nil {
 		recErr = kerrors.NewAggregate([]error{recErr, err})
 	}
 
-	return ctrl.Result{}, recErr
+	return ctrl.Result{RequeueAfter: util.MinRequeueAfter(util.HealthProbeInterval, requeueAfter)}, recErr
 }
 
 //nolint:unparam // Keep the controller working the same as all the others, even though currently it does always return nil conditions.
@@ -129,9 +162,26 @@ func (r *CompiledRootShardReconciler) reconcile(ctx context.Context, client ctrl
 	ownerRefWrapper := k8creconciling.OwnerRefWrapper(*metav1.NewControllerRef(rootShard, deployv1alpha1.SchemeGroupVersion.WithKind("CompiledRootShard")))
 	revisionLabels := modifier.RelatedRevisionsLabels(ctx, client)
 
+	if etcdSpec := rootShard.Spec.RootShard.Etcd.Managed; etcdSpec != nil {
+		if err := k8creconciling.ReconcileServices(ctx, []k8creconciling.NamedServiceReconcilerFactory{
+			etcd.ServiceReconciler(rootShard.Name),
+		}, rootShard.Namespace, client, ownerRefWrapper); err != nil {
+			errs = append(errs, err)
+		}
+
+		if err := k8creconciling.ReconcileStatefulSets(ctx, []k8creconciling.NamedStatefulSetReconcilerFactory{
+			etcd.StatefulSetReconciler(rootShard.Name, etcdSpec, resources.GetManagedEtcdDataDir(rootShard.Annotations), resources.IsEtcdRestoreInProgress(rootShard.Annotations)),
+		}, rootShard.Namespace, client, ownerRefWrapper, revisionLabels); err != nil {
+			// Like for the Deployment, rely on the Secret watch to retry once the etcd certificates exist.
+			if !errors.Is(err, modifier.ErrMountNotFound) {
+				errs = append(errs, err)
+			}
+		}
+	}
+
 	if err := k8creconciling.ReconcileDeployments(ctx, []k8creconciling.NamedDeploymentReconcilerFactory{
 		compiledrootshard.DeploymentReconciler(rootShard),
-	}, rootShard.Namespace, client, ownerRefWrapper, revisionLabels); err != nil {
+	}, rootShard.Namespace, client, ownerRefWrapper, revisionLabels, modifier.CopyPublishedAt(rootShard)); err != nil {
 		// Swallow these errors and instead rely on us watching Secrets and re-reconciling whenever they change.
 		if !errors.Is(err, modifier.ErrMountNotFound) {
 			errs = append(errs, err)
@@ -144,6 +194,11 @@ func (r *CompiledRootShardReconciler) reconcile(ctx context.Context, client ctrl
 		errs = append(errs, err)
 	}
 
+	replicas := ptr.Deref(rootShard.Spec.RootShard.Replicas, 2)
+	if err := utils.ReconcilePodDisruptionBudget(ctx, client, rootShard.Namespace, replicas, compiledrootshard.PodDisruptionBudgetReconciler(rootShard), ownerRefWrapper); err != nil {
+		errs = append(errs, err)
+	}
+
 	if err := compiledfrontproxy.NewRootShardProxy(rootShard).Reconcile(ctx, client, rootShard.Namespace); err != nil {
 		errs = append(errs, fmt.Errorf("failed to reconcile proxy: %w", err))
 	}
@@ -152,9 +207,12 @@ func (r *CompiledRootShardReconciler) reconcile(ctx context.Context, client ctrl
 }
 
 // reconcileStatus sets both phase and conditions on the reconciled CompiledRootShard object.
-func (r *CompiledRootShardReconciler) reconcileStatus(ctx context.Context, client ctrlruntimeclient.Client, oldRootShard *deployv1alpha1.CompiledRootShard, conditions []metav1.Condition) error {
+func (r *CompiledRootShardReconciler) reconcileStatus(ctx context.Context, client ctrlruntimeclient.Client, oldRootShard *deployv1alpha1.CompiledRootShard, conditions []metav1.Condition) (time.Duration, error) {
 	rootShard := oldRootShard.DeepCopy()
-	var errs []error
+	var (
+		errs         []error
+		requeueAfter time.Duration
+	)
 
 	depKey := types.NamespacedName{Namespace: rootShard.Namespace, Name: resources.GetCompiledRootShardDeploymentName(rootShard)}
 	cond, err := util.GetDeploymentAvailableCondition(ctx, client, depKey)
@@ -164,10 +222,48 @@ func (r *CompiledRootShardReconciler) reconcileStatus(ctx context.Context, clien
 		conditions = append(conditions, cond)
 	}
 
+	if err := util.RecordDeploymentRollout(ctx, client, metrics.RootShardResourceType, rootShard, depKey); err != nil {
+		errs = append(errs, err)
+	}
+
+	sourceRootShard := &operatorv1alpha1.RootShard{
+		ObjectMeta: metav1.ObjectMeta{Name: rootShard.Name, Namespace: rootShard.Namespace},
+		Spec:       rootShard.Spec.RootShard,
+	}
+
+	rootShard.Status.Version, requeueAfter, err = util.GetVersionStatus(ctx, client, depKey, compiledrootshard.ServerContainerName, rootShard.Status.Version, func(ctx context.Context) (*version.Info, error) {
+		return operatorclient.GetVersion(ctx, client, r.Address.RootShard(sourceRootShard), sourceRootShard)
+	})
+	if err != nil {
+		errs = append(errs, err)
+	}
+
+	cond, err = util.GetHealthyCondition(ctx, client, depKey, func(ctx context.Context) ([]string, error) {
+		return operatorclient.GetFailedHealthChecks(ctx, client, r.Address.RootShard(sourceRootShard), sourceRootShard)
+	})
+	if err != nil {
+		errs = append(errs, err)
+	} else {
+		conditions = append(conditions, cond)
+	}
+
+	if rootShard.Spec.RootShard.Etcd.Managed != nil {
+		etcdKey := types.NamespacedName{Namespace: rootShard.Namespace, Name: resources.GetManagedEtcdName(rootShard.Name)}
+		cond, err := util.GetEtcdHealthyCondition(ctx, client, etcdKey)
+		if err != nil {
+			errs = append(errs, err)
+		} else {
+			conditions = append(conditions, cond)
+		}
+	} else {
+		apimeta.RemoveStatusCondition(&rootShard.Status.Conditions, string(operatorv1alpha1.ConditionTypeEtcdHealthy))
+	}
+
 	for _, condition := range conditions {
 		condition.ObservedGeneration = rootShard.Generation
 		rootShard.Status.Conditions = util.UpdateCondition(rootShard.Status.Conditions, condition)
 	}
+	rootShard.Status.Conditions = util.UpdatePausedCondition(rootShard, rootShard.Status.Conditions)
 
 	if rootShard.DeletionTimestamp != nil {
 		rootShard.Status.Phase = operatorv1alpha1.RootShardPhaseDeleting
@@ -187,5 +283,5 @@ func (r *CompiledRootShardReconciler) reconcileStatus(ctx context.Context, clien
 		}
 	}
 
-	return kerrors.NewAggregate(errs)
+	return requeueAfter, kerrors.NewAggregate(errs)
 }
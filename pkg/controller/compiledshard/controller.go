@@ -26,11 +26,14 @@ import (
 
 	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
+	policyv1 "k8s.io/api/policy/v1"
 	"k8s.io/apimachinery/pkg/api/equality"
 	apimeta "k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
 	kerrors "k8s.io/apimachinery/pkg/util/errors"
+	"k8s.io/apimachinery/pkg/version"
+	"k8s.io/utils/ptr"
 	ctrl "sigs.k8s.io/controller-runtime"
 	ctrlruntimeclient "sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/cluster"
@@ -42,9 +45,13 @@ import (
 
 	"github.com/kcp-dev/kcp-operator/internal/resources"
 	"github.com/kcp-dev/kcp-operator/internal/resources/compiledshard"
+	"github.com/kcp-dev/kcp-operator/internal/resources/etcd"
+	"github.com/kcp-dev/kcp-operator/internal/resources/utils"
+	operatorclient "github.com/kcp-dev/kcp-operator/pkg/client"
 	"github.com/kcp-dev/kcp-operator/pkg/controller/util"
 	"github.com/kcp-dev/kcp-operator/pkg/metrics"
 	"github.com/kcp-dev/kcp-operator/pkg/reconciling/modifier"
+	"github.com/kcp-dev/kcp-operator/pkg/tracing"
 	deployv1alpha1 "github.com/kcp-dev/kcp-operator/sdk/apis/deploy/v1alpha1"
 	operatorv1alpha1 "github.com/kcp-dev/kcp-operator/sdk/apis/operator/v1alpha1"
 )
@@ -52,6 +59,7 @@ import (
 // CompiledShardReconciler reconciles a CompiledShard object
 type CompiledShardReconciler struct {
 	GetCluster func(ctx context.Context, clusterName multicluster.ClusterName) (cluster.Cluster, error)
+	Address    operatorclient.Addresser
 }
 
 func (r *CompiledShardReconciler) SetupWithManager(mgr mcmanager.Manager, opts ...mcbuilder.EngageOptions) error {
@@ -65,17 +73,21 @@ func (r *CompiledShardReconciler) SetupWithManager(mgr mcmanager.Manager, opts .
 		Named("compiled-shard").
 		For(&deployv1alpha1.CompiledShard{}, util.EngageFor(opts)...).
 		Owns(&appsv1.Deployment{}, util.EngageOwns(opts)...).
+		Owns(&appsv1.StatefulSet{}, util.EngageOwns(opts)...).
 		Owns(&corev1.Service{}, util.EngageOwns(opts)...).
+		Owns(&policyv1.PodDisruptionBudget{}, util.EngageOwns(opts)...).
 		Watches(&corev1.Secret{}, mountHandler, util.EngageWatches(opts)...).
-		Complete(r)
+		Complete(tracing.Reconciler("compiled-shard", r))
 }
 
 // +kubebuilder:rbac:groups=deploy.operator.kcp.io,resources=compiledshards,verbs=get;list;watch
 // +kubebuilder:rbac:groups=deploy.operator.kcp.io,resources=compiledshards/status,verbs=get;update;patch
 // +kubebuilder:rbac:groups=deploy.operator.kcp.io,resources=compiledshards/finalizers,verbs=update
 // +kubebuilder:rbac:groups=apps,resources=deployments,verbs=get;list;watch;create;update;patch
+// +kubebuilder:rbac:groups=apps,resources=statefulsets,verbs=get;list;watch;create;update;patch
 // +kubebuilder:rbac:groups=core,resources=services,verbs=get;list;watch;create;update;patch
 // +kubebuilder:rbac:groups=core,resources=configmaps;secrets,verbs=get;list;watch
+// +kubebuilder:rbac:groups=policy,resources=poddisruptionbudgets,verbs=get;list;watch;create;update;patch;delete
 
 func (r *CompiledShardReconciler) Reconcile(ctx context.Context, req mcreconcile.Request) (res ctrl.Result, recErr error) {
 	startTime := time.Now()
@@ -102,13 +114,31 @@ func (r *CompiledShardReconciler) Reconcile(ctx context.Context, req mcreconcile
 		return ctrl.Result{}, nil
 	}
 
-	conditions, recErr := r.reconcile(ctx, cl.GetClient(), &s)
+	// Objects placed into another cluster are deployed by the workload controllers in that
+	// cluster, their status is copied back by the syncer.
+	if cluster := resources.GetPlacementCluster(&s); cluster != "" {
+		logger.V(4).Info("Skipping reconciliation because the object is placed into another cluster", "placement", cluster)
+		return ctrl.Result{}, nil
+	}
+
+	// Link the workload side to the config reconcile that published this revision.
+	tracing.LinkCompiled(ctx, &s)
+
+	var conditions []metav1.Condition
+	if util.IsPaused(&s) {
+		logger.V(4).Info("Skipping reconciliation because the object is paused")
+	} else {
+		renderCtx, span := tracing.Start(ctx, "RenderWorkloads")
+		conditions, recErr = r.reconcile(renderCtx, cl.GetClient(), &s)
+		tracing.End(span, recErr)
+	}
 
-	if err := r.reconcileStatus(ctx, cl.GetClient(), &s, conditions); err != nil {
+	requeueAfter, err := r.reconcileStatus(ctx, cl.GetClient(), &s, conditions)
+	if err != nil {
 		recErr = kerrors.NewAggregate([]error{recErr, err})
 	}
 
-	return ctrl.Result{}, recErr
+	return ctrl.Result{RequeueAfter: util.MinRequeueAfter(util.HealthProbeInterval, requeueAfter)}, recErr
 }
 
 //nolint:unparam // Keep the controller working the same as all the others, even though currently it does always return nil conditions.
@@ -125,9 +155,26 @@ func (r *CompiledShardReconciler) reconcile(ctx context.Context, client ctrlrunt
 	ownerRefWrapper := k8creconciling.OwnerRefWrapper(*metav1.NewControllerRef(s, deployv1alpha1.SchemeGroupVersion.WithKind("CompiledShard")))
 	revisionLabels := modifier.RelatedRevisionsLabels(ctx, client)
 
+	if etcdSpec := s.Spec.Shard.Etcd.Managed; etcdSpec != nil {
+		if err := k8creconciling.ReconcileServices(ctx, []k8creconciling.NamedServiceReconcilerFactory{
+			etcd.ServiceReconciler(s.Name),
+		}, s.Namespace, client, ownerRefWrapper); err != nil {
+			errs = append(errs, err)
+		}
+
+		if err := k8creconciling.ReconcileStatefulSets(ctx, []k8creconciling.NamedStatefulSetReconcilerFactory{
+			etcd.StatefulSetReconciler(s.Name, etcdSpec, resources.GetManagedEtcdDataDir(s.Annotations), resources.IsEtcdRestoreInProgress(s.Annotations)),
+		}, s.Namespace, client, ownerRefWrapper, revisionLabels); err != nil {
+			// Like for the Deployment, rely on the Secret watch to retry once the etcd certificates exist.
+			if !errors.Is(err, modifier.ErrMountNotFound) {
+				errs = append(errs, err)
+			}
+		}
+	}
+
 	if err := k8creconciling.ReconcileDeployments(ctx, []k8creconciling.NamedDeploymentReconcilerFactory{
 		compiledshard.DeploymentReconciler(s),
-	}, s.Namespace, client, ownerRefWrapper, revisionLabels); err != nil {
+	}, s.Namespace, client, ownerRefWrapper, revisionLabels, modifier.CopyPublishedAt(s)); err != nil {
 		// Swallow these errors and instead rely on us watching Secrets and re-reconciling whenever they change.
 		if !errors.Is(err, modifier.ErrMountNotFound) {
 			errs = append(errs, err)
@@ -140,12 +187,20 @@ func (r *CompiledShardReconciler) reconcile(ctx context.Context, client ctrlrunt
 		errs = append(errs, err)
 	}
 
+	replicas := ptr.Deref(s.Spec.Shard.Replicas, 2)
+	if err := utils.ReconcilePodDisruptionBudget(ctx, client, s.Namespace, replicas, compiledshard.PodDisruptionBudgetReconciler(s), ownerRefWrapper); err != nil {
+		errs = append(errs, err)
+	}
+
 	return conditions, kerrors.NewAggregate(errs)
 }
 
-func (r *CompiledShardReconciler) reconcileStatus(ctx context.Context, client ctrlruntimeclient.Client, oldShard *deployv1alpha1.CompiledShard, conditions []metav1.Condition) error {
+func (r *CompiledShardReconciler) reconcileStatus(ctx context.Context, client ctrlruntimeclient.Client, oldShard *deployv1alpha1.CompiledShard, conditions []metav1.Condition) (time.Duration, error) {
 	newShard := oldShard.DeepCopy()
-	var errs []error
+	var (
+		errs         []error
+		requeueAfter time.Duration
+	)
 
 	depKey := types.NamespacedName{Namespace: newShard.Namespace, Name: resources.GetCompiledShardDeploymentName(newShard)}
 	cond, err := util.GetDeploymentAvailableCondition(ctx, client, depKey)
@@ -155,10 +210,52 @@ func (r *CompiledShardReconciler) reconcileStatus(ctx context.Context, client ct
 		conditions = append(conditions, cond)
 	}
 
+	if err := util.RecordDeploymentRollout(ctx, client, metrics.ShardResourceType, newShard, depKey); err != nil {
+		errs = append(errs, err)
+	}
+
+	rootShard := &operatorv1alpha1.RootShard{
+		ObjectMeta: metav1.ObjectMeta{Name: newShard.Spec.RootShard.Name, Namespace: newShard.Namespace},
+		Spec:       newShard.Spec.RootShard.Spec,
+	}
+	shard := &operatorv1alpha1.Shard{
+		ObjectMeta: metav1.ObjectMeta{Name: newShard.Name, Namespace: newShard.Namespace},
+		Spec:       newShard.Spec.Shard,
+	}
+
+	newShard.Status.Version, requeueAfter, err = util.GetVersionStatus(ctx, client, depKey, compiledshard.ServerContainerName, newShard.Status.Version, func(ctx context.Context) (*version.Info, error) {
+		return operatorclient.GetVersion(ctx, client, r.Address.Shard(shard), rootShard)
+	})
+	if err != nil {
+		errs = append(errs, err)
+	}
+
+	cond, err = util.GetHealthyCondition(ctx, client, depKey, func(ctx context.Context) ([]string, error) {
+		return operatorclient.GetFailedHealthChecks(ctx, client, r.Address.Shard(shard), rootShard)
+	})
+	if err != nil {
+		errs = append(errs, err)
+	} else {
+		conditions = append(conditions, cond)
+	}
+
+	if newShard.Spec.Shard.Etcd.Managed != nil {
+		etcdKey := types.NamespacedName{Namespace: newShard.Namespace, Name: resources.GetManagedEtcdName(newShard.Name)}
+		cond, err := util.GetEtcdHealthyCondition(ctx, client, etcdKey)
+		if err != nil {
+			errs = append(errs, err)
+		} else {
+			conditions = append(conditions, cond)
+		}
+	} else {
+		apimeta.RemoveStatusCondition(&newShard.Status.Conditions, string(operatorv1alpha1.ConditionTypeEtcdHealthy))
+	}
+
 	for _, condition := range conditions {
 		condition.ObservedGeneration = newShard.Generation
 		newShard.Status.Conditions = util.UpdateCondition(newShard.Status.Conditions, condition)
 	}
+	newShard.Status.Conditions = util.UpdatePausedCondition(newShard, newShard.Status.Conditions)
 
 	availableCond := apimeta.FindStatusCondition(newShard.Status.Conditions, string(operatorv1alpha1.ConditionTypeAvailable))
 
@@ -180,5 +277,5 @@ func (r *CompiledShardReconciler) reconcileStatus(ctx context.Context, client ct
 		}
 	}
 
-	return kerrors.NewAggregate(errs)
+	return requeueAfter, kerrors.NewAggregate(errs)
 }
@@ -22,12 +22,17 @@ import (
 
 	"github.com/stretchr/testify/require"
 
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	apimeta "k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
 	ctrlruntimeclient "sigs.k8s.io/controller-runtime/pkg/client"
 	ctrlruntimefakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 	mcreconcile "sigs.k8s.io/multicluster-runtime/pkg/reconcile"
 
+	"github.com/kcp-dev/kcp-operator/internal/resources"
 	"github.com/kcp-dev/kcp-operator/pkg/controller/util"
 	deployv1alpha1 "github.com/kcp-dev/kcp-operator/sdk/apis/deploy/v1alpha1"
 	operatorv1alpha1 "github.com/kcp-dev/kcp-operator/sdk/apis/operator/v1alpha1"
@@ -100,3 +105,185 @@ func TestReconciling(t *testing.T) {
 		})
 	}
 }
+
+func TestManagedEtcd(t *testing.T) {
+	const namespace = "shard-tests"
+
+	shard := &deployv1alpha1.CompiledShard{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "shardy",
+			Namespace: namespace,
+		},
+		Spec: deployv1alpha1.CompiledShardSpec{
+			Shard: operatorv1alpha1.ShardSpec{
+				CommonShardSpec: operatorv1alpha1.CommonShardSpec{
+					Etcd: operatorv1alpha1.EtcdConfig{
+						Managed: &operatorv1alpha1.ManagedEtcdSpec{},
+					},
+				},
+			},
+			RootShard: deployv1alpha1.NamedRootShardSpec{
+				Name: "rooty",
+				Spec: operatorv1alpha1.RootShardSpec{
+					External: operatorv1alpha1.ExternalConfig{
+						Hostname: "example.kcp.io",
+						Port:     6443,
+					},
+				},
+			},
+		},
+	}
+
+	serverCert := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "shardy-etcd-server",
+			Namespace: namespace,
+		},
+	}
+
+	client := ctrlruntimefakeclient.
+		NewClientBuilder().
+		WithScheme(util.GetTestScheme()).
+		WithStatusSubresource(shard).
+		WithObjects(shard, serverCert).
+		Build()
+
+	ctx := context.Background()
+
+	controllerReconciler := &CompiledShardReconciler{
+		GetCluster: util.FakeSingleCluster(client),
+	}
+
+	_, err := controllerReconciler.Reconcile(ctx, mcreconcile.Request{
+		Request: reconcile.Request{
+			NamespacedName: ctrlruntimeclient.ObjectKeyFromObject(shard),
+		},
+	})
+	require.NoError(t, err)
+
+	sts := &appsv1.StatefulSet{}
+	require.NoError(t, client.Get(ctx, types.NamespacedName{Namespace: namespace, Name: "shardy-etcd"}, sts))
+
+	svc := &corev1.Service{}
+	require.NoError(t, client.Get(ctx, types.NamespacedName{Namespace: namespace, Name: "shardy-etcd"}, svc))
+	require.Equal(t, corev1.ClusterIPNone, svc.Spec.ClusterIP)
+
+	require.NoError(t, client.Get(ctx, ctrlruntimeclient.ObjectKeyFromObject(shard), shard))
+
+	cond := apimeta.FindStatusCondition(shard.Status.Conditions, string(operatorv1alpha1.ConditionTypeEtcdHealthy))
+	require.NotNil(t, cond)
+	require.Equal(t, metav1.ConditionFalse, cond.Status)
+	require.Equal(t, string(operatorv1alpha1.ConditionReasonEtcdMembersUnavailable), cond.Reason)
+}
+
+func TestPaused(t *testing.T) {
+	const namespace = "shard-tests"
+
+	shard := &deployv1alpha1.CompiledShard{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        "shardy",
+			Namespace:   namespace,
+			Annotations: map[string]string{operatorv1alpha1.PausedAnnotation: "true"},
+		},
+		Spec: deployv1alpha1.CompiledShardSpec{
+			Shard: operatorv1alpha1.ShardSpec{
+				CommonShardSpec: operatorv1alpha1.CommonShardSpec{
+					Etcd: operatorv1alpha1.EtcdConfig{
+						Endpoints: []string{"https://localhost:2379"},
+					},
+				},
+			},
+			RootShard: deployv1alpha1.NamedRootShardSpec{
+				Name: "rooty",
+				Spec: operatorv1alpha1.RootShardSpec{
+					External: operatorv1alpha1.ExternalConfig{
+						Hostname: "example.kcp.io",
+						Port:     6443,
+					},
+				},
+			},
+		},
+	}
+
+	client := ctrlruntimefakeclient.
+		NewClientBuilder().
+		WithScheme(util.GetTestScheme()).
+		WithStatusSubresource(shard).
+		WithObjects(shard).
+		Build()
+
+	ctx := context.Background()
+
+	controllerReconciler := &CompiledShardReconciler{
+		GetCluster: util.FakeSingleCluster(client),
+	}
+
+	_, err := controllerReconciler.Reconcile(ctx, mcreconcile.Request{
+		Request: reconcile.Request{
+			NamespacedName: ctrlruntimeclient.ObjectKeyFromObject(shard),
+		},
+	})
+	require.NoError(t, err)
+
+	deployments := &appsv1.DeploymentList{}
+	require.NoError(t, client.List(ctx, deployments))
+	require.Empty(t, deployments.Items, "no Deployment should be rendered for a paused shard")
+
+	require.NoError(t, client.Get(ctx, ctrlruntimeclient.ObjectKeyFromObject(shard), shard))
+
+	cond := apimeta.FindStatusCondition(shard.Status.Conditions, string(operatorv1alpha1.ConditionTypePaused))
+	require.NotNil(t, cond)
+	require.Equal(t, metav1.ConditionTrue, cond.Status)
+}
+
+func TestPlacedIntoAnotherCluster(t *testing.T) {
+	const namespace = "shard-tests"
+
+	shard := &deployv1alpha1.CompiledShard{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "shardy",
+			Namespace: namespace,
+			Labels:    map[string]string{resources.PlacementLabel: "eu-1"},
+		},
+		Spec: deployv1alpha1.CompiledShardSpec{
+			Shard: operatorv1alpha1.ShardSpec{
+				CommonShardSpec: operatorv1alpha1.CommonShardSpec{
+					Etcd: operatorv1alpha1.EtcdConfig{
+						Endpoints: []string{"https://localhost:2379"},
+					},
+				},
+			},
+			RootShard: deployv1alpha1.NamedRootShardSpec{
+				Name: "rooty",
+			},
+		},
+	}
+
+	client := ctrlruntimefakeclient.
+		NewClientBuilder().
+		WithScheme(util.GetTestScheme()).
+		WithStatusSubresource(shard).
+		WithObjects(shard).
+		Build()
+
+	ctx := context.Background()
+
+	controllerReconciler := &CompiledShardReconciler{
+		GetCluster: util.FakeSingleCluster(client),
+	}
+
+	_, err := controllerReconciler.Reconcile(ctx, mcreconcile.Request{
+		Request: reconcile.Request{
+			NamespacedName: ctrlruntimeclient.ObjectKeyFromObject(shard),
+		},
+	})
+	require.NoError(t, err)
+
+	deployments := &appsv1.DeploymentList{}
+	require.NoError(t, client.List(ctx, deployments))
+	require.Empty(t, deployments.Items, "no Deployment should be rendered for a shard placed into another cluster")
+
+	// The status is owned by the syncer for the target cluster.
+	require.NoError(t, client.Get(ctx, ctrlruntimeclient.ObjectKeyFromObject(shard), shard))
+	require.Empty(t, shard.Status.Conditions)
+}
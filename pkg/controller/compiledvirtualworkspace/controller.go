@@ -26,11 +26,14 @@ import (
 
 	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
+	policyv1 "k8s.io/api/policy/v1"
 	"k8s.io/apimachinery/pkg/api/equality"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
 	kerrors "k8s.io/apimachinery/pkg/util/errors"
+	"k8s.io/apimachinery/pkg/version"
+	"k8s.io/utils/ptr"
 	ctrl "sigs.k8s.io/controller-runtime"
 	ctrlruntimeclient "sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/cluster"
@@ -42,15 +45,20 @@ import (
 
 	"github.com/kcp-dev/kcp-operator/internal/resources"
 	"github.com/kcp-dev/kcp-operator/internal/resources/compiledvirtualworkspace"
+	"github.com/kcp-dev/kcp-operator/internal/resources/utils"
+	operatorclient "github.com/kcp-dev/kcp-operator/pkg/client"
 	"github.com/kcp-dev/kcp-operator/pkg/controller/util"
 	"github.com/kcp-dev/kcp-operator/pkg/metrics"
 	"github.com/kcp-dev/kcp-operator/pkg/reconciling/modifier"
+	"github.com/kcp-dev/kcp-operator/pkg/tracing"
 	deployv1alpha1 "github.com/kcp-dev/kcp-operator/sdk/apis/deploy/v1alpha1"
+	operatorv1alpha1 "github.com/kcp-dev/kcp-operator/sdk/apis/operator/v1alpha1"
 )
 
 // CompiledVirtualWorkspaceReconciler reconciles a CompiledVirtualWorkspace object
 type CompiledVirtualWorkspaceReconciler struct {
 	GetCluster func(ctx context.Context, clusterName multicluster.ClusterName) (cluster.Cluster, error)
+	Address    operatorclient.Addresser
 }
 
 // SetupWithManager sets up the controller with the Manager.
@@ -66,8 +74,9 @@ func (r *CompiledVirtualWorkspaceReconciler) SetupWithManager(mgr mcmanager.Mana
 		For(&deployv1alpha1.CompiledVirtualWorkspace{}, util.EngageFor(opts)...).
 		Owns(&corev1.Service{}, util.EngageOwns(opts)...).
 		Owns(&appsv1.Deployment{}, util.EngageOwns(opts)...).
+		Owns(&policyv1.PodDisruptionBudget{}, util.EngageOwns(opts)...).
 		Watches(&corev1.Secret{}, mountHandler, util.EngageWatches(opts)...).
-		Complete(r)
+		Complete(tracing.Reconciler("compiled-virtualworkspace", r))
 }
 
 // +kubebuilder:rbac:groups=deploy.operator.kcp.io,resources=compiledvirtualworkspaces,verbs=get;list;watch
@@ -76,6 +85,7 @@ func (r *CompiledVirtualWorkspaceReconciler) SetupWithManager(mgr mcmanager.Mana
 // +kubebuilder:rbac:groups=apps,resources=deployments,verbs=get;list;watch;create;update;patch
 // +kubebuilder:rbac:groups=core,resources=services,verbs=get;list;watch;create;update;patch
 // +kubebuilder:rbac:groups=core,resources=configmaps;secrets,verbs=get;list;watch
+// +kubebuilder:rbac:groups=policy,resources=poddisruptionbudgets,verbs=get;list;watch;create;update;patch;delete
 
 // Reconcile is part of the main kubernetes reconciliation loop which aims to
 // move the current state of the cluster closer to the desired state.
@@ -108,15 +118,33 @@ func (r *CompiledVirtualWorkspaceReconciler) Reconcile(ctx context.Context, req
 		return ctrl.Result{}, nil
 	}
 
+	// Objects placed into another cluster are deployed by the workload controllers in that
+	// cluster, their status is copied back by the syncer.
+	if cluster := resources.GetPlacementCluster(vw); cluster != "" {
+		logger.V(4).Info("Skipping reconciliation because the object is placed into another cluster", "placement", cluster)
+		return ctrl.Result{}, nil
+	}
+
 	vwCopy := vw.DeepCopy()
 
-	conditions, recErr := r.reconcile(ctx, cl.GetClient(), vwCopy)
+	// Link the workload side to the config reconcile that published this revision.
+	tracing.LinkCompiled(ctx, vw)
+
+	var conditions []metav1.Condition
+	if util.IsPaused(vw) {
+		logger.V(4).Info("Skipping reconciliation because the object is paused")
+	} else {
+		renderCtx, span := tracing.Start(ctx, "RenderWorkloads")
+		conditions, recErr = r.reconcile(renderCtx, cl.GetClient(), vwCopy)
+		tracing.End(span, recErr)
+	}
 
-	if err := r.reconcileStatus(ctx, cl.GetClient(), vw, vwCopy, conditions); err != nil {
+	requeueAfter, err := r.reconcileStatus(ctx, cl.GetClient(), vw, vwCopy, conditions)
+	if err != nil {
 		recErr = kerrors.NewAggregate([]error{recErr, err})
 	}
 
-	return ctrl.Result{}, recErr
+	return ctrl.Result{RequeueAfter: requeueAfter}, recErr
 }
 
 //nolint:unparam // Keep the controller working the same as all the others, even though currently it does always return nil conditions.
@@ -131,7 +159,7 @@ func (r *CompiledVirtualWorkspaceReconciler) reconcile(ctx context.Context, clie
 
 	if err := k8creconciling.ReconcileDeployments(ctx, []k8creconciling.NamedDeploymentReconcilerFactory{
 		compiledvirtualworkspace.DeploymentReconciler(vw),
-	}, vw.Namespace, client, ownerRefWrapper, revisionLabels); err != nil {
+	}, vw.Namespace, client, ownerRefWrapper, revisionLabels, modifier.CopyPublishedAt(vw)); err != nil {
 		// Swallow these errors and instead rely on us watching Secrets and re-reconciling whenever they change.
 		if !errors.Is(err, modifier.ErrMountNotFound) {
 			errs = append(errs, err)
@@ -144,28 +172,55 @@ func (r *CompiledVirtualWorkspaceReconciler) reconcile(ctx context.Context, clie
 		errs = append(errs, err)
 	}
 
+	replicas := ptr.Deref(vw.Spec.VirtualWorkspace.Replicas, 2)
+	if err := utils.ReconcilePodDisruptionBudget(ctx, client, vw.Namespace, replicas, compiledvirtualworkspace.PodDisruptionBudgetReconciler(vw), ownerRefWrapper); err != nil {
+		errs = append(errs, err)
+	}
+
 	return conditions, kerrors.NewAggregate(errs)
 }
 
-func (r *CompiledVirtualWorkspaceReconciler) reconcileStatus(ctx context.Context, client ctrlruntimeclient.Client, oldVW *deployv1alpha1.CompiledVirtualWorkspace, vw *deployv1alpha1.CompiledVirtualWorkspace, conditions []metav1.Condition) error {
+func (r *CompiledVirtualWorkspaceReconciler) reconcileStatus(ctx context.Context, client ctrlruntimeclient.Client, oldVW *deployv1alpha1.CompiledVirtualWorkspace, vw *deployv1alpha1.CompiledVirtualWorkspace, conditions []metav1.Condition) (time.Duration, error) {
 	// Check deployment status
 	depKey := types.NamespacedName{Namespace: vw.Namespace, Name: resources.GetCompiledVirtualWorkspaceDeploymentName(vw)}
 	cond, err := util.GetDeploymentAvailableCondition(ctx, client, depKey)
 	if err != nil {
-		return err
+		return 0, err
 	}
 	conditions = append(conditions, cond)
 
+	if err := util.RecordDeploymentRollout(ctx, client, metrics.VirtualWorkspaceResourceType, vw, depKey); err != nil {
+		return 0, err
+	}
+
+	rootShard := &operatorv1alpha1.RootShard{
+		ObjectMeta: metav1.ObjectMeta{Name: vw.Spec.RootShard.Name, Namespace: vw.Namespace},
+		Spec:       vw.Spec.RootShard.Spec,
+	}
+	sourceVW := &operatorv1alpha1.VirtualWorkspace{
+		ObjectMeta: metav1.ObjectMeta{Name: vw.Name, Namespace: vw.Namespace},
+		Spec:       vw.Spec.VirtualWorkspace,
+	}
+
+	var requeueAfter time.Duration
+	vw.Status.Version, requeueAfter, err = util.GetVersionStatus(ctx, client, depKey, compiledvirtualworkspace.ServerContainerName, vw.Status.Version, func(ctx context.Context) (*version.Info, error) {
+		return operatorclient.GetVersion(ctx, client, r.Address.VirtualWorkspace(sourceVW), rootShard)
+	})
+	if err != nil {
+		return 0, err
+	}
+
 	for _, condition := range conditions {
 		condition.ObservedGeneration = vw.Generation
 		vw.Status.Conditions = util.UpdateCondition(vw.Status.Conditions, condition)
 	}
+	vw.Status.Conditions = util.UpdatePausedCondition(vw, vw.Status.Conditions)
 
 	if !equality.Semantic.DeepEqual(oldVW.Status, vw.Status) {
 		if err := client.Status().Patch(ctx, vw, ctrlruntimeclient.MergeFrom(oldVW)); err != nil {
-			return err
+			return 0, err
 		}
 	}
 
-	return nil
+	return requeueAfter, nil
 }
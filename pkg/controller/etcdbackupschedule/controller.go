@@ -0,0 +1,298 @@
+/*
+Copyright 2026 The kcp Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package etcdbackupschedule
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/robfig/cron/v3"
+
+	"k8s.io/apimachinery/pkg/api/equality"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	kerrors "k8s.io/apimachinery/pkg/util/errors"
+	"k8s.io/utils/ptr"
+	ctrl "sigs.k8s.io/controller-runtime"
+	ctrlruntimeclient "sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/cluster"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+	mcbuilder "sigs.k8s.io/multicluster-runtime/pkg/builder"
+	mcmanager "sigs.k8s.io/multicluster-runtime/pkg/manager"
+	"sigs.k8s.io/multicluster-runtime/pkg/multicluster"
+	mcreconcile "sigs.k8s.io/multicluster-runtime/pkg/reconcile"
+
+	"github.com/kcp-dev/kcp-operator/internal/resources"
+	"github.com/kcp-dev/kcp-operator/pkg/controller/util"
+	"github.com/kcp-dev/kcp-operator/pkg/metrics"
+	"github.com/kcp-dev/kcp-operator/pkg/tracing"
+	operatorv1alpha1 "github.com/kcp-dev/kcp-operator/sdk/apis/operator/v1alpha1"
+)
+
+// EtcdBackupScheduleReconciler reconciles an EtcdBackupSchedule object
+type EtcdBackupScheduleReconciler struct {
+	GetCluster func(ctx context.Context, clusterName multicluster.ClusterName) (cluster.Cluster, error)
+
+	// Now returns the current time; it defaults to time.Now and exists for testing.
+	Now func() time.Time
+}
+
+// SetupWithManager sets up the controller with the Manager.
+func (r *EtcdBackupScheduleReconciler) SetupWithManager(mgr mcmanager.Manager, opts ...mcbuilder.EngageOptions) error {
+	return mcbuilder.ControllerManagedBy(mgr).
+		Named("etcdbackupschedule").
+		For(&operatorv1alpha1.EtcdBackupSchedule{}, util.EngageFor(opts)...).
+		Owns(&operatorv1alpha1.EtcdSnapshot{}, util.EngageOwns(opts)...).
+		Complete(tracing.Reconciler("etcdbackupschedule", r))
+}
+
+// +kubebuilder:rbac:groups=operator.kcp.io,resources=etcdbackupschedules,verbs=get;list;watch;update;patch
+// +kubebuilder:rbac:groups=operator.kcp.io,resources=etcdbackupschedules/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=operator.kcp.io,resources=etcdbackupschedules/finalizers,verbs=update
+// +kubebuilder:rbac:groups=operator.kcp.io,resources=etcdsnapshots,verbs=get;list;watch;create;delete
+
+// Reconcile is part of the main kubernetes reconciliation loop which aims to
+// move the current state of the cluster closer to the desired state.
+func (r *EtcdBackupScheduleReconciler) Reconcile(ctx context.Context, req mcreconcile.Request) (res ctrl.Result, recErr error) {
+	startTime := time.Now()
+	defer func() {
+		duration := time.Since(startTime)
+		metrics.RecordReconciliationMetrics(metrics.EtcdBackupScheduleResourceType, duration.Seconds(), recErr)
+	}()
+
+	logger := log.FromContext(ctx).WithValues("cluster", req.ClusterName)
+	logger.V(4).Info("Reconciling EtcdBackupSchedule object")
+
+	cl, err := r.GetCluster(ctx, req.ClusterName)
+	if err != nil {
+		return ctrl.Result{}, fmt.Errorf("failed to get cluster %q: %w", req.ClusterName, err)
+	}
+
+	var schedule operatorv1alpha1.EtcdBackupSchedule
+	if err := cl.GetClient().Get(ctx, req.NamespacedName, &schedule); err != nil {
+		if ctrlruntimeclient.IgnoreNotFound(err) != nil {
+			metrics.RecordReconciliationError(metrics.EtcdBackupScheduleResourceType, err.Error())
+			return ctrl.Result{}, fmt.Errorf("failed to get backup schedule: %w", err)
+		}
+
+		return ctrl.Result{}, nil
+	}
+
+	if schedule.DeletionTimestamp != nil {
+		return ctrl.Result{}, nil
+	}
+
+	newSchedule := schedule.DeepCopy()
+	requeueAfter, conditions, recErr := r.reconcile(ctx, cl.GetClient(), newSchedule)
+
+	for _, condition := range conditions {
+		condition.ObservedGeneration = newSchedule.Generation
+		newSchedule.Status.Conditions = util.UpdateCondition(newSchedule.Status.Conditions, condition)
+	}
+
+	// only patch the status if there are actual changes.
+	if !equality.Semantic.DeepEqual(schedule.Status, newSchedule.Status) {
+		if err := cl.GetClient().Status().Patch(ctx, newSchedule, ctrlruntimeclient.MergeFrom(&schedule)); err != nil {
+			recErr = kerrors.NewAggregate([]error{recErr, err})
+		}
+	}
+
+	return ctrl.Result{RequeueAfter: requeueAfter}, recErr
+}
+
+func (r *EtcdBackupScheduleReconciler) now() time.Time {
+	if r.Now != nil {
+		return r.Now()
+	}
+
+	return time.Now()
+}
+
+func (r *EtcdBackupScheduleReconciler) reconcile(ctx context.Context, client ctrlruntimeclient.Client, schedule *operatorv1alpha1.EtcdBackupSchedule) (time.Duration, []metav1.Condition, error) {
+	var conditions []metav1.Condition
+
+	snapshots, err := r.getSnapshots(ctx, client, schedule)
+	if err != nil {
+		return 0, conditions, err
+	}
+
+	updateLastSuccessful(schedule, snapshots)
+
+	if err := r.pruneSnapshots(ctx, client, schedule, snapshots); err != nil {
+		return 0, conditions, fmt.Errorf("failed to prune snapshots: %w", err)
+	}
+
+	sched, err := cron.ParseStandard(schedule.Spec.Schedule)
+	if err != nil {
+		// There is no point in retrying until the schedule is fixed.
+		return 0, append(conditions, metav1.Condition{
+			Type:    string(operatorv1alpha1.ConditionTypeReady),
+			Status:  metav1.ConditionFalse,
+			Reason:  string(operatorv1alpha1.ConditionReasonInvalidSchedule),
+			Message: fmt.Sprintf("Failed to parse schedule: %v.", err),
+		}), nil
+	}
+
+	conditions = append(conditions, metav1.Condition{
+		Type:    string(operatorv1alpha1.ConditionTypeReady),
+		Status:  metav1.ConditionTrue,
+		Reason:  string(operatorv1alpha1.ConditionReasonScheduled),
+		Message: "Snapshots are taken according to the schedule.",
+	})
+
+	if schedule.Spec.Suspend {
+		return 0, conditions, nil
+	}
+
+	now := r.now()
+
+	// Only the most recent missed run is caught up on, like a CronJob would.
+	last := schedule.CreationTimestamp.Time
+	if schedule.Status.LastScheduleTime != nil {
+		last = schedule.Status.LastScheduleTime.Time
+	}
+
+	if scheduled := mostRecentRun(sched, last, now); !scheduled.IsZero() {
+		if err := r.createSnapshot(ctx, client, schedule, scheduled); err != nil {
+			return 0, conditions, err
+		}
+
+		schedule.Status.LastScheduleTime = &metav1.Time{Time: scheduled}
+	}
+
+	return sched.Next(now).Sub(now), conditions, nil
+}
+
+// mostRecentRun returns the latest time after last and not after now at which the schedule
+// fires, or the zero time if there is none. Instead of stepping through every missed run
+// since last, which can take very long after a long downtime with a frequent schedule, it
+// looks back from now in windows of doubling length until one of them contains a run.
+func mostRecentRun(sched cron.Schedule, last, now time.Time) time.Time {
+	for window := time.Minute; ; window *= 2 {
+		start := now.Add(-window)
+		if !start.After(last) {
+			start = last
+		}
+
+		var scheduled time.Time
+		for next := sched.Next(start); !next.After(now); next = sched.Next(next) {
+			scheduled = next
+		}
+
+		if !scheduled.IsZero() || start.Equal(last) {
+			return scheduled
+		}
+	}
+}
+
+func (r *EtcdBackupScheduleReconciler) createSnapshot(ctx context.Context, client ctrlruntimeclient.Client, schedule *operatorv1alpha1.EtcdBackupSchedule, scheduled time.Time) error {
+	snapshot := &operatorv1alpha1.EtcdSnapshot{
+		ObjectMeta: metav1.ObjectMeta{
+			// Naming the snapshot after its scheduled time makes creating it idempotent.
+			Name:      fmt.Sprintf("%s-%d", schedule.Name, scheduled.Unix()/60),
+			Namespace: schedule.Namespace,
+			Labels: map[string]string{
+				resources.EtcdBackupScheduleLabel: schedule.Name,
+			},
+			OwnerReferences: []metav1.OwnerReference{
+				*metav1.NewControllerRef(schedule, operatorv1alpha1.SchemeGroupVersion.WithKind("EtcdBackupSchedule")),
+			},
+		},
+		Spec: operatorv1alpha1.EtcdSnapshotSpec{
+			Target:  schedule.Spec.Target,
+			Storage: schedule.Spec.Storage,
+			Image:   schedule.Spec.Image,
+		},
+	}
+
+	if err := client.Create(ctx, snapshot); err != nil && !apierrors.IsAlreadyExists(err) {
+		return fmt.Errorf("failed to create EtcdSnapshot: %w", err)
+	}
+
+	return nil
+}
+
+// getSnapshots returns the snapshots created for the schedule, oldest first.
+func (r *EtcdBackupScheduleReconciler) getSnapshots(ctx context.Context, client ctrlruntimeclient.Client, schedule *operatorv1alpha1.EtcdBackupSchedule) ([]operatorv1alpha1.EtcdSnapshot, error) {
+	var snapshots operatorv1alpha1.EtcdSnapshotList
+	if err := client.List(ctx, &snapshots, ctrlruntimeclient.InNamespace(schedule.Namespace), ctrlruntimeclient.MatchingLabels{
+		resources.EtcdBackupScheduleLabel: schedule.Name,
+	}); err != nil {
+		return nil, fmt.Errorf("failed to list EtcdSnapshots: %w", err)
+	}
+
+	items := snapshots.Items
+	slices.SortFunc(items, func(a, b operatorv1alpha1.EtcdSnapshot) int {
+		if c := a.CreationTimestamp.Compare(b.CreationTimestamp.Time); c != 0 {
+			return c
+		}
+		return cmp.Compare(a.Name, b.Name)
+	})
+
+	return items, nil
+}
+
+func updateLastSuccessful(schedule *operatorv1alpha1.EtcdBackupSchedule, snapshots []operatorv1alpha1.EtcdSnapshot) {
+	for i := len(snapshots) - 1; i >= 0; i-- {
+		snapshot := snapshots[i]
+		if snapshot.Status.Phase == operatorv1alpha1.EtcdSnapshotPhaseCompleted {
+			schedule.Status.LastSuccessfulSnapshot = snapshot.Name
+			schedule.Status.LastSuccessfulTime = snapshot.Status.CompletionTime
+			return
+		}
+	}
+}
+
+// pruneSnapshots deletes the oldest finished snapshots beyond the configured history limits.
+func (r *EtcdBackupScheduleReconciler) pruneSnapshots(ctx context.Context, client ctrlruntimeclient.Client, schedule *operatorv1alpha1.EtcdBackupSchedule, snapshots []operatorv1alpha1.EtcdSnapshot) error {
+	var completed, failed []operatorv1alpha1.EtcdSnapshot
+
+	for _, snapshot := range snapshots {
+		switch snapshot.Status.Phase {
+		case operatorv1alpha1.EtcdSnapshotPhaseCompleted:
+			completed = append(completed, snapshot)
+		case operatorv1alpha1.EtcdSnapshotPhaseFailed:
+			failed = append(failed, snapshot)
+		}
+	}
+
+	var errs []error
+	for _, prunable := range [][]operatorv1alpha1.EtcdSnapshot{
+		excess(completed, ptr.Deref(schedule.Spec.SuccessfulSnapshotsHistoryLimit, 7)),
+		excess(failed, ptr.Deref(schedule.Spec.FailedSnapshotsHistoryLimit, 1)),
+	} {
+		for _, snapshot := range prunable {
+			if err := client.Delete(ctx, &snapshot); ctrlruntimeclient.IgnoreNotFound(err) != nil {
+				errs = append(errs, err)
+			}
+		}
+	}
+
+	return kerrors.NewAggregate(errs)
+}
+
+// excess returns the oldest snapshots of the list that exceed the limit.
+func excess(snapshots []operatorv1alpha1.EtcdSnapshot, limit int32) []operatorv1alpha1.EtcdSnapshot {
+	if int32(len(snapshots)) <= limit {
+		return nil
+	}
+
+	return snapshots[:int32(len(snapshots))-limit]
+}
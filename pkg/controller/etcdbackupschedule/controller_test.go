@@ -0,0 +1,208 @@
+/*
+Copyright 2026 The kcp Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package etcdbackupschedule
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/robfig/cron/v3"
+	"github.com/stretchr/testify/require"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+	ctrlruntimeclient "sigs.k8s.io/controller-runtime/pkg/client"
+	ctrlruntimefakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+	mcreconcile "sigs.k8s.io/multicluster-runtime/pkg/reconcile"
+
+	"github.com/kcp-dev/kcp-operator/internal/resources"
+	"github.com/kcp-dev/kcp-operator/pkg/controller/util"
+	operatorv1alpha1 "github.com/kcp-dev/kcp-operator/sdk/apis/operator/v1alpha1"
+)
+
+const namespace = "etcdbackupschedule-tests"
+
+var created = time.Date(2026, 1, 1, 10, 0, 0, 0, time.UTC)
+
+func newSchedule() *operatorv1alpha1.EtcdBackupSchedule {
+	return &operatorv1alpha1.EtcdBackupSchedule{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:              "nightly",
+			Namespace:         namespace,
+			CreationTimestamp: metav1.NewTime(created),
+		},
+		Spec: operatorv1alpha1.EtcdBackupScheduleSpec{
+			Schedule: "0 * * * *",
+			Target: operatorv1alpha1.EtcdSnapshotTarget{
+				RootShardRef: &corev1.LocalObjectReference{Name: "rooty"},
+			},
+			Storage: operatorv1alpha1.EtcdSnapshotStorage{
+				PersistentVolumeClaim: &operatorv1alpha1.PersistentVolumeClaimSnapshotStorage{ClaimName: "backups"},
+			},
+		},
+	}
+}
+
+func reconcileSchedule(t *testing.T, client ctrlruntimeclient.Client, now time.Time) reconcile.Result {
+	t.Helper()
+
+	r := &EtcdBackupScheduleReconciler{
+		GetCluster: util.FakeSingleCluster(client),
+		Now:        func() time.Time { return now },
+	}
+
+	res, err := r.Reconcile(context.Background(), mcreconcile.Request{
+		Request: reconcile.Request{NamespacedName: ctrlruntimeclient.ObjectKey{Namespace: namespace, Name: "nightly"}},
+	})
+	require.NoError(t, err)
+
+	return res
+}
+
+func listSnapshots(t *testing.T, client ctrlruntimeclient.Client) []operatorv1alpha1.EtcdSnapshot {
+	t.Helper()
+
+	var snapshots operatorv1alpha1.EtcdSnapshotList
+	require.NoError(t, client.List(context.Background(), &snapshots, ctrlruntimeclient.InNamespace(namespace)))
+
+	return snapshots.Items
+}
+
+func TestScheduling(t *testing.T) {
+	schedule := newSchedule()
+
+	client := ctrlruntimefakeclient.
+		NewClientBuilder().
+		WithScheme(util.GetTestScheme()).
+		WithStatusSubresource(schedule).
+		WithObjects(schedule).
+		Build()
+
+	// nothing is due yet
+	res := reconcileSchedule(t, client, created.Add(15*time.Minute))
+	require.Empty(t, listSnapshots(t, client))
+	require.Equal(t, 45*time.Minute, res.RequeueAfter)
+
+	// several runs have been missed, only the most recent one is caught up on
+	reconcileSchedule(t, client, created.Add(3*time.Hour+time.Minute))
+
+	snapshots := listSnapshots(t, client)
+	require.Len(t, snapshots, 1)
+	require.Equal(t, fmt.Sprintf("nightly-%d", created.Add(3*time.Hour).Unix()/60), snapshots[0].Name)
+	require.Equal(t, "nightly", snapshots[0].Labels[resources.EtcdBackupScheduleLabel])
+	require.Equal(t, "rooty", snapshots[0].Spec.Target.RootShardRef.Name)
+
+	current := &operatorv1alpha1.EtcdBackupSchedule{}
+	require.NoError(t, client.Get(context.Background(), ctrlruntimeclient.ObjectKeyFromObject(schedule), current))
+	require.True(t, current.Status.LastScheduleTime.Time.Equal(created.Add(3*time.Hour)))
+
+	// reconciling again within the same period does not create another snapshot
+	reconcileSchedule(t, client, created.Add(3*time.Hour+2*time.Minute))
+	require.Len(t, listSnapshots(t, client), 1)
+}
+
+func TestMostRecentRun(t *testing.T) {
+	sched, err := cron.ParseStandard("* * * * *")
+	require.NoError(t, err)
+
+	now := time.Date(2030, 1, 1, 12, 0, 30, 0, time.UTC)
+
+	// years of missed runs with a schedule firing every minute are collapsed into the latest one
+	start := time.Now()
+	require.Equal(t, time.Date(2030, 1, 1, 12, 0, 0, 0, time.UTC), mostRecentRun(sched, now.AddDate(-10, 0, 0), now))
+	require.Less(t, time.Since(start), time.Second)
+
+	// no run is due yet
+	require.True(t, mostRecentRun(sched, time.Date(2030, 1, 1, 12, 0, 0, 0, time.UTC), now).IsZero())
+
+	sched, err = cron.ParseStandard("0 0 1 1 *")
+	require.NoError(t, err)
+
+	// a rare schedule still finds a run that lies far in the past
+	require.Equal(t, time.Date(2030, 1, 1, 0, 0, 0, 0, time.UTC), mostRecentRun(sched, now.AddDate(-3, 0, 0), now))
+	require.True(t, mostRecentRun(sched, time.Date(2030, 1, 1, 0, 0, 0, 0, time.UTC), now).IsZero())
+}
+
+func TestSuspended(t *testing.T) {
+	schedule := newSchedule()
+	schedule.Spec.Suspend = true
+
+	client := ctrlruntimefakeclient.
+		NewClientBuilder().
+		WithScheme(util.GetTestScheme()).
+		WithStatusSubresource(schedule).
+		WithObjects(schedule).
+		Build()
+
+	reconcileSchedule(t, client, created.Add(3*time.Hour))
+	require.Empty(t, listSnapshots(t, client))
+}
+
+func TestPruning(t *testing.T) {
+	schedule := newSchedule()
+	schedule.Spec.SuccessfulSnapshotsHistoryLimit = ptr.To[int32](2)
+	schedule.Spec.FailedSnapshotsHistoryLimit = ptr.To[int32](0)
+	// do not schedule anything new in this test
+	schedule.Spec.Suspend = true
+
+	objects := []ctrlruntimeclient.Object{schedule}
+	for i, phase := range []operatorv1alpha1.EtcdSnapshotPhase{
+		operatorv1alpha1.EtcdSnapshotPhaseCompleted,
+		operatorv1alpha1.EtcdSnapshotPhaseCompleted,
+		operatorv1alpha1.EtcdSnapshotPhaseFailed,
+		operatorv1alpha1.EtcdSnapshotPhaseCompleted,
+		operatorv1alpha1.EtcdSnapshotPhaseRunning,
+	} {
+		objects = append(objects, &operatorv1alpha1.EtcdSnapshot{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:              fmt.Sprintf("nightly-%d", i),
+				Namespace:         namespace,
+				CreationTimestamp: metav1.NewTime(created.Add(time.Duration(i) * time.Hour)),
+				Labels: map[string]string{
+					resources.EtcdBackupScheduleLabel: "nightly",
+				},
+			},
+			Status: operatorv1alpha1.EtcdSnapshotStatus{
+				Phase:          phase,
+				CompletionTime: ptr.To(metav1.NewTime(created.Add(time.Duration(i) * time.Hour))),
+			},
+		})
+	}
+
+	client := ctrlruntimefakeclient.
+		NewClientBuilder().
+		WithScheme(util.GetTestScheme()).
+		WithStatusSubresource(schedule).
+		WithObjects(objects...).
+		Build()
+
+	reconcileSchedule(t, client, created.Add(5*time.Hour))
+
+	var names []string
+	for _, snapshot := range listSnapshots(t, client) {
+		names = append(names, snapshot.Name)
+	}
+	require.ElementsMatch(t, []string{"nightly-1", "nightly-3", "nightly-4"}, names)
+
+	current := &operatorv1alpha1.EtcdBackupSchedule{}
+	require.NoError(t, client.Get(context.Background(), ctrlruntimeclient.ObjectKeyFromObject(schedule), current))
+	require.Equal(t, "nightly-3", current.Status.LastSuccessfulSnapshot)
+}
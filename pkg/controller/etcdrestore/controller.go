@@ -0,0 +1,465 @@
+/*
+Copyright 2026 The kcp Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package etcdrestore
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"time"
+
+	appsv1 "k8s.io/api/apps/v1"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	kerrors "k8s.io/apimachinery/pkg/util/errors"
+	"k8s.io/utils/ptr"
+	ctrl "sigs.k8s.io/controller-runtime"
+	ctrlruntimeclient "sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/cluster"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+	mcbuilder "sigs.k8s.io/multicluster-runtime/pkg/builder"
+	mcmanager "sigs.k8s.io/multicluster-runtime/pkg/manager"
+	"sigs.k8s.io/multicluster-runtime/pkg/multicluster"
+	mcreconcile "sigs.k8s.io/multicluster-runtime/pkg/reconcile"
+
+	"github.com/kcp-dev/kcp-operator/internal/resources"
+	"github.com/kcp-dev/kcp-operator/internal/resources/etcdbackup"
+	"github.com/kcp-dev/kcp-operator/pkg/controller/util"
+	"github.com/kcp-dev/kcp-operator/pkg/metrics"
+	"github.com/kcp-dev/kcp-operator/pkg/tracing"
+	operatorv1alpha1 "github.com/kcp-dev/kcp-operator/sdk/apis/operator/v1alpha1"
+)
+
+// cleanupFinalizer makes sure that a (Root)Shard is not left scaled down if an EtcdRestore
+// is deleted while it is in progress.
+const cleanupFinalizer = "operator.kcp.io/cleanup-etcd-restore"
+
+// EtcdRestoreReconciler reconciles an EtcdRestore object
+type EtcdRestoreReconciler struct {
+	GetCluster func(ctx context.Context, clusterName multicluster.ClusterName) (cluster.Cluster, error)
+}
+
+// SetupWithManager sets up the controller with the Manager.
+func (r *EtcdRestoreReconciler) SetupWithManager(mgr mcmanager.Manager, opts ...mcbuilder.EngageOptions) error {
+	return mcbuilder.ControllerManagedBy(mgr).
+		Named("etcdrestore").
+		For(&operatorv1alpha1.EtcdRestore{}, util.EngageFor(opts)...).
+		Owns(&batchv1.Job{}, util.EngageOwns(opts)...).
+		Complete(tracing.Reconciler("etcdrestore", r))
+}
+
+// +kubebuilder:rbac:groups=operator.kcp.io,resources=etcdrestores,verbs=get;list;watch;update;patch
+// +kubebuilder:rbac:groups=operator.kcp.io,resources=etcdrestores/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=operator.kcp.io,resources=etcdrestores/finalizers,verbs=update
+// +kubebuilder:rbac:groups=operator.kcp.io,resources=etcdsnapshots,verbs=get;list;watch
+// +kubebuilder:rbac:groups=operator.kcp.io,resources=rootshards;shards,verbs=get;list;watch;update;patch
+// +kubebuilder:rbac:groups=apps,resources=deployments;statefulsets,verbs=get;list;watch
+// +kubebuilder:rbac:groups=core,resources=pods,verbs=get;list;watch
+// +kubebuilder:rbac:groups=batch,resources=jobs,verbs=get;list;watch;create;update;patch;delete
+
+// Reconcile is part of the main kubernetes reconciliation loop which aims to
+// move the current state of the cluster closer to the desired state.
+func (r *EtcdRestoreReconciler) Reconcile(ctx context.Context, req mcreconcile.Request) (res ctrl.Result, recErr error) {
+	startTime := time.Now()
+	defer func() {
+		duration := time.Since(startTime)
+		metrics.RecordReconciliationMetrics(metrics.EtcdRestoreResourceType, duration.Seconds(), recErr)
+	}()
+
+	logger := log.FromContext(ctx).WithValues("cluster", req.ClusterName)
+	logger.V(4).Info("Reconciling EtcdRestore object")
+
+	cl, err := r.GetCluster(ctx, req.ClusterName)
+	if err != nil {
+		return ctrl.Result{}, fmt.Errorf("failed to get cluster %q: %w", req.ClusterName, err)
+	}
+	client := cl.GetClient()
+
+	var restore operatorv1alpha1.EtcdRestore
+	if err := client.Get(ctx, req.NamespacedName, &restore); err != nil {
+		if ctrlruntimeclient.IgnoreNotFound(err) != nil {
+			metrics.RecordReconciliationError(metrics.EtcdRestoreResourceType, err.Error())
+			return ctrl.Result{}, fmt.Errorf("failed to get restore: %w", err)
+		}
+
+		return ctrl.Result{}, nil
+	}
+
+	// Once finished (or deleted), make sure the target is not left scaled down.
+	if restore.DeletionTimestamp != nil || isFinished(&restore) {
+		if err := r.releaseTarget(ctx, client, &restore); err != nil {
+			return ctrl.Result{}, err
+		}
+
+		return ctrl.Result{}, r.removeFinalizer(ctx, client, &restore)
+	}
+
+	if updated, err := r.ensureFinalizer(ctx, client, &restore); err != nil {
+		return ctrl.Result{}, fmt.Errorf("failed to ensure cleanup finalizer: %w", err)
+	} else if updated {
+		return ctrl.Result{}, nil
+	}
+
+	newRestore := restore.DeepCopy()
+	requeueAfter, conditions, recErr := r.reconcile(ctx, client, newRestore)
+
+	for _, condition := range conditions {
+		condition.ObservedGeneration = newRestore.Generation
+		newRestore.Status.Conditions = util.UpdateCondition(newRestore.Status.Conditions, condition)
+	}
+
+	if isFinished(newRestore) && newRestore.Status.CompletionTime == nil {
+		now := metav1.Now()
+		newRestore.Status.CompletionTime = &now
+	}
+
+	// only patch the status if there are actual changes.
+	if !equality.Semantic.DeepEqual(restore.Status, newRestore.Status) {
+		if err := client.Status().Patch(ctx, newRestore, ctrlruntimeclient.MergeFrom(&restore)); err != nil {
+			recErr = kerrors.NewAggregate([]error{recErr, err})
+		}
+	}
+
+	return ctrl.Result{RequeueAfter: requeueAfter}, recErr
+}
+
+func isFinished(restore *operatorv1alpha1.EtcdRestore) bool {
+	return restore.Status.Phase == operatorv1alpha1.EtcdRestorePhaseCompleted || restore.Status.Phase == operatorv1alpha1.EtcdRestorePhaseFailed
+}
+
+func (r *EtcdRestoreReconciler) reconcile(ctx context.Context, client ctrlruntimeclient.Client, restore *operatorv1alpha1.EtcdRestore) (time.Duration, []metav1.Condition, error) {
+	var conditions []metav1.Condition
+
+	if restore.Status.Phase == "" {
+		restore.Status.Phase = operatorv1alpha1.EtcdRestorePhasePending
+	}
+
+	snapshot := &operatorv1alpha1.EtcdSnapshot{}
+	if err := client.Get(ctx, types.NamespacedName{Namespace: restore.Namespace, Name: restore.Spec.SnapshotRef.Name}, snapshot); err != nil {
+		return 0, append(conditions, metav1.Condition{
+			Type:    string(operatorv1alpha1.ConditionTypeReferenceValid),
+			Status:  metav1.ConditionFalse,
+			Reason:  string(operatorv1alpha1.ConditionReasonReferenceNotFound),
+			Message: fmt.Sprintf("Failed to retrieve EtcdSnapshot: %v.", err),
+		}), err
+	}
+
+	targetCond, target, etcdConfig := util.FetchEtcdSnapshotTarget(ctx, client, restore.Namespace, snapshot.Spec.Target)
+	conditions = append(conditions, targetCond)
+
+	if target == nil {
+		return 0, conditions, fmt.Errorf("failed to resolve target: %s", targetCond.Message)
+	}
+
+	switch snapshot.Status.Phase {
+	case operatorv1alpha1.EtcdSnapshotPhaseCompleted:
+		// good to go
+
+	case operatorv1alpha1.EtcdSnapshotPhaseFailed:
+		return 0, append(conditions, fail(restore, operatorv1alpha1.ConditionReasonJobFailed, "EtcdSnapshot %s has failed.", snapshot.Name)), nil
+
+	default:
+		return 10 * time.Second, append(conditions, waiting("Waiting for EtcdSnapshot %s to complete.", snapshot.Name)), nil
+	}
+
+	if etcdConfig.Managed == nil {
+		return 0, append(conditions, fail(restore, operatorv1alpha1.ConditionReasonUnsupported, "Only operator-managed etcd clusters can be restored.")), nil
+	}
+
+	switch restore.Status.Phase {
+	case operatorv1alpha1.EtcdRestorePhasePending:
+		return r.scaleDown(ctx, client, restore, target, conditions)
+
+	case operatorv1alpha1.EtcdRestorePhaseScalingDown:
+		return r.waitForShutdown(ctx, client, restore, target, conditions)
+
+	case operatorv1alpha1.EtcdRestorePhaseRestoring:
+		return r.restore(ctx, client, restore, snapshot, target, etcdConfig.Managed, conditions)
+
+	case operatorv1alpha1.EtcdRestorePhaseScalingUp:
+		return r.waitForStartup(ctx, client, restore, target, conditions)
+	}
+
+	return 0, conditions, nil
+}
+
+// scaleDown marks the target as being restored, which makes the workload controllers scale the
+// kcp Deployment and the etcd StatefulSet down to zero.
+func (r *EtcdRestoreReconciler) scaleDown(ctx context.Context, client ctrlruntimeclient.Client, restore *operatorv1alpha1.EtcdRestore, target ctrlruntimeclient.Object, conditions []metav1.Condition) (time.Duration, []metav1.Condition, error) {
+	if holder := target.GetAnnotations()[operatorv1alpha1.EtcdRestoreAnnotation]; holder != "" && holder != restore.Name {
+		return 30 * time.Second, append(conditions, waiting("EtcdRestore %s is already in progress for %s.", holder, target.GetName())), nil
+	}
+
+	err := patchAnnotations(ctx, client, target, func(annotations map[string]string) {
+		annotations[operatorv1alpha1.EtcdRestoreAnnotation] = restore.Name
+	})
+	if err != nil {
+		return 0, conditions, fmt.Errorf("failed to mark target as being restored: %w", err)
+	}
+
+	now := metav1.Now()
+	restore.Status.StartTime = &now
+	restore.Status.Phase = operatorv1alpha1.EtcdRestorePhaseScalingDown
+
+	return 5 * time.Second, append(conditions, waiting("Waiting for kcp and etcd to shut down.")), nil
+}
+
+func (r *EtcdRestoreReconciler) waitForShutdown(ctx context.Context, client ctrlruntimeclient.Client, restore *operatorv1alpha1.EtcdRestore, target ctrlruntimeclient.Object, conditions []metav1.Condition) (time.Duration, []metav1.Condition, error) {
+	stopped, err := isStopped(ctx, client, target)
+	if err != nil {
+		return 0, conditions, err
+	}
+
+	if !stopped {
+		return 5 * time.Second, append(conditions, waiting("Waiting for kcp and etcd to shut down.")), nil
+	}
+
+	restore.Status.Phase = operatorv1alpha1.EtcdRestorePhaseRestoring
+
+	return 0, append(conditions, waiting("Restoring etcd members.")), nil
+}
+
+// isStopped returns true once neither kcp nor etcd Pods are running anymore, so that the etcd
+// data volumes can be mounted by the restore Jobs.
+func isStopped(ctx context.Context, client ctrlruntimeclient.Client, target ctrlruntimeclient.Object) (bool, error) {
+	deploymentName := getDeploymentName(target)
+
+	var dep appsv1.Deployment
+	if err := client.Get(ctx, types.NamespacedName{Namespace: target.GetNamespace(), Name: deploymentName}, &dep); ctrlruntimeclient.IgnoreNotFound(err) != nil {
+		return false, err
+	}
+
+	if dep.Name != "" && (ptr.Deref(dep.Spec.Replicas, 1) != 0 || dep.Status.Replicas != 0) {
+		return false, nil
+	}
+
+	var sts appsv1.StatefulSet
+	if err := client.Get(ctx, types.NamespacedName{Namespace: target.GetNamespace(), Name: resources.GetManagedEtcdName(target.GetName())}, &sts); err != nil {
+		return false, err
+	}
+
+	if ptr.Deref(sts.Spec.Replicas, 1) != 0 {
+		return false, nil
+	}
+
+	var pods corev1.PodList
+	if err := client.List(ctx, &pods, ctrlruntimeclient.InNamespace(target.GetNamespace()), ctrlruntimeclient.MatchingLabels(resources.GetManagedEtcdResourceLabels(target.GetName()))); err != nil {
+		return false, err
+	}
+
+	return len(pods.Items) == 0, nil
+}
+
+// restore runs one Job per etcd member. The Jobs run one after another, so that a snapshot
+// stored on a ReadWriteOnce volume can be mounted by each of them.
+func (r *EtcdRestoreReconciler) restore(ctx context.Context, client ctrlruntimeclient.Client, restore *operatorv1alpha1.EtcdRestore, snapshot *operatorv1alpha1.EtcdSnapshot, target ctrlruntimeclient.Object, spec *operatorv1alpha1.ManagedEtcdSpec, conditions []metav1.Condition) (time.Duration, []metav1.Condition, error) {
+	for member := range resources.GetManagedEtcdReplicas(spec) {
+		var job batchv1.Job
+		err := client.Get(ctx, types.NamespacedName{Namespace: restore.Namespace, Name: resources.GetEtcdRestoreJobName(restore, member)}, &job)
+		if ctrlruntimeclient.IgnoreNotFound(err) != nil {
+			return 0, conditions, fmt.Errorf("failed to get restore Job: %w", err)
+		}
+
+		if err != nil {
+			newJob, err := etcdbackup.RestoreJob(restore, snapshot, target.GetName(), spec, member)
+			if err != nil {
+				return 0, append(conditions, fail(restore, operatorv1alpha1.ConditionReasonJobFailed, "Failed to render restore Job: %v.", err)), nil
+			}
+
+			newJob.OwnerReferences = []metav1.OwnerReference{*metav1.NewControllerRef(restore, operatorv1alpha1.SchemeGroupVersion.WithKind("EtcdRestore"))}
+
+			if err := client.Create(ctx, newJob); err != nil {
+				return 0, conditions, fmt.Errorf("failed to create restore Job: %w", err)
+			}
+
+			return 0, append(conditions, metav1.Condition{
+				Type:    string(operatorv1alpha1.ConditionTypeReady),
+				Status:  metav1.ConditionFalse,
+				Reason:  string(operatorv1alpha1.ConditionReasonJobRunning),
+				Message: fmt.Sprintf("Restoring etcd member %d.", member),
+			}), nil
+		}
+
+		switch {
+		case hasJobCondition(&job, batchv1.JobFailed):
+			// The target is released once the restore has finished and keeps using its previous data directory.
+			return 0, append(conditions, fail(restore, operatorv1alpha1.ConditionReasonJobFailed, "Restoring etcd member %d failed, see Job %s.", member, job.Name)), nil
+
+		case !hasJobCondition(&job, batchv1.JobComplete):
+			return 0, append(conditions, metav1.Condition{
+				Type:    string(operatorv1alpha1.ConditionTypeReady),
+				Status:  metav1.ConditionFalse,
+				Reason:  string(operatorv1alpha1.ConditionReasonJobRunning),
+				Message: fmt.Sprintf("Restoring etcd member %d.", member),
+			}), nil
+		}
+	}
+
+	// Switch etcd over to the restored data and start everything again.
+	err := patchAnnotations(ctx, client, target, func(annotations map[string]string) {
+		annotations[operatorv1alpha1.EtcdDataDirAnnotation] = restore.Name
+		delete(annotations, operatorv1alpha1.EtcdRestoreAnnotation)
+	})
+	if err != nil {
+		return 0, conditions, fmt.Errorf("failed to switch target to restored data: %w", err)
+	}
+
+	restore.Status.Phase = operatorv1alpha1.EtcdRestorePhaseScalingUp
+
+	return 10 * time.Second, append(conditions, waiting("Waiting for kcp and etcd to start up.")), nil
+}
+
+func (r *EtcdRestoreReconciler) waitForStartup(ctx context.Context, client ctrlruntimeclient.Client, restore *operatorv1alpha1.EtcdRestore, target ctrlruntimeclient.Object, conditions []metav1.Condition) (time.Duration, []metav1.Condition, error) {
+	deploymentName := getDeploymentName(target)
+
+	etcdCond, err := util.GetEtcdHealthyCondition(ctx, client, types.NamespacedName{Namespace: target.GetNamespace(), Name: resources.GetManagedEtcdName(target.GetName())})
+	if err != nil {
+		return 0, conditions, err
+	}
+
+	availableCond, err := util.GetDeploymentAvailableCondition(ctx, client, types.NamespacedName{Namespace: target.GetNamespace(), Name: deploymentName})
+	if err != nil {
+		return 0, conditions, err
+	}
+
+	for _, cond := range []metav1.Condition{etcdCond, availableCond} {
+		if cond.Status != metav1.ConditionTrue {
+			return 10 * time.Second, append(conditions, waiting("Waiting for kcp and etcd to start up: %s", cond.Message)), nil
+		}
+	}
+
+	restore.Status.Phase = operatorv1alpha1.EtcdRestorePhaseCompleted
+
+	return 0, append(conditions, metav1.Condition{
+		Type:    string(operatorv1alpha1.ConditionTypeReady),
+		Status:  metav1.ConditionTrue,
+		Reason:  string(operatorv1alpha1.ConditionReasonJobCompleted),
+		Message: "The snapshot has been restored.",
+	}), nil
+}
+
+// releaseTarget removes the restore annotation from the target, if this restore still holds it.
+func (r *EtcdRestoreReconciler) releaseTarget(ctx context.Context, client ctrlruntimeclient.Client, restore *operatorv1alpha1.EtcdRestore) error {
+	if !slices.Contains(restore.Finalizers, cleanupFinalizer) {
+		return nil
+	}
+
+	snapshot := &operatorv1alpha1.EtcdSnapshot{}
+	if err := client.Get(ctx, types.NamespacedName{Namespace: restore.Namespace, Name: restore.Spec.SnapshotRef.Name}, snapshot); err != nil {
+		// without the snapshot, the target cannot be known
+		return ctrlruntimeclient.IgnoreNotFound(err)
+	}
+
+	_, target, _ := util.FetchEtcdSnapshotTarget(ctx, client, restore.Namespace, snapshot.Spec.Target)
+	if target == nil || target.GetAnnotations()[operatorv1alpha1.EtcdRestoreAnnotation] != restore.Name {
+		return nil
+	}
+
+	err := patchAnnotations(ctx, client, target, func(annotations map[string]string) {
+		delete(annotations, operatorv1alpha1.EtcdRestoreAnnotation)
+	})
+	if err != nil {
+		return fmt.Errorf("failed to release target: %w", err)
+	}
+
+	return nil
+}
+
+func (r *EtcdRestoreReconciler) ensureFinalizer(ctx context.Context, client ctrlruntimeclient.Client, restore *operatorv1alpha1.EtcdRestore) (updated bool, err error) {
+	if slices.Contains(restore.Finalizers, cleanupFinalizer) {
+		return false, nil
+	}
+
+	original := restore.DeepCopy()
+	restore.Finalizers = append(restore.Finalizers, cleanupFinalizer)
+
+	if err := client.Patch(ctx, restore, ctrlruntimeclient.MergeFrom(original)); err != nil {
+		return false, err
+	}
+
+	return true, nil
+}
+
+func (r *EtcdRestoreReconciler) removeFinalizer(ctx context.Context, client ctrlruntimeclient.Client, restore *operatorv1alpha1.EtcdRestore) error {
+	if !slices.Contains(restore.Finalizers, cleanupFinalizer) {
+		return nil
+	}
+
+	original := restore.DeepCopy()
+	restore.Finalizers = slices.DeleteFunc(restore.Finalizers, func(f string) bool {
+		return f == cleanupFinalizer
+	})
+
+	return client.Patch(ctx, restore, ctrlruntimeclient.MergeFrom(original))
+}
+
+func patchAnnotations(ctx context.Context, client ctrlruntimeclient.Client, obj ctrlruntimeclient.Object, mutate func(map[string]string)) error {
+	original := obj.DeepCopyObject().(ctrlruntimeclient.Object)
+
+	annotations := obj.GetAnnotations()
+	if annotations == nil {
+		annotations = map[string]string{}
+	}
+	mutate(annotations)
+	obj.SetAnnotations(annotations)
+
+	return client.Patch(ctx, obj, ctrlruntimeclient.MergeFrom(original))
+}
+
+func getDeploymentName(target ctrlruntimeclient.Object) string {
+	switch t := target.(type) {
+	case *operatorv1alpha1.RootShard:
+		return resources.GetRootShardDeploymentName(t)
+	case *operatorv1alpha1.Shard:
+		return resources.GetShardDeploymentName(t)
+	default:
+		return ""
+	}
+}
+
+func hasJobCondition(job *batchv1.Job, condType batchv1.JobConditionType) bool {
+	for _, cond := range job.Status.Conditions {
+		if cond.Type == condType && cond.Status == corev1.ConditionTrue {
+			return true
+		}
+	}
+
+	return false
+}
+
+func waiting(format string, args ...any) metav1.Condition {
+	return metav1.Condition{
+		Type:    string(operatorv1alpha1.ConditionTypeReady),
+		Status:  metav1.ConditionFalse,
+		Reason:  string(operatorv1alpha1.ConditionReasonWaiting),
+		Message: fmt.Sprintf(format, args...),
+	}
+}
+
+func fail(restore *operatorv1alpha1.EtcdRestore, reason operatorv1alpha1.ConditionReason, format string, args ...any) metav1.Condition {
+	restore.Status.Phase = operatorv1alpha1.EtcdRestorePhaseFailed
+
+	return metav1.Condition{
+		Type:    string(operatorv1alpha1.ConditionTypeReady),
+		Status:  metav1.ConditionFalse,
+		Reason:  string(reason),
+		Message: fmt.Sprintf(format, args...),
+	}
+}
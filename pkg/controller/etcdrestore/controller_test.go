@@ -0,0 +1,235 @@
+/*
+Copyright 2026 The kcp Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package etcdrestore
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	appsv1 "k8s.io/api/apps/v1"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/ptr"
+	ctrlruntimeclient "sigs.k8s.io/controller-runtime/pkg/client"
+	ctrlruntimefakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+	mcreconcile "sigs.k8s.io/multicluster-runtime/pkg/reconcile"
+
+	"github.com/kcp-dev/kcp-operator/pkg/controller/util"
+	operatorv1alpha1 "github.com/kcp-dev/kcp-operator/sdk/apis/operator/v1alpha1"
+)
+
+const namespace = "etcdrestore-tests"
+
+func newObjects(etcd operatorv1alpha1.EtcdConfig) (*operatorv1alpha1.Shard, *operatorv1alpha1.EtcdSnapshot, *operatorv1alpha1.EtcdRestore) {
+	shard := &operatorv1alpha1.Shard{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "shardy",
+			Namespace: namespace,
+		},
+		Spec: operatorv1alpha1.ShardSpec{
+			CommonShardSpec: operatorv1alpha1.CommonShardSpec{
+				Etcd: etcd,
+			},
+		},
+	}
+
+	snapshot := &operatorv1alpha1.EtcdSnapshot{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "snappy",
+			Namespace: namespace,
+		},
+		Spec: operatorv1alpha1.EtcdSnapshotSpec{
+			Target: operatorv1alpha1.EtcdSnapshotTarget{
+				ShardRef: &corev1.LocalObjectReference{Name: "shardy"},
+			},
+			Storage: operatorv1alpha1.EtcdSnapshotStorage{
+				S3: &operatorv1alpha1.S3SnapshotStorage{
+					Endpoint:             "http://minio:9000",
+					Bucket:               "backups",
+					CredentialsSecretRef: corev1.LocalObjectReference{Name: "minio"},
+				},
+			},
+		},
+		Status: operatorv1alpha1.EtcdSnapshotStatus{
+			Phase: operatorv1alpha1.EtcdSnapshotPhaseCompleted,
+		},
+	}
+
+	restore := &operatorv1alpha1.EtcdRestore{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "resty",
+			Namespace: namespace,
+		},
+		Spec: operatorv1alpha1.EtcdRestoreSpec{
+			SnapshotRef: corev1.LocalObjectReference{Name: "snappy"},
+		},
+	}
+
+	return shard, snapshot, restore
+}
+
+func TestRestoring(t *testing.T) {
+	shard, snapshot, restore := newObjects(operatorv1alpha1.EtcdConfig{
+		Managed: &operatorv1alpha1.ManagedEtcdSpec{Replicas: ptr.To[int32](3)},
+	})
+
+	deployment := &appsv1.Deployment{
+		ObjectMeta: metav1.ObjectMeta{Name: "shardy-shard-kcp", Namespace: namespace},
+		Spec:       appsv1.DeploymentSpec{Replicas: ptr.To[int32](2)},
+		Status:     appsv1.DeploymentStatus{Replicas: 2},
+	}
+
+	statefulSet := &appsv1.StatefulSet{
+		ObjectMeta: metav1.ObjectMeta{Name: "shardy-etcd", Namespace: namespace},
+		Spec:       appsv1.StatefulSetSpec{Replicas: ptr.To[int32](3)},
+	}
+
+	client := ctrlruntimefakeclient.
+		NewClientBuilder().
+		WithScheme(util.GetTestScheme()).
+		WithStatusSubresource(snapshot, restore, deployment, statefulSet).
+		WithObjects(shard, snapshot, restore, deployment, statefulSet).
+		Build()
+
+	ctx := context.Background()
+
+	controllerReconciler := &EtcdRestoreReconciler{
+		GetCluster: util.FakeSingleCluster(client),
+	}
+
+	reconcileRestore := func() *operatorv1alpha1.EtcdRestore {
+		t.Helper()
+
+		_, err := controllerReconciler.Reconcile(ctx, mcreconcile.Request{
+			Request: reconcile.Request{NamespacedName: ctrlruntimeclient.ObjectKeyFromObject(restore)},
+		})
+		require.NoError(t, err)
+
+		current := &operatorv1alpha1.EtcdRestore{}
+		require.NoError(t, client.Get(ctx, ctrlruntimeclient.ObjectKeyFromObject(restore), current))
+
+		return current
+	}
+
+	getShard := func() *operatorv1alpha1.Shard {
+		t.Helper()
+
+		current := &operatorv1alpha1.Shard{}
+		require.NoError(t, client.Get(ctx, ctrlruntimeclient.ObjectKeyFromObject(shard), current))
+
+		return current
+	}
+
+	// first reconcile only adds the finalizer
+	current := reconcileRestore()
+	require.Contains(t, current.Finalizers, cleanupFinalizer)
+
+	current = reconcileRestore()
+	require.Equal(t, operatorv1alpha1.EtcdRestorePhaseScalingDown, current.Status.Phase)
+	require.Equal(t, "resty", getShard().Annotations[operatorv1alpha1.EtcdRestoreAnnotation])
+
+	// kcp is still running
+	current = reconcileRestore()
+	require.Equal(t, operatorv1alpha1.EtcdRestorePhaseScalingDown, current.Status.Phase)
+
+	// simulate the workload controllers scaling everything down
+	deployment.Spec.Replicas = ptr.To[int32](0)
+	require.NoError(t, client.Update(ctx, deployment))
+	deployment.Status.Replicas = 0
+	require.NoError(t, client.Status().Update(ctx, deployment))
+	statefulSet.Spec.Replicas = ptr.To[int32](0)
+	require.NoError(t, client.Update(ctx, statefulSet))
+
+	current = reconcileRestore()
+	require.Equal(t, operatorv1alpha1.EtcdRestorePhaseRestoring, current.Status.Phase)
+
+	// members are restored one after another
+	for member, name := range []string{"resty-etcd-restore-0", "resty-etcd-restore-1", "resty-etcd-restore-2"} {
+		current = reconcileRestore()
+		require.Equal(t, operatorv1alpha1.EtcdRestorePhaseRestoring, current.Status.Phase)
+
+		job := &batchv1.Job{}
+		require.NoError(t, client.Get(ctx, types.NamespacedName{Namespace: namespace, Name: name}, job), "member %d", member)
+
+		job.Status.Conditions = []batchv1.JobCondition{{Type: batchv1.JobComplete, Status: corev1.ConditionTrue}}
+		require.NoError(t, client.Status().Update(ctx, job))
+	}
+
+	current = reconcileRestore()
+	require.Equal(t, operatorv1alpha1.EtcdRestorePhaseScalingUp, current.Status.Phase)
+
+	annotations := getShard().Annotations
+	require.NotContains(t, annotations, operatorv1alpha1.EtcdRestoreAnnotation)
+	require.Equal(t, "resty", annotations[operatorv1alpha1.EtcdDataDirAnnotation])
+
+	// simulate everything coming back up
+	deployment.Spec.Replicas = ptr.To[int32](2)
+	require.NoError(t, client.Update(ctx, deployment))
+	deployment.Status = appsv1.DeploymentStatus{Replicas: 2, UpdatedReplicas: 2, ReadyReplicas: 2, AvailableReplicas: 2}
+	require.NoError(t, client.Status().Update(ctx, deployment))
+	statefulSet.Spec.Replicas = ptr.To[int32](3)
+	require.NoError(t, client.Update(ctx, statefulSet))
+	require.NoError(t, client.Get(ctx, ctrlruntimeclient.ObjectKeyFromObject(statefulSet), statefulSet))
+	statefulSet.Status = appsv1.StatefulSetStatus{ObservedGeneration: statefulSet.Generation, Replicas: 3, ReadyReplicas: 3, UpdatedReplicas: 3}
+	require.NoError(t, client.Status().Update(ctx, statefulSet))
+
+	current = reconcileRestore()
+	require.Equal(t, operatorv1alpha1.EtcdRestorePhaseCompleted, current.Status.Phase)
+	require.NotNil(t, current.Status.CompletionTime)
+
+	// the finalizer is removed once the restore is done
+	current = reconcileRestore()
+	require.NotContains(t, current.Finalizers, cleanupFinalizer)
+}
+
+func TestRestoringExternalEtcdIsUnsupported(t *testing.T) {
+	shard, snapshot, restore := newObjects(operatorv1alpha1.EtcdConfig{
+		Endpoints: []string{"https://etcd:2379"},
+	})
+
+	client := ctrlruntimefakeclient.
+		NewClientBuilder().
+		WithScheme(util.GetTestScheme()).
+		WithStatusSubresource(snapshot, restore).
+		WithObjects(shard, snapshot, restore).
+		Build()
+
+	ctx := context.Background()
+	controllerReconciler := &EtcdRestoreReconciler{
+		GetCluster: util.FakeSingleCluster(client),
+	}
+
+	for range 2 {
+		_, err := controllerReconciler.Reconcile(ctx, mcreconcile.Request{
+			Request: reconcile.Request{NamespacedName: ctrlruntimeclient.ObjectKeyFromObject(restore)},
+		})
+		require.NoError(t, err)
+	}
+
+	current := &operatorv1alpha1.EtcdRestore{}
+	require.NoError(t, client.Get(ctx, ctrlruntimeclient.ObjectKeyFromObject(restore), current))
+	require.Equal(t, operatorv1alpha1.EtcdRestorePhaseFailed, current.Status.Phase)
+
+	currentShard := &operatorv1alpha1.Shard{}
+	require.NoError(t, client.Get(ctx, ctrlruntimeclient.ObjectKeyFromObject(shard), currentShard))
+	require.NotContains(t, currentShard.Annotations, operatorv1alpha1.EtcdRestoreAnnotation)
+}
@@ -0,0 +1,248 @@
+/*
+Copyright 2026 The kcp Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package etcdsnapshot
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	apimeta "k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	kerrors "k8s.io/apimachinery/pkg/util/errors"
+	ctrl "sigs.k8s.io/controller-runtime"
+	ctrlruntimeclient "sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/cluster"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+	mcbuilder "sigs.k8s.io/multicluster-runtime/pkg/builder"
+	mcmanager "sigs.k8s.io/multicluster-runtime/pkg/manager"
+	"sigs.k8s.io/multicluster-runtime/pkg/multicluster"
+	mcreconcile "sigs.k8s.io/multicluster-runtime/pkg/reconcile"
+
+	"github.com/kcp-dev/kcp-operator/internal/resources"
+	"github.com/kcp-dev/kcp-operator/internal/resources/etcdbackup"
+	"github.com/kcp-dev/kcp-operator/pkg/controller/util"
+	"github.com/kcp-dev/kcp-operator/pkg/metrics"
+	"github.com/kcp-dev/kcp-operator/pkg/tracing"
+	operatorv1alpha1 "github.com/kcp-dev/kcp-operator/sdk/apis/operator/v1alpha1"
+)
+
+// EtcdSnapshotReconciler reconciles an EtcdSnapshot object
+type EtcdSnapshotReconciler struct {
+	GetCluster func(ctx context.Context, clusterName multicluster.ClusterName) (cluster.Cluster, error)
+}
+
+// SetupWithManager sets up the controller with the Manager.
+func (r *EtcdSnapshotReconciler) SetupWithManager(mgr mcmanager.Manager, opts ...mcbuilder.EngageOptions) error {
+	return mcbuilder.ControllerManagedBy(mgr).
+		Named("etcdsnapshot").
+		For(&operatorv1alpha1.EtcdSnapshot{}, util.EngageFor(opts)...).
+		Owns(&batchv1.Job{}, util.EngageOwns(opts)...).
+		Complete(tracing.Reconciler("etcdsnapshot", r))
+}
+
+// +kubebuilder:rbac:groups=operator.kcp.io,resources=etcdsnapshots,verbs=get;list;watch;update;patch
+// +kubebuilder:rbac:groups=operator.kcp.io,resources=etcdsnapshots/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=operator.kcp.io,resources=etcdsnapshots/finalizers,verbs=update
+// +kubebuilder:rbac:groups=operator.kcp.io,resources=rootshards;shards,verbs=get;list;watch
+// +kubebuilder:rbac:groups=batch,resources=jobs,verbs=get;list;watch;create;update;patch;delete
+
+// Reconcile is part of the main kubernetes reconciliation loop which aims to
+// move the current state of the cluster closer to the desired state.
+func (r *EtcdSnapshotReconciler) Reconcile(ctx context.Context, req mcreconcile.Request) (res ctrl.Result, recErr error) {
+	startTime := time.Now()
+	defer func() {
+		duration := time.Since(startTime)
+		metrics.RecordReconciliationMetrics(metrics.EtcdSnapshotResourceType, duration.Seconds(), recErr)
+	}()
+
+	logger := log.FromContext(ctx).WithValues("cluster", req.ClusterName)
+	logger.V(4).Info("Reconciling EtcdSnapshot object")
+
+	cl, err := r.GetCluster(ctx, req.ClusterName)
+	if err != nil {
+		return ctrl.Result{}, fmt.Errorf("failed to get cluster %q: %w", req.ClusterName, err)
+	}
+
+	var snapshot operatorv1alpha1.EtcdSnapshot
+	if err := cl.GetClient().Get(ctx, req.NamespacedName, &snapshot); err != nil {
+		if ctrlruntimeclient.IgnoreNotFound(err) != nil {
+			metrics.RecordReconciliationError(metrics.EtcdSnapshotResourceType, err.Error())
+			return ctrl.Result{}, fmt.Errorf("failed to get snapshot: %w", err)
+		}
+
+		return ctrl.Result{}, nil
+	}
+
+	// Snapshots are taken exactly once.
+	if snapshot.DeletionTimestamp != nil || isFinished(&snapshot) {
+		return ctrl.Result{}, nil
+	}
+
+	newSnapshot := snapshot.DeepCopy()
+	conditions, recErr := r.reconcile(ctx, cl.GetClient(), newSnapshot)
+
+	if err := r.reconcileStatus(ctx, cl.GetClient(), &snapshot, newSnapshot, conditions); err != nil {
+		recErr = kerrors.NewAggregate([]error{recErr, err})
+	}
+
+	return ctrl.Result{}, recErr
+}
+
+func isFinished(snapshot *operatorv1alpha1.EtcdSnapshot) bool {
+	return snapshot.Status.Phase == operatorv1alpha1.EtcdSnapshotPhaseCompleted || snapshot.Status.Phase == operatorv1alpha1.EtcdSnapshotPhaseFailed
+}
+
+func (r *EtcdSnapshotReconciler) reconcile(ctx context.Context, client ctrlruntimeclient.Client, snapshot *operatorv1alpha1.EtcdSnapshot) ([]metav1.Condition, error) {
+	var failed *metav1.Condition
+
+	// etcdctl can only take a snapshot from a single endpoint, so the endpoints are tried one
+	// after another, each with a Job of its own, until one of them succeeds.
+	for endpoint := 0; ; endpoint++ {
+		var job batchv1.Job
+		err := client.Get(ctx, types.NamespacedName{Namespace: snapshot.Namespace, Name: resources.GetEtcdSnapshotJobName(snapshot, endpoint)}, &job)
+		if ctrlruntimeclient.IgnoreNotFound(err) != nil {
+			return nil, fmt.Errorf("failed to get snapshot Job: %w", err)
+		}
+
+		if err != nil {
+			return r.createJob(ctx, client, snapshot, endpoint, failed)
+		}
+
+		cond := jobCondition(&job)
+		switch cond.Reason {
+		case string(operatorv1alpha1.ConditionReasonJobFailed):
+			failed = &cond
+			continue
+
+		case string(operatorv1alpha1.ConditionReasonJobCompleted):
+			snapshot.Status.Location = etcdbackup.Location(snapshot, snapshot.Spec.Storage)
+		}
+
+		return []metav1.Condition{cond}, nil
+	}
+}
+
+// createJob creates the Job that takes the snapshot from the endpoint with the given index. If
+// there is no such endpoint, all endpoints have failed and the last failure is reported.
+func (r *EtcdSnapshotReconciler) createJob(ctx context.Context, client ctrlruntimeclient.Client, snapshot *operatorv1alpha1.EtcdSnapshot, endpoint int, failed *metav1.Condition) ([]metav1.Condition, error) {
+	targetCond, target, etcdConfig := util.FetchEtcdSnapshotTarget(ctx, client, snapshot.Namespace, snapshot.Spec.Target)
+	conditions := []metav1.Condition{targetCond}
+
+	if target == nil {
+		return conditions, fmt.Errorf("failed to resolve target: %s", targetCond.Message)
+	}
+
+	if failed != nil && endpoint >= len(etcdbackup.Endpoints(target.GetName(), *etcdConfig)) {
+		return append(conditions, *failed), nil
+	}
+
+	job, err := etcdbackup.SnapshotJob(snapshot, target.GetName(), *etcdConfig, endpoint)
+	if err != nil {
+		return conditions, fmt.Errorf("failed to render snapshot Job: %w", err)
+	}
+
+	job.OwnerReferences = []metav1.OwnerReference{*metav1.NewControllerRef(snapshot, operatorv1alpha1.SchemeGroupVersion.WithKind("EtcdSnapshot"))}
+
+	if err := client.Create(ctx, job); err != nil {
+		return conditions, fmt.Errorf("failed to create snapshot Job: %w", err)
+	}
+
+	if snapshot.Status.StartTime == nil {
+		now := metav1.Now()
+		snapshot.Status.StartTime = &now
+	}
+
+	return append(conditions, jobCondition(job)), nil
+}
+
+// jobCondition translates the state of a Job into a Ready condition.
+func jobCondition(job *batchv1.Job) metav1.Condition {
+	for _, cond := range job.Status.Conditions {
+		if cond.Status != corev1.ConditionTrue {
+			continue
+		}
+
+		switch cond.Type {
+		case batchv1.JobComplete:
+			return metav1.Condition{
+				Type:    string(operatorv1alpha1.ConditionTypeReady),
+				Status:  metav1.ConditionTrue,
+				Reason:  string(operatorv1alpha1.ConditionReasonJobCompleted),
+				Message: fmt.Sprintf("Job %s has completed.", job.Name),
+			}
+
+		case batchv1.JobFailed:
+			return metav1.Condition{
+				Type:    string(operatorv1alpha1.ConditionTypeReady),
+				Status:  metav1.ConditionFalse,
+				Reason:  string(operatorv1alpha1.ConditionReasonJobFailed),
+				Message: fmt.Sprintf("Job %s has failed: %s", job.Name, cond.Message),
+			}
+		}
+	}
+
+	return metav1.Condition{
+		Type:    string(operatorv1alpha1.ConditionTypeReady),
+		Status:  metav1.ConditionFalse,
+		Reason:  string(operatorv1alpha1.ConditionReasonJobRunning),
+		Message: fmt.Sprintf("Job %s is running.", job.Name),
+	}
+}
+
+func (r *EtcdSnapshotReconciler) reconcileStatus(ctx context.Context, client ctrlruntimeclient.Client, oldSnapshot, newSnapshot *operatorv1alpha1.EtcdSnapshot, conditions []metav1.Condition) error {
+	// The phase is derived from the reason of the Ready condition, which has to be updated even
+	// if its status stays the same, e.g. when a running Job fails.
+	for _, condition := range conditions {
+		condition.ObservedGeneration = newSnapshot.Generation
+		apimeta.SetStatusCondition(&newSnapshot.Status.Conditions, condition)
+	}
+
+	readyCond := apimeta.FindStatusCondition(newSnapshot.Status.Conditions, string(operatorv1alpha1.ConditionTypeReady))
+
+	switch {
+	case readyCond == nil:
+		newSnapshot.Status.Phase = operatorv1alpha1.EtcdSnapshotPhasePending
+
+	case readyCond.Reason == string(operatorv1alpha1.ConditionReasonJobCompleted):
+		newSnapshot.Status.Phase = operatorv1alpha1.EtcdSnapshotPhaseCompleted
+
+	case readyCond.Reason == string(operatorv1alpha1.ConditionReasonJobFailed):
+		newSnapshot.Status.Phase = operatorv1alpha1.EtcdSnapshotPhaseFailed
+
+	default:
+		newSnapshot.Status.Phase = operatorv1alpha1.EtcdSnapshotPhaseRunning
+	}
+
+	if isFinished(newSnapshot) && newSnapshot.Status.CompletionTime == nil {
+		now := metav1.Now()
+		newSnapshot.Status.CompletionTime = &now
+	}
+
+	// only patch the status if there are actual changes.
+	if !equality.Semantic.DeepEqual(oldSnapshot.Status, newSnapshot.Status) {
+		if err := client.Status().Patch(ctx, newSnapshot, ctrlruntimeclient.MergeFrom(oldSnapshot)); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,246 @@
+/*
+Copyright 2026 The kcp Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package etcdsnapshot
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	ctrlruntimeclient "sigs.k8s.io/controller-runtime/pkg/client"
+	ctrlruntimefakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+	mcreconcile "sigs.k8s.io/multicluster-runtime/pkg/reconcile"
+
+	"github.com/kcp-dev/kcp-operator/pkg/controller/util"
+	operatorv1alpha1 "github.com/kcp-dev/kcp-operator/sdk/apis/operator/v1alpha1"
+)
+
+func TestReconciling(t *testing.T) {
+	const namespace = "etcdsnapshot-tests"
+
+	shard := &operatorv1alpha1.Shard{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "shardy",
+			Namespace: namespace,
+		},
+		Spec: operatorv1alpha1.ShardSpec{
+			CommonShardSpec: operatorv1alpha1.CommonShardSpec{
+				Etcd: operatorv1alpha1.EtcdConfig{
+					Endpoints: []string{"https://etcd-0:2379", "https://etcd-1:2379"},
+					TLSConfig: &operatorv1alpha1.EtcdTLSConfig{
+						SecretRef: corev1.LocalObjectReference{Name: "etcd-client"},
+					},
+				},
+			},
+		},
+	}
+
+	snapshot := &operatorv1alpha1.EtcdSnapshot{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "snappy",
+			Namespace: namespace,
+		},
+		Spec: operatorv1alpha1.EtcdSnapshotSpec{
+			Target: operatorv1alpha1.EtcdSnapshotTarget{
+				ShardRef: &corev1.LocalObjectReference{Name: "shardy"},
+			},
+			Storage: operatorv1alpha1.EtcdSnapshotStorage{
+				PersistentVolumeClaim: &operatorv1alpha1.PersistentVolumeClaimSnapshotStorage{
+					ClaimName: "backups",
+					SubPath:   "shardy",
+				},
+			},
+		},
+	}
+
+	client := ctrlruntimefakeclient.
+		NewClientBuilder().
+		WithScheme(util.GetTestScheme()).
+		WithStatusSubresource(snapshot).
+		WithObjects(shard, snapshot).
+		Build()
+
+	ctx := context.Background()
+	key := ctrlruntimeclient.ObjectKeyFromObject(snapshot)
+
+	controllerReconciler := &EtcdSnapshotReconciler{
+		GetCluster: util.FakeSingleCluster(client),
+	}
+
+	reconcileSnapshot := func() *operatorv1alpha1.EtcdSnapshot {
+		t.Helper()
+
+		_, err := controllerReconciler.Reconcile(ctx, mcreconcile.Request{
+			Request: reconcile.Request{NamespacedName: key},
+		})
+		require.NoError(t, err)
+
+		current := &operatorv1alpha1.EtcdSnapshot{}
+		require.NoError(t, client.Get(ctx, key, current))
+
+		return current
+	}
+
+	current := reconcileSnapshot()
+	require.Equal(t, operatorv1alpha1.EtcdSnapshotPhaseRunning, current.Status.Phase)
+	require.NotNil(t, current.Status.StartTime)
+
+	job := &batchv1.Job{}
+	require.NoError(t, client.Get(ctx, types.NamespacedName{Namespace: namespace, Name: "snappy-etcd-snapshot"}, job))
+	require.Len(t, job.OwnerReferences, 1)
+
+	container := job.Spec.Template.Spec.Containers[0]
+	require.Contains(t, container.Env, corev1.EnvVar{Name: "ETCDCTL_ENDPOINTS", Value: "https://etcd-0:2379"})
+	require.Equal(t, "etcd-client", job.Spec.Template.Spec.Volumes[1].Secret.SecretName)
+
+	job.Status.Conditions = []batchv1.JobCondition{{Type: batchv1.JobComplete, Status: corev1.ConditionTrue}}
+	require.NoError(t, client.Status().Update(ctx, job))
+
+	current = reconcileSnapshot()
+	require.Equal(t, operatorv1alpha1.EtcdSnapshotPhaseCompleted, current.Status.Phase)
+	require.Equal(t, "pvc://backups/shardy/snappy.db", current.Status.Location)
+	require.NotNil(t, current.Status.CompletionTime)
+}
+
+func TestMissingTarget(t *testing.T) {
+	snapshot := &operatorv1alpha1.EtcdSnapshot{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "snappy",
+			Namespace: "default",
+		},
+		Spec: operatorv1alpha1.EtcdSnapshotSpec{
+			Target: operatorv1alpha1.EtcdSnapshotTarget{
+				RootShardRef: &corev1.LocalObjectReference{Name: "does-not-exist"},
+			},
+		},
+	}
+
+	client := ctrlruntimefakeclient.
+		NewClientBuilder().
+		WithScheme(util.GetTestScheme()).
+		WithStatusSubresource(snapshot).
+		WithObjects(snapshot).
+		Build()
+
+	ctx := context.Background()
+
+	_, err := (&EtcdSnapshotReconciler{GetCluster: util.FakeSingleCluster(client)}).Reconcile(ctx, mcreconcile.Request{
+		Request: reconcile.Request{NamespacedName: ctrlruntimeclient.ObjectKeyFromObject(snapshot)},
+	})
+	require.Error(t, err)
+
+	current := &operatorv1alpha1.EtcdSnapshot{}
+	require.NoError(t, client.Get(ctx, ctrlruntimeclient.ObjectKeyFromObject(snapshot), current))
+	require.Equal(t, operatorv1alpha1.EtcdSnapshotPhasePending, current.Status.Phase)
+}
+
+func TestEndpointFailover(t *testing.T) {
+	const namespace = "etcdsnapshot-tests"
+
+	shard := &operatorv1alpha1.Shard{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "shardy",
+			Namespace: namespace,
+		},
+		Spec: operatorv1alpha1.ShardSpec{
+			CommonShardSpec: operatorv1alpha1.CommonShardSpec{
+				Etcd: operatorv1alpha1.EtcdConfig{
+					Endpoints: []string{"https://etcd-0:2379", "https://etcd-1:2379"},
+				},
+			},
+		},
+	}
+
+	snapshot := &operatorv1alpha1.EtcdSnapshot{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "snappy",
+			Namespace: namespace,
+		},
+		Spec: operatorv1alpha1.EtcdSnapshotSpec{
+			Target: operatorv1alpha1.EtcdSnapshotTarget{
+				ShardRef: &corev1.LocalObjectReference{Name: "shardy"},
+			},
+			Storage: operatorv1alpha1.EtcdSnapshotStorage{
+				PersistentVolumeClaim: &operatorv1alpha1.PersistentVolumeClaimSnapshotStorage{
+					ClaimName: "backups",
+				},
+			},
+		},
+	}
+
+	client := ctrlruntimefakeclient.
+		NewClientBuilder().
+		WithScheme(util.GetTestScheme()).
+		WithStatusSubresource(snapshot, &batchv1.Job{}).
+		WithObjects(shard, snapshot).
+		Build()
+
+	ctx := context.Background()
+	key := ctrlruntimeclient.ObjectKeyFromObject(snapshot)
+
+	controllerReconciler := &EtcdSnapshotReconciler{
+		GetCluster: util.FakeSingleCluster(client),
+	}
+
+	reconcileSnapshot := func() *operatorv1alpha1.EtcdSnapshot {
+		t.Helper()
+
+		_, err := controllerReconciler.Reconcile(ctx, mcreconcile.Request{
+			Request: reconcile.Request{NamespacedName: key},
+		})
+		require.NoError(t, err)
+
+		current := &operatorv1alpha1.EtcdSnapshot{}
+		require.NoError(t, client.Get(ctx, key, current))
+
+		return current
+	}
+
+	failJob := func(name string) {
+		t.Helper()
+
+		job := &batchv1.Job{}
+		require.NoError(t, client.Get(ctx, types.NamespacedName{Namespace: namespace, Name: name}, job))
+
+		job.Status.Conditions = []batchv1.JobCondition{{Type: batchv1.JobFailed, Status: corev1.ConditionTrue, Message: "etcd unavailable"}}
+		require.NoError(t, client.Status().Update(ctx, job))
+	}
+
+	reconcileSnapshot()
+	failJob("snappy-etcd-snapshot")
+
+	// The next endpoint is tried when the first one fails.
+	current := reconcileSnapshot()
+	require.Equal(t, operatorv1alpha1.EtcdSnapshotPhaseRunning, current.Status.Phase)
+
+	job := &batchv1.Job{}
+	require.NoError(t, client.Get(ctx, types.NamespacedName{Namespace: namespace, Name: "snappy-etcd-snapshot-1"}, job))
+	require.Contains(t, job.Spec.Template.Spec.Containers[0].Env, corev1.EnvVar{Name: "ETCDCTL_ENDPOINTS", Value: "https://etcd-1:2379"})
+
+	// The snapshot only fails once all endpoints have failed.
+	failJob("snappy-etcd-snapshot-1")
+
+	current = reconcileSnapshot()
+	require.Equal(t, operatorv1alpha1.EtcdSnapshotPhaseFailed, current.Status.Phase)
+	require.NotNil(t, current.Status.CompletionTime)
+}
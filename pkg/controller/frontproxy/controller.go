@@ -44,8 +44,10 @@ import (
 	"github.com/kcp-dev/kcp-operator/internal/resources/frontproxy"
 	"github.com/kcp-dev/kcp-operator/pkg/controller/util"
 	"github.com/kcp-dev/kcp-operator/pkg/metrics"
+	"github.com/kcp-dev/kcp-operator/pkg/pki"
 	"github.com/kcp-dev/kcp-operator/pkg/reconciling"
 	"github.com/kcp-dev/kcp-operator/pkg/reconciling/modifier"
+	"github.com/kcp-dev/kcp-operator/pkg/tracing"
 	deployv1alpha1 "github.com/kcp-dev/kcp-operator/sdk/apis/deploy/v1alpha1"
 	operatorv1alpha1 "github.com/kcp-dev/kcp-operator/sdk/apis/operator/v1alpha1"
 )
@@ -53,6 +55,8 @@ import (
 // FrontProxyReconciler reconciles a FrontProxy object
 type FrontProxyReconciler struct {
 	GetCluster func(ctx context.Context, clusterName multicluster.ClusterName) (cluster.Cluster, error)
+	Events     *util.EventRecorder
+	PKI        pki.Backend
 }
 
 // SetupWithManager sets up the controller with the Manager.
@@ -81,9 +85,9 @@ func (r *FrontProxyReconciler) SetupWithManager(mgr mcmanager.Manager, opts ...m
 		For(&operatorv1alpha1.FrontProxy{}, util.EngageFor(opts)...).
 		Owns(&deployv1alpha1.CompiledFrontProxy{}, util.EngageOwns(opts)...).
 		Owns(&corev1.Secret{}, util.EngageOwns(opts)...).
-		Owns(&certmanagerv1.Certificate{}, util.EngageOwns(opts)...).
+		Owns(r.PKI.CertificateType(), util.EngageOwns(opts)...).
 		Watches(&operatorv1alpha1.RootShard{}, rootShardHandler, util.EngageWatches(opts)...).
-		Complete(r)
+		Complete(tracing.Reconciler("frontproxy", r))
 }
 
 // +kubebuilder:rbac:groups=operator.kcp.io,resources=frontproxies,verbs=get;list;watch;create;update;patch;delete
@@ -120,16 +124,39 @@ func (r *FrontProxyReconciler) Reconcile(ctx context.Context, req mcreconcile.Re
 		return ctrl.Result{}, nil
 	}
 
-	conditions, recErr := r.reconcile(ctx, cl.GetClient(), &frontProxy)
+	recorder := r.Events.For(req.ClusterName, cl)
 
-	if err := r.reconcileStatus(ctx, cl.GetClient(), &frontProxy, conditions); err != nil {
+	var (
+		conditions []metav1.Condition
+		certs      []*certmanagerv1.Certificate
+	)
+	if util.IsPaused(&frontProxy) {
+		logger.V(4).Info("Skipping reconciliation because the object is paused")
+		recErr = util.PauseCompiled(ctx, cl.GetClient(), req.NamespacedName, &deployv1alpha1.CompiledFrontProxy{})
+	} else {
+		metrics.RecordGeneration(&frontProxy)
+		conditions, recErr = r.reconcile(ctx, cl.GetClient(), recorder, &frontProxy, &certs)
+	}
+
+	certsCond, requeueAfter, err := util.GetCertificatesReadyCondition(ctx, cl.GetClient(), r.PKI, &frontProxy)
+	if err != nil {
+		recErr = kerrors.NewAggregate([]error{recErr, err})
+	} else {
+		conditions = append(conditions, certsCond)
+	}
+
+	if err := r.reconcileStatus(ctx, cl.GetClient(), recorder, &frontProxy, conditions, certs); err != nil {
 		recErr = kerrors.NewAggregate([]error{recErr, err})
 	}
 
-	return ctrl.Result{}, recErr
+	recorder.ReconcileFailed(&frontProxy, recErr)
+
+	requeueAfter = util.MinRequeueAfter(requeueAfter, util.CertificateRotationRequeueAfter(&frontProxy))
+
+	return ctrl.Result{RequeueAfter: requeueAfter}, recErr
 }
 
-func (r *FrontProxyReconciler) reconcile(ctx context.Context, client ctrlruntimeclient.Client, frontProxy *operatorv1alpha1.FrontProxy) ([]metav1.Condition, error) {
+func (r *FrontProxyReconciler) reconcile(ctx context.Context, client ctrlruntimeclient.Client, recorder *util.Recorder, frontProxy *operatorv1alpha1.FrontProxy, certs *[]*certmanagerv1.Certificate) ([]metav1.Condition, error) {
 	var (
 		conditions []metav1.Condition
 		errs       []error
@@ -143,6 +170,7 @@ func (r *FrontProxyReconciler) reconcile(ctx context.Context, client ctrlruntime
 	conditions = append(conditions, cond)
 
 	if rootShard == nil {
+		recorder.ReferenceMissing(frontProxy, cond)
 		return conditions, nil
 	}
 
@@ -153,45 +181,92 @@ func (r *FrontProxyReconciler) reconcile(ctx context.Context, client ctrlruntime
 
 	// Certificates and CA bundles stay here; the workloads are rendered by the
 	// CompiledFrontProxy controller.
-	var certs []*certmanagerv1.Certificate
-	if err := frontproxy.NewFrontProxy(frontProxy, rootShard, shards).Reconcile(ctx, client, frontProxy.Namespace, modifier.Capture(&certs)); err != nil {
+	if err := tracing.Trace(ctx, "ReconcileCertificates", func(ctx context.Context) error {
+		return frontproxy.NewFrontProxy(frontProxy, rootShard, shards).Reconcile(ctx, client, r.PKI, frontProxy.Namespace, modifier.Capture(certs))
+	}); err != nil {
 		errs = append(errs, fmt.Errorf("failed to reconcile: %w", err))
 	}
 
+	if err := util.RotateCertificates(ctx, client, r.PKI, recorder, frontProxy, frontProxy.Status.CertificateRotation, *certs); err != nil {
+		errs = append(errs, err)
+	}
+
 	ownerRefWrapper := k8creconciling.OwnerRefWrapper(*metav1.NewControllerRef(frontProxy, operatorv1alpha1.SchemeGroupVersion.WithKind("FrontProxy")))
 
 	// Only publish the render input once every Certificate is ready, so that whoever consumes
 	// it can rely on the Secrets it mounts already existing.
-	revisions, certsReady := util.CertificateRevisions(certs)
+	revisions, certsReady := util.CertificateRevisions(*certs)
 	if !certsReady {
 		return conditions, kerrors.NewAggregate(errs)
 	}
 
-	if err := reconciling.ReconcileCompiledFrontProxys(ctx, []reconciling.NamedCompiledFrontProxyReconcilerFactory{
-		frontproxy.CompiledFrontProxyReconciler(frontProxy, rootShard, shards, util.MutateKeys(revisions, "cert-", "-revision")),
-	}, frontProxy.Namespace, client, ownerRefWrapper); err != nil {
+	recorder.CertificatesIssued(frontProxy, len(*certs))
+
+	// New kcp versions are rolled out step by step across the installation, and front-proxies
+	// are the last ones to be upgraded.
+	plan, err := util.GetUpgradePlan(ctx, client, rootShard)
+	if err != nil {
+		errs = append(errs, fmt.Errorf("failed to determine upgrade plan: %w", err))
+		return conditions, kerrors.NewAggregate(errs)
+	}
+
+	compiledFrontProxy := frontProxy
+	if decision := plan.Decide(util.UpgradeKindFrontProxy, frontProxy.Name); decision.HeldBack() {
+		compiledFrontProxy = frontProxy.DeepCopy()
+		compiledFrontProxy.Spec.Image = decision.Image
+	}
+
+	var published bool
+	if err := tracing.Trace(ctx, "PublishCompiled", func(ctx context.Context) error {
+		return reconciling.ReconcileCompiledFrontProxys(ctx, []reconciling.NamedCompiledFrontProxyReconcilerFactory{
+			frontproxy.CompiledFrontProxyReconciler(compiledFrontProxy, rootShard, shards, util.MutateKeys(revisions, "cert-", "-revision")),
+		}, frontProxy.Namespace, client, ownerRefWrapper, modifier.PublishedAt(time.Now(), &published), modifier.TraceParent(ctx))
+	}); err != nil {
 		errs = append(errs, err)
+	} else {
+		metrics.RecordPublished(metrics.FrontProxyResourceType, frontProxy, published)
 	}
 
 	return conditions, kerrors.NewAggregate(errs)
 }
 
-func (r *FrontProxyReconciler) reconcileStatus(ctx context.Context, client ctrlruntimeclient.Client, oldFrontProxy *operatorv1alpha1.FrontProxy, conditions []metav1.Condition) error {
+func (r *FrontProxyReconciler) reconcileStatus(ctx context.Context, client ctrlruntimeclient.Client, recorder *util.Recorder, oldFrontProxy *operatorv1alpha1.FrontProxy, conditions []metav1.Condition, certs []*certmanagerv1.Certificate) error {
 	frontProxy := oldFrontProxy.DeepCopy()
 	var errs []error
 
+	// Certificates are only known if they have been reconciled.
+	if certs != nil {
+		frontProxy.Status.Certificates = util.CertificateInventory(certs)
+	}
+
 	compiled := &deployv1alpha1.CompiledFrontProxy{}
 	key := types.NamespacedName{Namespace: frontProxy.Namespace, Name: frontProxy.Name}
 	if err := client.Get(ctx, key, compiled); ctrlruntimeclient.IgnoreNotFound(err) != nil {
 		errs = append(errs, err)
 	} else {
 		conditions = append(conditions, util.GetCompiledAvailableCondition(compiled.Status.Conditions, "CompiledFrontProxy "+frontProxy.Name))
+		frontProxy.Status.Version = util.AdoptVersionStatus(frontProxy.Spec.Image, compiled.Status.Version)
+		frontProxy.Status.Placement = compiled.Status.Placement
+
+		if rotation, cond := util.CertificateRotation(frontProxy, frontProxy.Status.CertificateRotation, certs, compiled, compiled.Status.Conditions); cond != nil {
+			frontProxy.Status.CertificateRotation = rotation
+			cond.ObservedGeneration = frontProxy.Generation
+			apimeta.SetStatusCondition(&frontProxy.Status.Conditions, *cond)
+		}
+
+		recorder.CompiledPublished(frontProxy, compiled, "CompiledFrontProxy")
+		recorder.DeploymentRolled(frontProxy, oldFrontProxy.Status.Version, frontProxy.Status.Version)
+
+		if cond := util.GetCompiledCondition(compiled.Status.Conditions, operatorv1alpha1.ConditionTypeHealthy); cond != nil {
+			conditions = append(conditions, *cond)
+		}
 	}
 
 	for _, condition := range conditions {
 		condition.ObservedGeneration = frontProxy.Generation
 		frontProxy.Status.Conditions = util.UpdateCondition(frontProxy.Status.Conditions, condition)
 	}
+	frontProxy.Status.Conditions = util.UpdatePausedCondition(frontProxy, frontProxy.Status.Conditions)
 
 	if frontProxy.DeletionTimestamp != nil {
 		frontProxy.Status.Phase = operatorv1alpha1.FrontProxyPhaseDeleting
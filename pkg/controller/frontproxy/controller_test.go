@@ -24,12 +24,14 @@ import (
 
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/events"
 	ctrlruntimeclient "sigs.k8s.io/controller-runtime/pkg/client"
 	ctrlruntimefakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 	mcreconcile "sigs.k8s.io/multicluster-runtime/pkg/reconcile"
 
 	"github.com/kcp-dev/kcp-operator/pkg/controller/util"
+	"github.com/kcp-dev/kcp-operator/pkg/pki"
 	operatorv1alpha1 "github.com/kcp-dev/kcp-operator/sdk/apis/operator/v1alpha1"
 )
 
@@ -140,6 +142,7 @@ func TestReconciling(t *testing.T) {
 
 			controllerReconciler := &FrontProxyReconciler{
 				GetCluster: util.FakeSingleCluster(client),
+				PKI:        pki.CertManager{},
 			}
 
 			_, err := controllerReconciler.Reconcile(ctx, mcreconcile.Request{
@@ -384,6 +387,7 @@ func TestClientCABundleMerging(t *testing.T) {
 
 			controllerReconciler := &FrontProxyReconciler{
 				GetCluster: util.FakeSingleCluster(client),
+				PKI:        pki.CertManager{},
 			}
 
 			_, err := controllerReconciler.Reconcile(ctx, mcreconcile.Request{
@@ -411,3 +415,48 @@ func TestClientCABundleMerging(t *testing.T) {
 		})
 	}
 }
+
+func TestMissingRootShardEvent(t *testing.T) {
+	frontProxy := &operatorv1alpha1.FrontProxy{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "proxy",
+			Namespace: "frontproxy-tests",
+			UID:       "1234",
+		},
+		Spec: operatorv1alpha1.FrontProxySpec{
+			RootShard: operatorv1alpha1.RootShardConfig{
+				Reference: &corev1.LocalObjectReference{Name: "missing"},
+			},
+		},
+	}
+
+	client := ctrlruntimefakeclient.
+		NewClientBuilder().
+		WithScheme(util.GetTestScheme()).
+		WithStatusSubresource(frontProxy).
+		WithObjects(frontProxy).
+		Build()
+
+	recorder := events.NewFakeRecorder(10)
+
+	controllerReconciler := &FrontProxyReconciler{
+		GetCluster: util.FakeSingleClusterWithEvents(client, recorder),
+		Events:     util.NewEventRecorder("test"),
+		PKI:        pki.CertManager{},
+	}
+
+	request := mcreconcile.Request{
+		Request: reconcile.Request{
+			NamespacedName: ctrlruntimeclient.ObjectKeyFromObject(frontProxy),
+		},
+	}
+
+	// reconciling twice must not record the same event again
+	for range 2 {
+		_, err := controllerReconciler.Reconcile(context.Background(), request)
+		require.NoError(t, err)
+	}
+
+	require.Len(t, recorder.Events, 1)
+	require.Contains(t, <-recorder.Events, "Warning ReferenceMissing")
+}
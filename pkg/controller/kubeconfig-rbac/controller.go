@@ -20,6 +20,7 @@ import (
 	"context"
 	"fmt"
 	"slices"
+	"strings"
 
 	"github.com/kcp-dev/logicalcluster/v3"
 	"k8c.io/reconciler/pkg/reconciling"
@@ -38,6 +39,7 @@ import (
 	"github.com/kcp-dev/kcp-operator/internal/resources/kubeconfig"
 	operatorclient "github.com/kcp-dev/kcp-operator/pkg/client"
 	"github.com/kcp-dev/kcp-operator/pkg/controller/util"
+	"github.com/kcp-dev/kcp-operator/pkg/tracing"
 	operatorv1alpha1 "github.com/kcp-dev/kcp-operator/sdk/apis/operator/v1alpha1"
 )
 
@@ -47,6 +49,7 @@ const cleanupFinalizer = "operator.kcp.io/cleanup-rbac"
 type KubeconfigRBACReconciler struct {
 	GetCluster func(ctx context.Context, clusterName multicluster.ClusterName) (cluster.Cluster, error)
 	Address    operatorclient.Addresser
+	Events     *util.EventRecorder
 }
 
 // SetupWithManager sets up the controller with the Manager.
@@ -54,7 +57,7 @@ func (r *KubeconfigRBACReconciler) SetupWithManager(mgr mcmanager.Manager, opts
 	return mcbuilder.ControllerManagedBy(mgr).
 		For(&operatorv1alpha1.Kubeconfig{}, util.EngageFor(opts)...).
 		Named("kubeconfig-rbac").
-		Complete(r)
+		Complete(tracing.Reconciler("kubeconfig-rbac", r))
 }
 
 // +kubebuilder:rbac:groups=operator.kcp.io,resources=kubeconfigs,verbs=get;update;patch
@@ -77,12 +80,15 @@ func (r *KubeconfigRBACReconciler) Reconcile(ctx context.Context, req mcreconcil
 		return ctrl.Result{}, ctrlruntimeclient.IgnoreNotFound(err)
 	}
 
-	err = r.reconcile(ctx, cl.GetClient(), config)
+	recorder := r.Events.For(req.ClusterName, cl)
+
+	err = r.reconcile(ctx, cl.GetClient(), recorder, config)
+	recorder.ReconcileFailed(config, err)
 
 	return ctrl.Result{}, err
 }
 
-func (r *KubeconfigRBACReconciler) reconcile(ctx context.Context, client ctrlruntimeclient.Client, config *operatorv1alpha1.Kubeconfig) error {
+func (r *KubeconfigRBACReconciler) reconcile(ctx context.Context, client ctrlruntimeclient.Client, recorder *util.Recorder, config *operatorv1alpha1.Kubeconfig) error {
 	if config.DeletionTimestamp != nil {
 		return r.handleDeletion(ctx, client, config)
 	}
@@ -138,6 +144,9 @@ func (r *KubeconfigRBACReconciler) reconcile(ctx context.Context, client ctrlrun
 		return fmt.Errorf("failed to ensure ClusterRoleBindings: %w", err)
 	}
 
+	recorder.Normal(config, util.EventReasonRBACProvisioned, util.EventActionProvisionRBAC, "Bound ClusterRoles [%s] in workspace %s.",
+		strings.Join(config.Spec.Authorization.ClusterRoleBindings.ClusterRoles, ", "), newCluster)
+
 	return nil
 }
 
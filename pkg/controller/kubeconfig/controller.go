@@ -44,15 +44,20 @@ import (
 
 	"github.com/kcp-dev/kcp-operator/internal/resources"
 	"github.com/kcp-dev/kcp-operator/internal/resources/kubeconfig"
+	"github.com/kcp-dev/kcp-operator/internal/resources/utils"
 	"github.com/kcp-dev/kcp-operator/pkg/controller/util"
 	"github.com/kcp-dev/kcp-operator/pkg/metrics"
+	"github.com/kcp-dev/kcp-operator/pkg/pki"
 	"github.com/kcp-dev/kcp-operator/pkg/reconciling"
+	"github.com/kcp-dev/kcp-operator/pkg/tracing"
 	operatorv1alpha1 "github.com/kcp-dev/kcp-operator/sdk/apis/operator/v1alpha1"
 )
 
 // KubeconfigReconciler reconciles a Kubeconfig object
 type KubeconfigReconciler struct {
 	GetCluster func(ctx context.Context, clusterName multicluster.ClusterName) (cluster.Cluster, error)
+	Events     *util.EventRecorder
+	PKI        pki.Backend
 }
 
 // SetupWithManager sets up the controller with the Manager.
@@ -64,8 +69,8 @@ func (r *KubeconfigReconciler) SetupWithManager(mgr mcmanager.Manager, opts ...m
 		Watches(&operatorv1alpha1.Shard{}, util.EnqueueMapped(r.mapShardToKubeconfigs), util.EngageWatches(opts)...).
 		Watches(&operatorv1alpha1.FrontProxy{}, util.EnqueueMapped(r.mapFrontProxyToKubeconfigs), util.EngageWatches(opts)...).
 		Owns(&corev1.Secret{}, util.EngageOwns(opts)...).
-		Owns(&certmanagerv1.Certificate{}, util.EngageOwns(opts)...).
-		Complete(r)
+		Owns(r.PKI.CertificateType(), util.EngageOwns(opts)...).
+		Complete(tracing.Reconciler("kubeconfig", r))
 }
 
 // +kubebuilder:rbac:groups=operator.kcp.io,resources=kubeconfigs,verbs=get;list;watch;update;patch
@@ -108,8 +113,25 @@ func (r *KubeconfigReconciler) Reconcile(ctx context.Context, req mcreconcile.Re
 
 	kcCopy := kc.DeepCopy()
 	kcCopy.Status.TargetName = r.getTargetName(&kc)
+	recorder := r.Events.For(req.ClusterName, cl)
+
+	conditions, recErr := r.reconcile(ctx, cl.GetClient(), recorder, kcCopy, req.NamespacedName)
+
+	certsCond, requeueAfter, err := util.GetCertificatesReadyCondition(ctx, cl.GetClient(), r.PKI, &kc)
+	if err != nil {
+		recErr = kerrors.NewAggregate([]error{recErr, err})
+	} else {
+		conditions = append(conditions, certsCond)
+	}
+
+	// The client certificate is not controlled by the Kubeconfig, so its renewal is scheduled here.
+	cert, err := r.PKI.GetCertificate(ctx, cl.GetClient(), types.NamespacedName{Namespace: kc.Namespace, Name: kc.GetCertificateName()})
+	if ctrlruntimeclient.IgnoreNotFound(err) != nil {
+		recErr = kerrors.NewAggregate([]error{recErr, fmt.Errorf("failed to get client certificate: %w", err)})
+	} else if err == nil {
+		requeueAfter = util.MinRequeueAfter(requeueAfter, util.CertificateRenewalRequeueAfter(r.PKI, []certmanagerv1.Certificate{*cert}, time.Now()))
+	}
 
-	conditions, recErr := r.reconcile(ctx, cl.GetClient(), kcCopy, req.NamespacedName)
 	if recErr == nil && len(conditions) > 0 {
 		for _, cond := range conditions {
 			if cond.Reason == "ClientCertificateSecretNotReady" ||
@@ -118,27 +140,29 @@ func (r *KubeconfigReconciler) Reconcile(ctx context.Context, req mcreconcile.Re
 					"kubeconfig", req.NamespacedName,
 					"message", cond.Message)
 
-				_ = r.reconcileStatus(ctx, cl.GetClient(), &kc, kcCopy, conditions)
+				_ = r.reconcileStatus(ctx, cl.GetClient(), recorder, &kc, kcCopy, conditions)
 
 				return ctrl.Result{RequeueAfter: time.Second * 5}, nil
 			}
 		}
 	}
 
-	if err := r.reconcileStatus(ctx, cl.GetClient(), &kc, kcCopy, conditions); err != nil {
+	if err := r.reconcileStatus(ctx, cl.GetClient(), recorder, &kc, kcCopy, conditions); err != nil {
 		recErr = kerrors.NewAggregate([]error{recErr, err})
 	}
 
-	return ctrl.Result{}, recErr
+	recorder.ReconcileFailed(&kc, recErr)
+
+	return ctrl.Result{RequeueAfter: requeueAfter}, recErr
 }
 
-func (r *KubeconfigReconciler) reconcile(ctx context.Context, client ctrlruntimeclient.Client, kc *operatorv1alpha1.Kubeconfig, req types.NamespacedName) ([]metav1.Condition, error) {
+func (r *KubeconfigReconciler) reconcile(ctx context.Context, client ctrlruntimeclient.Client, recorder *util.Recorder, kc *operatorv1alpha1.Kubeconfig, req types.NamespacedName) ([]metav1.Condition, error) {
 	var conditions []metav1.Condition
 
 	rootShard := &operatorv1alpha1.RootShard{}
 	shard := &operatorv1alpha1.Shard{}
 	var frontProxy operatorv1alpha1.FrontProxy
-	var caBundle *corev1.Secret
+	var caBundle []byte
 
 	var (
 		clientCertIssuer string
@@ -236,19 +260,18 @@ func (r *KubeconfigReconciler) reconcile(ctx context.Context, client ctrlruntime
 		clientCertIssuer = resources.GetRootShardCAName(rootShard, operatorv1alpha1.ClientCA)
 		serverCA = resources.GetRootShardCAName(rootShard, operatorv1alpha1.ServerCA)
 
-		if frontProxy.Spec.CABundleSecretRef != nil {
-			caBundle = &corev1.Secret{}
-			if err := client.Get(ctx, types.NamespacedName{Name: frontProxy.Spec.CABundleSecretRef.Name, Namespace: req.Namespace}, caBundle); err != nil {
-				err = fmt.Errorf("failed to get CA bundle secret %s/%s: %w", req.Namespace, frontProxy.Spec.CABundleSecretRef.Name, err)
-				conditions = append(conditions, metav1.Condition{
-					Type:    string(operatorv1alpha1.ConditionTypeReferenceValid),
-					Status:  metav1.ConditionFalse,
-					Reason:  string(operatorv1alpha1.ConditionReasonReferenceNotFound),
-					Message: err.Error(),
-				})
-				return conditions, err
-			}
+		bundle, err := utils.FetchCABundle(ctx, client, req.Namespace, frontProxy.Spec.CABundleSecretRef, frontProxy.Spec.CABundleConfigMapRef)
+		if err != nil {
+			err = fmt.Errorf("failed to get CA bundle: %w", err)
+			conditions = append(conditions, metav1.Condition{
+				Type:    string(operatorv1alpha1.ConditionTypeReferenceValid),
+				Status:  metav1.ConditionFalse,
+				Reason:  string(operatorv1alpha1.ConditionReasonReferenceNotFound),
+				Message: err.Error(),
+			})
+			return conditions, err
 		}
+		caBundle = bundle
 
 	default:
 		err := errors.New("no valid target for kubeconfig found")
@@ -272,7 +295,9 @@ func (r *KubeconfigReconciler) reconcile(ctx context.Context, client ctrlruntime
 		kubeconfig.ClientCertificateReconciler(kc, clientCertIssuer),
 	}
 
-	if err := reconciling.ReconcileCertificates(ctx, certReconcilers, req.Namespace, client); err != nil {
+	if err := tracing.Trace(ctx, "ReconcileCertificates", func(ctx context.Context) error {
+		return r.PKI.ReconcileCertificates(ctx, certReconcilers, req.Namespace, client)
+	}); err != nil {
 		return conditions, err
 	}
 
@@ -314,6 +339,18 @@ func (r *KubeconfigReconciler) reconcile(ctx context.Context, client ctrlruntime
 		return conditions, nil
 	}
 
+	// While the CAs are rotated, clients have to accept server certificates from both the
+	// previous and the current server CA.
+	if resources.RootShardTrustsPreviousCAs(rootShard) {
+		serverCASecret = &corev1.Secret{}
+		key := types.NamespacedName{Namespace: req.Namespace, Name: resources.GetRootShardTrustBundleName(rootShard.Name, operatorv1alpha1.ServerCA)}
+		if err := client.Get(ctx, key, serverCASecret); err != nil {
+			return conditions, fmt.Errorf("failed to get server CA trust bundle: %w", err)
+		}
+	}
+
+	recorder.Normal(kc, util.EventReasonCertificatesIssued, util.EventActionIssueCertificates, "Client certificate has been issued.")
+
 	reconciler, err := kubeconfig.KubeconfigSecretReconciler(kc, rootShard, shard, frontProxy, serverCASecret, clientCertSecret, caBundle)
 	if err != nil {
 		return conditions, err
@@ -333,10 +370,11 @@ func (r *KubeconfigReconciler) reconcile(ctx context.Context, client ctrlruntime
 	return conditions, nil
 }
 
-func (r *KubeconfigReconciler) reconcileStatus(ctx context.Context, client ctrlruntimeclient.Client, oldKc *operatorv1alpha1.Kubeconfig, kc *operatorv1alpha1.Kubeconfig, conditions []metav1.Condition) error {
+func (r *KubeconfigReconciler) reconcileStatus(ctx context.Context, client ctrlruntimeclient.Client, recorder *util.Recorder, oldKc *operatorv1alpha1.Kubeconfig, kc *operatorv1alpha1.Kubeconfig, conditions []metav1.Condition) error {
 	var errs []error
 
 	for _, condition := range conditions {
+		recorder.ReferenceMissing(kc, condition)
 		condition.ObservedGeneration = kc.Generation
 		kc.Status.Conditions = util.UpdateCondition(kc.Status.Conditions, condition)
 	}
@@ -362,8 +400,8 @@ func (r *KubeconfigReconciler) reconcileStatus(ctx context.Context, client ctrlr
 func (r *KubeconfigReconciler) getCertificateSecret(ctx context.Context, client ctrlruntimeclient.Client, name, namespace string) (*corev1.Secret, error) {
 	logger := log.FromContext(ctx).WithValues("certificate", name)
 
-	certificate := &certmanagerv1.Certificate{}
-	if err := client.Get(ctx, types.NamespacedName{Name: name, Namespace: namespace}, certificate); err != nil {
+	certificate, err := r.PKI.GetCertificate(ctx, client, types.NamespacedName{Name: name, Namespace: namespace})
+	if err != nil {
 		// Because of how the reconciling framework works, this should never happen.
 		logger.V(6).Info("Certificate does not exist yet, trying later ...")
 		return nil, nil
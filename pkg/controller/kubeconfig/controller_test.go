@@ -31,6 +31,7 @@ import (
 	mcreconcile "sigs.k8s.io/multicluster-runtime/pkg/reconcile"
 
 	"github.com/kcp-dev/kcp-operator/pkg/controller/util"
+	"github.com/kcp-dev/kcp-operator/pkg/pki"
 	operatorv1alpha1 "github.com/kcp-dev/kcp-operator/sdk/apis/operator/v1alpha1"
 )
 
@@ -97,6 +98,7 @@ func TestReconciling(t *testing.T) {
 
 			controllerReconciler := &KubeconfigReconciler{
 				GetCluster: util.FakeSingleCluster(client),
+				PKI:        pki.CertManager{},
 			}
 
 			_, err := controllerReconciler.Reconcile(ctx, mcreconcile.Request{
@@ -0,0 +1,367 @@
+/*
+Copyright 2026 The kcp Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package rootshard
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	apiutil "github.com/cert-manager/cert-manager/pkg/api/util"
+	certmanagerv1 "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
+	certmanagermetav1 "github.com/cert-manager/cert-manager/pkg/apis/meta/v1"
+	k8creconciling "k8c.io/reconciler/pkg/reconciling"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/ptr"
+	ctrlruntimeclient "sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/kcp-dev/kcp-operator/internal/resources"
+	"github.com/kcp-dev/kcp-operator/internal/resources/rootshard"
+	"github.com/kcp-dev/kcp-operator/pkg/controller/util"
+	deployv1alpha1 "github.com/kcp-dev/kcp-operator/sdk/apis/deploy/v1alpha1"
+	operatorv1alpha1 "github.com/kcp-dev/kcp-operator/sdk/apis/operator/v1alpha1"
+)
+
+// caRotationResyncInterval is how often a RootShard is reconciled while its CAs are rotated,
+// as not every object a rotation waits for is watched.
+const caRotationResyncInterval = 30 * time.Second
+
+// intermediateCAs are the rotated CAs that issue leaf certificates.
+var intermediateCAs = []operatorv1alpha1.CA{
+	operatorv1alpha1.ServerCA,
+	operatorv1alpha1.RequestHeaderClientCA,
+	operatorv1alpha1.ClientCA,
+	operatorv1alpha1.ServiceAccountCA,
+}
+
+// caRotationActive returns true if a CA rotation has been started and not completed yet.
+func caRotationActive(status *operatorv1alpha1.CARotationStatus) bool {
+	return status != nil && status.Phase != operatorv1alpha1.CARotationPhaseCompleted
+}
+
+// reconcileCARotation advances a CA rotation requested via the RotateCAsAnnotation and returns
+// the new rotation status. A rotation replaces the CAs without interrupting any component:
+//
+//  1. PublishingTrust: every component trusts both the current and the previous CAs.
+//  2. IssuingCAs: the root CA (if it is issued by the operator) and the intermediate CAs are
+//     issued again.
+//  3. ReissuingCertificates: every certificate signed by an intermediate CA is issued again and
+//     rolled out.
+//  4. RemovingTrust: every component trusts only the new CAs.
+//
+// Each phase only starts once the previous one has been rolled out to all components.
+func (r *RootShardReconciler) reconcileCARotation(ctx context.Context, client ctrlruntimeclient.Client, recorder *util.Recorder, rootShard *operatorv1alpha1.RootShard) (*operatorv1alpha1.CARotationStatus, error) {
+	status := rootShard.Status.CARotation.DeepCopy()
+
+	if rootShard.DeletionTimestamp != nil {
+		return status, nil
+	}
+
+	if !caRotationActive(status) {
+		request := rootShard.Annotations[operatorv1alpha1.RotateCAsAnnotation]
+		if request == "" || (status != nil && status.Request == request) {
+			return status, nil
+		}
+
+		status = &operatorv1alpha1.CARotationStatus{
+			Request:   request,
+			Phase:     operatorv1alpha1.CARotationPhasePublishingTrust,
+			StartTime: metav1.Now(),
+		}
+		recorder.Normal(rootShard, util.EventReasonCARotation, util.EventActionRotateCAs, "Started rotating CAs for request %q.", request)
+	}
+
+	// The trust bundles are reconciled before any component is told to mount them.
+	if err := k8creconciling.ReconcileSecrets(ctx, []k8creconciling.NamedSecretReconcilerFactory{
+		rootshard.PreviousCAsSecretReconciler(ctx, rootShard, client),
+	}, rootShard.Namespace, client, caRotationOwnerRef(rootShard)); err != nil {
+		return status, err
+	}
+
+	trustBundleReconcilers := make([]k8creconciling.NamedSecretReconcilerFactory, 0, len(resources.RotatedCAs))
+	for _, ca := range resources.RotatedCAs {
+		trustBundleReconcilers = append(trustBundleReconcilers, rootshard.CATrustBundleSecretReconciler(ctx, rootShard, ca, client))
+	}
+
+	if err := k8creconciling.ReconcileSecrets(ctx, trustBundleReconcilers, rootShard.Namespace, client, caRotationOwnerRef(rootShard)); err != nil {
+		return status, err
+	}
+
+	var (
+		message string
+		err     error
+	)
+
+	phase := status.Phase
+	switch phase {
+	case operatorv1alpha1.CARotationPhasePublishingTrust:
+		message, err = r.waitForTrustBundles(ctx, client, rootShard, true)
+		if err == nil && message == "" {
+			status.Phase = operatorv1alpha1.CARotationPhaseIssuingCAs
+		}
+
+	case operatorv1alpha1.CARotationPhaseIssuingCAs:
+		message, err = r.issueCAs(ctx, client, rootShard, status.StartTime.Time)
+		if err == nil && message == "" {
+			status.Phase = operatorv1alpha1.CARotationPhaseReissuingCertificates
+		}
+
+	case operatorv1alpha1.CARotationPhaseReissuingCertificates:
+		message, err = r.reissueCertificates(ctx, client, rootShard)
+		if err == nil && message == "" {
+			status.Phase = operatorv1alpha1.CARotationPhaseRemovingTrust
+		}
+
+	case operatorv1alpha1.CARotationPhaseRemovingTrust:
+		message, err = r.waitForTrustBundles(ctx, client, rootShard, false)
+		if err == nil && message == "" {
+			if err = deleteTrustBundles(ctx, client, rootShard); err == nil {
+				status.Phase = operatorv1alpha1.CARotationPhaseCompleted
+				status.CompletionTime = ptr.To(metav1.Now())
+			}
+		}
+
+	default:
+		return status, fmt.Errorf("unknown CA rotation phase %q", phase)
+	}
+
+	if err != nil {
+		return status, err
+	}
+
+	status.Message = message
+	if status.Phase != phase {
+		recorder.Normal(rootShard, util.EventReasonCARotation, util.EventActionRotateCAs, "CA rotation moved from phase %s to %s.", phase, status.Phase)
+	}
+
+	return status, nil
+}
+
+func caRotationOwnerRef(rootShard *operatorv1alpha1.RootShard) k8creconciling.ObjectModifier {
+	return k8creconciling.OwnerRefWrapper(*metav1.NewControllerRef(rootShard, operatorv1alpha1.SchemeGroupVersion.WithKind("RootShard")))
+}
+
+// waitForTrustBundles returns a message naming the first component that has not rolled out the
+// given trust bundle setting yet, or an empty string if all have. Components that have never
+// been compiled do not run yet and will pick up the setting once they are.
+func (r *RootShardReconciler) waitForTrustBundles(ctx context.Context, client ctrlruntimeclient.Client, rootShard *operatorv1alpha1.RootShard, trustBundles bool) (string, error) {
+	plan, err := util.GetUpgradePlan(ctx, client, rootShard)
+	if err != nil {
+		return "", fmt.Errorf("failed to determine components: %w", err)
+	}
+
+	for _, component := range caRotationComponents(plan) {
+		if compiledTrustBundles(component.Object) != trustBundles || !component.Available {
+			if trustBundles {
+				return fmt.Sprintf("Waiting for %s %s to trust the previous CAs.", component.Kind, component.Name), nil
+			}
+			return fmt.Sprintf("Waiting for %s %s to stop trusting the previous CAs.", component.Kind, component.Name), nil
+		}
+	}
+
+	return "", nil
+}
+
+// issueCAs issues the root CA and then the intermediate CAs again, unless they have been issued
+// since the rotation started.
+func (r *RootShardReconciler) issueCAs(ctx context.Context, client ctrlruntimeclient.Client, rootShard *operatorv1alpha1.RootShard, startTime time.Time) (string, error) {
+	threshold := startTime
+
+	// A root CA provided via caSecretRef is not issued by the operator and cannot be rotated.
+	if rootShard.Spec.Certificates.IssuerRef != nil {
+		cert, message, err := r.reissueCertificate(ctx, client, rootShard, operatorv1alpha1.RootCA, threshold)
+		if err != nil || message != "" {
+			return message, err
+		}
+
+		// Intermediate CAs must be signed by the new root CA.
+		if cert.Status.NotBefore.After(threshold) {
+			threshold = cert.Status.NotBefore.Time
+		}
+	}
+
+	for _, ca := range intermediateCAs {
+		if _, message, err := r.reissueCertificate(ctx, client, rootShard, ca, threshold); err != nil || message != "" {
+			return message, err
+		}
+	}
+
+	return "", nil
+}
+
+// reissueCertificate issues the certificate of a CA again if it was issued before the threshold.
+// It returns a message as long as the new certificate is not ready.
+func (r *RootShardReconciler) reissueCertificate(ctx context.Context, client ctrlruntimeclient.Client, rootShard *operatorv1alpha1.RootShard, ca operatorv1alpha1.CA, threshold time.Time) (*certmanagerv1.Certificate, string, error) {
+	name := resources.GetRootShardCAName(rootShard, ca)
+
+	cert, err := r.PKI.GetCertificate(ctx, client, types.NamespacedName{Namespace: rootShard.Namespace, Name: name})
+	if err != nil {
+		return nil, "", fmt.Errorf("failed to get certificate %s: %w", name, err)
+	}
+
+	if issuedBefore(cert, threshold) {
+		if err := r.PKI.Reissue(ctx, client, cert); err != nil {
+			return nil, "", fmt.Errorf("failed to reissue certificate %s: %w", name, err)
+		}
+
+		return nil, fmt.Sprintf("Waiting for the %s CA to be issued again.", ca), nil
+	}
+
+	if !certificateReady(cert) {
+		return nil, fmt.Sprintf("Waiting for the %s CA to become ready.", ca), nil
+	}
+
+	return cert, "", nil
+}
+
+// reissueCertificates issues every certificate signed by an intermediate CA again, unless it
+// has already been signed by the new CA, and waits for all components to roll them out.
+func (r *RootShardReconciler) reissueCertificates(ctx context.Context, client ctrlruntimeclient.Client, rootShard *operatorv1alpha1.RootShard) (string, error) {
+	// Issuers are named after their CA.
+	caIssued := make(map[string]time.Time, len(intermediateCAs))
+	for _, ca := range intermediateCAs {
+		name := resources.GetRootShardCAName(rootShard, ca)
+
+		cert, err := r.PKI.GetCertificate(ctx, client, types.NamespacedName{Namespace: rootShard.Namespace, Name: name})
+		if err != nil {
+			return "", fmt.Errorf("failed to get certificate %s: %w", name, err)
+		}
+		if cert.Status.NotBefore == nil {
+			return fmt.Sprintf("Waiting for the %s CA to become ready.", ca), nil
+		}
+
+		caIssued[name] = cert.Status.NotBefore.Time
+	}
+
+	certs, err := r.PKI.ListCertificates(ctx, client, ctrlruntimeclient.InNamespace(rootShard.Namespace))
+	if err != nil {
+		return "", fmt.Errorf("failed to list certificates: %w", err)
+	}
+
+	revisions := map[string]string{}
+	pending := 0
+
+	for i := range certs {
+		cert := &certs[i]
+
+		issued, ok := caIssued[cert.Spec.IssuerRef.Name]
+		if !ok || (cert.Spec.IssuerRef.Kind != "" && cert.Spec.IssuerRef.Kind != certmanagerv1.IssuerKind) {
+			continue
+		}
+
+		switch {
+		case issuedBefore(cert, issued):
+			if err := r.PKI.Reissue(ctx, client, cert); err != nil {
+				return "", fmt.Errorf("failed to reissue certificate %s: %w", cert.Name, err)
+			}
+			pending++
+
+		case !certificateReady(cert):
+			pending++
+
+		default:
+			revisions[util.CertificateRevisionAnnotation(cert.Name)] = strconv.Itoa(ptr.Deref(cert.Status.Revision, 0))
+		}
+	}
+
+	if pending > 0 {
+		return fmt.Sprintf("Waiting for %d certificates to be issued again.", pending), nil
+	}
+
+	plan, err := util.GetUpgradePlan(ctx, client, rootShard)
+	if err != nil {
+		return "", fmt.Errorf("failed to determine components: %w", err)
+	}
+
+	// Compiled objects carry the revisions of the certificates they mount, which tells whether
+	// they have been published with the new certificates.
+	for _, component := range caRotationComponents(plan) {
+		rolledOut := component.Available
+		for key, value := range component.Object.GetAnnotations() {
+			if revision, ok := revisions[key]; ok && revision != value {
+				rolledOut = false
+			}
+		}
+
+		if !rolledOut {
+			return fmt.Sprintf("Waiting for %s %s to roll out the new certificates.", component.Kind, component.Name), nil
+		}
+	}
+
+	return "", nil
+}
+
+func deleteTrustBundles(ctx context.Context, client ctrlruntimeclient.Client, rootShard *operatorv1alpha1.RootShard) error {
+	names := []string{resources.GetRootShardPreviousCAsName(rootShard)}
+	for _, ca := range resources.RotatedCAs {
+		names = append(names, resources.GetRootShardTrustBundleName(rootShard.Name, ca))
+	}
+
+	for _, name := range names {
+		secret := &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Namespace: rootShard.Namespace, Name: name}}
+		if err := client.Delete(ctx, secret); ctrlruntimeclient.IgnoreNotFound(err) != nil {
+			return fmt.Errorf("failed to delete Secret %s: %w", name, err)
+		}
+	}
+
+	return nil
+}
+
+// caRotationComponents returns the compiled components that trust the root shard's CAs. The
+// cache server has a CA of its own.
+func caRotationComponents(plan *util.UpgradePlan) []util.UpgradeComponent {
+	var components []util.UpgradeComponent
+	for _, component := range plan.Components() {
+		if component.Compiled && component.Kind != util.UpgradeKindCacheServer {
+			components = append(components, component)
+		}
+	}
+
+	return components
+}
+
+func compiledTrustBundles(obj ctrlruntimeclient.Object) bool {
+	switch compiled := obj.(type) {
+	case *deployv1alpha1.CompiledRootShard:
+		return compiled.Spec.TrustBundles
+	case *deployv1alpha1.CompiledShard:
+		return compiled.Spec.RootShard.TrustBundles
+	case *deployv1alpha1.CompiledVirtualWorkspace:
+		return compiled.Spec.RootShard.TrustBundles
+	case *deployv1alpha1.CompiledFrontProxy:
+		return compiled.Spec.RootShard.TrustBundles
+	default:
+		return false
+	}
+}
+
+// issuedBefore returns true if the certificate has been issued before the threshold. Certificates
+// that have not been issued at all are still on their way and not reported.
+func issuedBefore(cert *certmanagerv1.Certificate, threshold time.Time) bool {
+	return cert.Status.NotBefore != nil && cert.Status.NotBefore.Before(&metav1.Time{Time: threshold})
+}
+
+func certificateReady(cert *certmanagerv1.Certificate) bool {
+	return apiutil.CertificateHasCondition(cert, certmanagerv1.CertificateCondition{
+		Type:   certmanagerv1.CertificateConditionReady,
+		Status: certmanagermetav1.ConditionTrue,
+	})
+}
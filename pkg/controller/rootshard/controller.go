@@ -19,6 +19,7 @@ package rootshard
 import (
 	"context"
 	"fmt"
+	"slices"
 	"time"
 
 	certmanagerv1 "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
@@ -26,6 +27,7 @@ import (
 
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/equality"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	apimeta "k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
@@ -41,12 +43,16 @@ import (
 	"sigs.k8s.io/multicluster-runtime/pkg/multicluster"
 	mcreconcile "sigs.k8s.io/multicluster-runtime/pkg/reconcile"
 
+	"github.com/kcp-dev/kcp-operator/internal/resources"
 	"github.com/kcp-dev/kcp-operator/internal/resources/frontproxy"
 	"github.com/kcp-dev/kcp-operator/internal/resources/rootshard"
+	"github.com/kcp-dev/kcp-operator/internal/resources/utils"
 	"github.com/kcp-dev/kcp-operator/pkg/controller/util"
 	"github.com/kcp-dev/kcp-operator/pkg/metrics"
+	"github.com/kcp-dev/kcp-operator/pkg/pki"
 	"github.com/kcp-dev/kcp-operator/pkg/reconciling"
 	"github.com/kcp-dev/kcp-operator/pkg/reconciling/modifier"
+	"github.com/kcp-dev/kcp-operator/pkg/tracing"
 	deployv1alpha1 "github.com/kcp-dev/kcp-operator/sdk/apis/deploy/v1alpha1"
 	operatorv1alpha1 "github.com/kcp-dev/kcp-operator/sdk/apis/operator/v1alpha1"
 )
@@ -54,6 +60,8 @@ import (
 // RootShardReconciler reconciles a RootShard object
 type RootShardReconciler struct {
 	GetCluster func(ctx context.Context, clusterName multicluster.ClusterName) (cluster.Cluster, error)
+	Events     *util.EventRecorder
+	PKI        pki.Backend
 }
 
 // SetupWithManager sets up the controller with the Manager.
@@ -84,7 +92,42 @@ func (r *RootShardReconciler) SetupWithManager(mgr mcmanager.Manager, opts ...mc
 
 		var requests []reconcile.Request
 		for _, rs := range rootShards.Items {
-			if rs.Spec.KCPVirtualWorkspace != nil && rs.Spec.KCPVirtualWorkspace.Name == vw.Name {
+			target := vw.Spec.Target
+
+			switch {
+			case rs.Spec.KCPVirtualWorkspace != nil && rs.Spec.KCPVirtualWorkspace.Name == vw.Name,
+				target.RootShardRef != nil && target.RootShardRef.Name == rs.Name,
+				target.ShardRef != nil && slices.ContainsFunc(rs.Status.Shards, func(s operatorv1alpha1.ShardReference) bool { return s.Name == target.ShardRef.Name }):
+				requests = append(requests, reconcile.Request{NamespacedName: ctrlruntimeclient.ObjectKeyFromObject(&rs)})
+			}
+		}
+
+		return requests
+	})
+
+	// FrontProxies and CacheServers are part of the upgrade status.
+	frontProxyHandler := util.EnqueueMapped(func(ctx context.Context, client ctrlruntimeclient.Client, obj ctrlruntimeclient.Object) []reconcile.Request {
+		frontProxy := obj.(*operatorv1alpha1.FrontProxy)
+
+		if ref := frontProxy.Spec.RootShard.Reference; ref != nil {
+			return []reconcile.Request{{NamespacedName: types.NamespacedName{Namespace: frontProxy.Namespace, Name: ref.Name}}}
+		}
+
+		return nil
+	})
+
+	cacheServerHandler := util.EnqueueMapped(func(ctx context.Context, client ctrlruntimeclient.Client, obj ctrlruntimeclient.Object) []reconcile.Request {
+		server := obj.(*operatorv1alpha1.CacheServer)
+
+		var rootShards operatorv1alpha1.RootShardList
+		if err := client.List(ctx, &rootShards, ctrlruntimeclient.InNamespace(server.Namespace)); err != nil {
+			utilruntime.HandleError(err)
+			return nil
+		}
+
+		var requests []reconcile.Request
+		for _, rs := range rootShards.Items {
+			if ref := rs.Spec.Cache.Reference; ref != nil && ref.Name == server.Name {
 				requests = append(requests, reconcile.Request{NamespacedName: ctrlruntimeclient.ObjectKeyFromObject(&rs)})
 			}
 		}
@@ -97,10 +140,12 @@ func (r *RootShardReconciler) SetupWithManager(mgr mcmanager.Manager, opts ...mc
 		For(&operatorv1alpha1.RootShard{}, util.EngageFor(opts)...).
 		Owns(&deployv1alpha1.CompiledRootShard{}, util.EngageOwns(opts)...).
 		Owns(&corev1.Secret{}, util.EngageOwns(opts)...).
-		Owns(&certmanagerv1.Certificate{}, util.EngageOwns(opts)...).
+		Owns(r.PKI.CertificateType(), util.EngageOwns(opts)...).
 		Watches(&operatorv1alpha1.Shard{}, shardHandler, util.EngageWatches(opts)...).
 		Watches(&operatorv1alpha1.VirtualWorkspace{}, vwHandler, util.EngageWatches(opts)...).
-		Complete(r)
+		Watches(&operatorv1alpha1.FrontProxy{}, frontProxyHandler, util.EngageWatches(opts)...).
+		Watches(&operatorv1alpha1.CacheServer{}, cacheServerHandler, util.EngageWatches(opts)...).
+		Complete(tracing.Reconciler("rootshard", r))
 }
 
 // +kubebuilder:rbac:groups=operator.kcp.io,resources=rootshards,verbs=get;list;watch;update;patch
@@ -148,17 +193,72 @@ func (r *RootShardReconciler) Reconcile(ctx context.Context, req mcreconcile.Req
 		return ctrl.Result{}, nil
 	}
 
-	conditions, recErr := r.reconcile(ctx, cl.GetClient(), &rootShard)
+	recorder := r.Events.For(req.ClusterName, cl)
+
+	var (
+		conditions []metav1.Condition
+		certs      []*certmanagerv1.Certificate
+	)
+	// The trust-manager Bundle is cleaned up even while the RootShard is paused, as its deletion
+	// would never finish otherwise.
+	if rootShard.DeletionTimestamp != nil || rootShard.Spec.TrustBundle == nil {
+		removed, err := r.cleanupTrustBundle(ctx, cl.GetClient(), &rootShard)
+		if err != nil {
+			err = fmt.Errorf("failed to clean up trust-manager Bundle: %w", err)
+			recorder.ReconcileFailed(&rootShard, err)
+			return ctrl.Result{}, err
+		}
+
+		// Without its last finalizer, the object is gone by now.
+		if removed && rootShard.DeletionTimestamp != nil {
+			return ctrl.Result{}, nil
+		}
+	}
+
+	caRotation := rootShard.Status.CARotation
+	if util.IsPaused(&rootShard) {
+		logger.V(4).Info("Skipping reconciliation because the object is paused")
+		recErr = util.PauseCompiled(ctx, cl.GetClient(), req.NamespacedName, &deployv1alpha1.CompiledRootShard{})
+	} else {
+		metrics.RecordGeneration(&rootShard)
+		conditions, recErr = r.reconcile(ctx, cl.GetClient(), recorder, &rootShard, &certs)
+
+		// A failed step is retried from the status it started from.
+		if status, err := r.reconcileCARotation(ctx, cl.GetClient(), recorder, &rootShard); err != nil {
+			recErr = kerrors.NewAggregate([]error{recErr, fmt.Errorf("failed to rotate CAs: %w", err)})
+		} else {
+			caRotation = status
+		}
+
+		if err := r.reconcileTrustBundle(ctx, cl.GetClient(), &rootShard); err != nil {
+			recErr = kerrors.NewAggregate([]error{recErr, fmt.Errorf("failed to reconcile trust-manager Bundle: %w", err)})
+		}
+	}
+
+	certsCond, requeueAfter, err := util.GetCertificatesReadyCondition(ctx, cl.GetClient(), r.PKI, &rootShard)
+	if err != nil {
+		recErr = kerrors.NewAggregate([]error{recErr, err})
+	} else {
+		conditions = append(conditions, certsCond)
+	}
 
-	if err := r.reconcileStatus(ctx, cl.GetClient(), &rootShard, conditions); err != nil {
+	if err := r.reconcileStatus(ctx, cl.GetClient(), recorder, &rootShard, conditions, certs, caRotation); err != nil {
 		recErr = kerrors.NewAggregate([]error{recErr, err})
 	}
 
-	return ctrl.Result{}, recErr
+	recorder.ReconcileFailed(&rootShard, recErr)
+
+	requeueAfter = util.MinRequeueAfter(requeueAfter, util.CertificateRotationRequeueAfter(&rootShard))
+
+	if caRotationActive(caRotation) && (requeueAfter == 0 || requeueAfter > caRotationResyncInterval) {
+		requeueAfter = caRotationResyncInterval
+	}
+
+	return ctrl.Result{RequeueAfter: requeueAfter}, recErr
 }
 
 //nolint:unparam // Keep the controller working the same as all the others, even though currently it does always return nil conditions.
-func (r *RootShardReconciler) reconcile(ctx context.Context, client ctrlruntimeclient.Client, rootShard *operatorv1alpha1.RootShard) ([]metav1.Condition, error) {
+func (r *RootShardReconciler) reconcile(ctx context.Context, client ctrlruntimeclient.Client, recorder *util.Recorder, rootShard *operatorv1alpha1.RootShard, certs *[]*certmanagerv1.Certificate) ([]metav1.Condition, error) {
 	var (
 		errs       []error
 		conditions []metav1.Condition
@@ -170,6 +270,11 @@ func (r *RootShardReconciler) reconcile(ctx context.Context, client ctrlruntimec
 
 	ownerRefWrapper := k8creconciling.OwnerRefWrapper(*metav1.NewControllerRef(rootShard, operatorv1alpha1.SchemeGroupVersion.WithKind("RootShard")))
 
+	shards, shardsErr := util.GetRootShardChildren(ctx, client, rootShard)
+	if shardsErr != nil {
+		errs = append(errs, fmt.Errorf("failed to list shards: %w", shardsErr))
+	}
+
 	issuerReconcilers := []reconciling.NamedIssuerReconcilerFactory{
 		rootshard.RootCAIssuerReconciler(rootShard),
 	}
@@ -192,6 +297,18 @@ func (r *RootShardReconciler) reconcile(ctx context.Context, client ctrlruntimec
 		operatorv1alpha1.ServiceAccountCA,
 	}
 
+	// The etcd CA is only needed once the root shard or one of its shards uses an operator-managed etcd.
+	if resources.UsesManagedEtcd(rootShard, shards) {
+		intermediateCAs = append(intermediateCAs, operatorv1alpha1.EtcdCA)
+	}
+
+	if rootShard.Spec.Etcd.Managed != nil {
+		certReconcilers = append(certReconcilers,
+			rootshard.EtcdServerCertificateReconciler(rootShard),
+			rootshard.EtcdClientCertificateReconciler(rootShard),
+		)
+	}
+
 	for _, ca := range intermediateCAs {
 		certReconcilers = append(certReconcilers, rootshard.CACertificateReconciler(rootShard, ca))
 		issuerReconcilers = append(issuerReconcilers, rootshard.CAIssuerReconciler(rootShard, ca))
@@ -200,16 +317,17 @@ func (r *RootShardReconciler) reconcile(ctx context.Context, client ctrlruntimec
 		certReconcilers = append(certReconcilers, rootshard.RootCACertificateReconciler(rootShard))
 	}
 
-	var certs []*certmanagerv1.Certificate
-	if err := reconciling.ReconcileCertificates(ctx, certReconcilers, rootShard.Namespace, client, ownerRefWrapper, modifier.Capture(&certs)); err != nil {
+	if err := tracing.Trace(ctx, "ReconcileCertificates", func(ctx context.Context) error {
+		return r.PKI.ReconcileCertificates(ctx, certReconcilers, rootShard.Namespace, client, ownerRefWrapper, modifier.Capture(certs))
+	}); err != nil {
 		errs = append(errs, err)
 	}
 
-	if err := reconciling.ReconcileIssuers(ctx, issuerReconcilers, rootShard.Namespace, client, ownerRefWrapper); err != nil {
+	if err := r.PKI.ReconcileIssuers(ctx, issuerReconcilers, rootShard.Namespace, client, ownerRefWrapper); err != nil {
 		errs = append(errs, err)
 	}
 
-	if rootShard.Spec.CABundleSecretRef != nil {
+	if utils.HasCABundle(rootShard.Spec.CABundleSecretRef, rootShard.Spec.CABundleConfigMapRef) {
 		if err := k8creconciling.ReconcileSecrets(ctx, []k8creconciling.NamedSecretReconcilerFactory{
 			rootshard.MergedCABundleSecretReconciler(ctx, rootShard, client),
 		}, rootShard.Namespace, client, ownerRefWrapper); err != nil {
@@ -242,30 +360,48 @@ func (r *RootShardReconciler) reconcile(ctx context.Context, client ctrlruntimec
 		kcpVW = &operatorv1alpha1.VirtualWorkspace{}
 		key := types.NamespacedName{Namespace: rootShard.Namespace, Name: rootShard.Spec.KCPVirtualWorkspace.Name}
 		if err := client.Get(ctx, key, kcpVW); err != nil {
+			if apierrors.IsNotFound(err) {
+				recorder.Warning(rootShard, util.EventReasonReferenceMissing, util.EventActionResolveReferences, "VirtualWorkspace %s does not exist.", key.Name)
+			}
+
 			errs = append(errs, fmt.Errorf("failed to find associated VirtualWorkspace %s: %w", key.Name, err))
 			vwConfigValid = false
 		}
 	}
 
-	shards, shardsErr := util.GetRootShardChildren(ctx, client, rootShard)
-	if shardsErr != nil {
-		errs = append(errs, fmt.Errorf("failed to list shards: %w", shardsErr))
+	if err := frontproxy.NewRootShardProxy(rootShard).Reconcile(ctx, client, r.PKI, rootShard.Namespace, modifier.Capture(certs)); err != nil {
+		errs = append(errs, fmt.Errorf("failed to reconcile proxy: %w", err))
 	}
 
-	if err := frontproxy.NewRootShardProxy(rootShard).Reconcile(ctx, client, rootShard.Namespace, modifier.Capture(&certs)); err != nil {
-		errs = append(errs, fmt.Errorf("failed to reconcile proxy: %w", err))
+	if err := util.RotateCertificates(ctx, client, r.PKI, recorder, rootShard, rootShard.Status.CertificateRotation, *certs); err != nil {
+		errs = append(errs, err)
 	}
 
 	// Only publish the render input once every Certificate is ready, so that whoever consumes
 	// it can rely on the Secrets it mounts already existing.
-	revisions, certsReady := util.CertificateRevisions(certs)
+	revisions, certsReady := util.CertificateRevisions(*certs)
+	if certsReady {
+		recorder.CertificatesIssued(rootShard, len(*certs))
+	}
+
+	// New kcp versions are rolled out step by step across the installation, so the root shard
+	// and its proxy might have to stay on their current images for now.
+	plan, planErr := util.GetUpgradePlan(ctx, client, rootShard)
+	if planErr != nil {
+		errs = append(errs, fmt.Errorf("failed to determine upgrade plan: %w", planErr))
+	}
 
 	// The workloads themselves are rendered by the CompiledRootShard controller.
-	if vwConfigValid && shardsErr == nil && certsReady {
-		if err := reconciling.ReconcileCompiledRootShards(ctx, []reconciling.NamedCompiledRootShardReconcilerFactory{
-			rootshard.CompiledRootShardReconciler(rootShard, kcpVW, shards, util.MutateKeys(revisions, "cert-", "-revision")),
-		}, rootShard.Namespace, client, ownerRefWrapper); err != nil {
+	if vwConfigValid && shardsErr == nil && planErr == nil && certsReady {
+		var published bool
+		if err := tracing.Trace(ctx, "PublishCompiled", func(ctx context.Context) error {
+			return reconciling.ReconcileCompiledRootShards(ctx, []reconciling.NamedCompiledRootShardReconcilerFactory{
+				rootshard.CompiledRootShardReconciler(applyUpgradePlan(rootShard, plan), kcpVW, shards, util.MutateKeys(revisions, "cert-", "-revision")),
+			}, rootShard.Namespace, client, ownerRefWrapper, modifier.PublishedAt(time.Now(), &published), modifier.TraceParent(ctx))
+		}); err != nil {
 			errs = append(errs, err)
+		} else {
+			metrics.RecordPublished(metrics.RootShardResourceType, rootShard, published)
 		}
 	}
 
@@ -273,22 +409,50 @@ func (r *RootShardReconciler) reconcile(ctx context.Context, client ctrlruntimec
 }
 
 // reconcileStatus sets both phase and conditions on the reconciled RootShard object.
-func (r *RootShardReconciler) reconcileStatus(ctx context.Context, client ctrlruntimeclient.Client, oldRootShard *operatorv1alpha1.RootShard, conditions []metav1.Condition) error {
+func (r *RootShardReconciler) reconcileStatus(ctx context.Context, client ctrlruntimeclient.Client, recorder *util.Recorder, oldRootShard *operatorv1alpha1.RootShard, conditions []metav1.Condition, certs []*certmanagerv1.Certificate, caRotation *operatorv1alpha1.CARotationStatus) error {
 	rootShard := oldRootShard.DeepCopy()
+	rootShard.Status.CARotation = caRotation
 	var errs []error
 
+	// Certificates are only known if they have been reconciled.
+	if certs != nil {
+		rootShard.Status.Certificates = util.CertificateInventory(certs)
+	}
+
 	compiled := &deployv1alpha1.CompiledRootShard{}
 	key := types.NamespacedName{Namespace: rootShard.Namespace, Name: rootShard.Name}
 	if err := client.Get(ctx, key, compiled); ctrlruntimeclient.IgnoreNotFound(err) != nil {
 		errs = append(errs, err)
 	} else {
 		conditions = append(conditions, util.GetCompiledAvailableCondition(compiled.Status.Conditions, "CompiledRootShard "+rootShard.Name))
+		rootShard.Status.Version = util.AdoptVersionStatus(rootShard.Spec.Image, compiled.Status.Version)
+		rootShard.Status.Placement = compiled.Status.Placement
+
+		if rotation, cond := util.CertificateRotation(rootShard, rootShard.Status.CertificateRotation, certs, compiled, compiled.Status.Conditions); cond != nil {
+			rootShard.Status.CertificateRotation = rotation
+			cond.ObservedGeneration = rootShard.Generation
+			apimeta.SetStatusCondition(&rootShard.Status.Conditions, *cond)
+		}
+
+		recorder.CompiledPublished(rootShard, compiled, "CompiledRootShard")
+		recorder.DeploymentRolled(rootShard, oldRootShard.Status.Version, rootShard.Status.Version)
+
+		if cond := util.GetCompiledCondition(compiled.Status.Conditions, operatorv1alpha1.ConditionTypeHealthy); cond != nil {
+			conditions = append(conditions, *cond)
+		}
+
+		if cond := util.GetCompiledCondition(compiled.Status.Conditions, operatorv1alpha1.ConditionTypeEtcdHealthy); cond != nil && rootShard.Spec.Etcd.Managed != nil {
+			conditions = append(conditions, *cond)
+		} else {
+			apimeta.RemoveStatusCondition(&rootShard.Status.Conditions, string(operatorv1alpha1.ConditionTypeEtcdHealthy))
+		}
 	}
 
 	for _, condition := range conditions {
 		condition.ObservedGeneration = rootShard.Generation
 		rootShard.Status.Conditions = util.UpdateCondition(rootShard.Status.Conditions, condition)
 	}
+	rootShard.Status.Conditions = util.UpdatePausedCondition(rootShard, rootShard.Status.Conditions)
 
 	if rootShard.DeletionTimestamp != nil {
 		rootShard.Status.Phase = operatorv1alpha1.RootShardPhaseDeleting
@@ -314,6 +478,13 @@ func (r *RootShardReconciler) reconcileStatus(ctx context.Context, client ctrlru
 		}
 	}
 
+	plan, err := util.GetUpgradePlan(ctx, client, rootShard)
+	if err != nil {
+		errs = append(errs, err)
+	} else {
+		rootShard.Status.Upgrade = plan.Status()
+	}
+
 	// No reconciler reads Status.Shards, but this write is what wakes the Shard and FrontProxy controllers through their RootShard watches.
 	if !equality.Semantic.DeepEqual(oldRootShard.Status, rootShard.Status) {
 		if err := client.Status().Patch(ctx, rootShard, ctrlruntimeclient.MergeFrom(oldRootShard)); err != nil {
@@ -323,3 +494,22 @@ func (r *RootShardReconciler) reconcileStatus(ctx context.Context, client ctrlru
 
 	return kerrors.NewAggregate(errs)
 }
+
+// applyUpgradePlan returns a copy of the root shard that uses the images the upgrade plan
+// allows to roll out right now.
+func applyUpgradePlan(rootShard *operatorv1alpha1.RootShard, plan *util.UpgradePlan) *operatorv1alpha1.RootShard {
+	rootShard = rootShard.DeepCopy()
+
+	if decision := plan.Decide(util.UpgradeKindRootShard, rootShard.Name); decision.HeldBack() {
+		rootShard.Spec.Image = decision.Image
+	}
+
+	if decision := plan.Decide(util.UpgradeKindRootShardProxy, rootShard.Name); decision.HeldBack() {
+		if rootShard.Spec.Proxy == nil {
+			rootShard.Spec.Proxy = &operatorv1alpha1.RootShardProxySpec{}
+		}
+		rootShard.Spec.Proxy.Image = decision.Image
+	}
+
+	return rootShard
+}
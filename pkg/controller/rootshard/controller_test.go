@@ -20,16 +20,19 @@ import (
 	"context"
 	"testing"
 
+	certmanagerv1 "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
 	"github.com/stretchr/testify/require"
 
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
 	ctrlruntimeclient "sigs.k8s.io/controller-runtime/pkg/client"
 	ctrlruntimefakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 	mcreconcile "sigs.k8s.io/multicluster-runtime/pkg/reconcile"
 
 	"github.com/kcp-dev/kcp-operator/pkg/controller/util"
+	"github.com/kcp-dev/kcp-operator/pkg/pki"
 	operatorv1alpha1 "github.com/kcp-dev/kcp-operator/sdk/apis/operator/v1alpha1"
 )
 
@@ -113,6 +116,7 @@ func TestReconciling(t *testing.T) {
 
 			controllerReconciler := &RootShardReconciler{
 				GetCluster: util.FakeSingleCluster(client),
+				PKI:        pki.CertManager{},
 			}
 
 			_, err := controllerReconciler.Reconcile(ctx, mcreconcile.Request{
@@ -224,6 +228,7 @@ func TestClientCABundleMerging(t *testing.T) {
 
 			controllerReconciler := &RootShardReconciler{
 				GetCluster: util.FakeSingleCluster(client),
+				PKI:        pki.CertManager{},
 			}
 
 			_, err := controllerReconciler.Reconcile(ctx, mcreconcile.Request{
@@ -256,3 +261,112 @@ func TestClientCABundleMerging(t *testing.T) {
 		})
 	}
 }
+
+func TestManagedEtcdPKI(t *testing.T) {
+	const namespace = "rootshard-etcd-tests"
+
+	testcases := []struct {
+		name        string
+		rootEtcd    operatorv1alpha1.EtcdConfig
+		shardEtcd   operatorv1alpha1.EtcdConfig
+		expectCA    bool
+		expectCerts bool
+	}{
+		{
+			name:      "external etcd everywhere",
+			rootEtcd:  operatorv1alpha1.EtcdConfig{Endpoints: []string{"https://localhost:2379"}},
+			shardEtcd: operatorv1alpha1.EtcdConfig{Endpoints: []string{"https://localhost:2379"}},
+		},
+		{
+			name:        "managed etcd for the root shard",
+			rootEtcd:    operatorv1alpha1.EtcdConfig{Managed: &operatorv1alpha1.ManagedEtcdSpec{}},
+			shardEtcd:   operatorv1alpha1.EtcdConfig{Endpoints: []string{"https://localhost:2379"}},
+			expectCA:    true,
+			expectCerts: true,
+		},
+		{
+			name:      "managed etcd only for a child shard",
+			rootEtcd:  operatorv1alpha1.EtcdConfig{Endpoints: []string{"https://localhost:2379"}},
+			shardEtcd: operatorv1alpha1.EtcdConfig{Managed: &operatorv1alpha1.ManagedEtcdSpec{}},
+			expectCA:  true,
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			rootShard := &operatorv1alpha1.RootShard{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "rooty",
+					Namespace: namespace,
+				},
+				Spec: operatorv1alpha1.RootShardSpec{
+					External: operatorv1alpha1.ExternalConfig{
+						Hostname: "example.kcp.io",
+						Port:     6443,
+					},
+					CommonShardSpec: operatorv1alpha1.CommonShardSpec{
+						Etcd: tc.rootEtcd,
+					},
+				},
+			}
+
+			shard := &operatorv1alpha1.Shard{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "shardy",
+					Namespace: namespace,
+				},
+				Spec: operatorv1alpha1.ShardSpec{
+					RootShard: operatorv1alpha1.RootShardConfig{
+						Reference: &corev1.LocalObjectReference{Name: rootShard.Name},
+					},
+					CommonShardSpec: operatorv1alpha1.CommonShardSpec{
+						Etcd: tc.shardEtcd,
+					},
+				},
+			}
+
+			// The merged client CA reconciler fetches ClientCA.
+			clientCASecret := &corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      rootShard.Name + "-client-ca",
+					Namespace: namespace,
+				},
+				Data: map[string][]byte{
+					"tls.crt": []byte("client-ca-cert"),
+				},
+			}
+
+			client := ctrlruntimefakeclient.
+				NewClientBuilder().
+				WithScheme(util.GetTestScheme()).
+				WithStatusSubresource(rootShard).
+				WithObjects(rootShard, shard, clientCASecret).
+				Build()
+
+			ctx := context.Background()
+
+			controllerReconciler := &RootShardReconciler{
+				GetCluster: util.FakeSingleCluster(client),
+				PKI:        pki.CertManager{},
+			}
+
+			_, err := controllerReconciler.Reconcile(ctx, mcreconcile.Request{
+				Request: reconcile.Request{
+					NamespacedName: ctrlruntimeclient.ObjectKeyFromObject(rootShard),
+				},
+			})
+			require.NoError(t, err)
+
+			exists := func(obj ctrlruntimeclient.Object, name string) bool {
+				err := client.Get(ctx, types.NamespacedName{Namespace: namespace, Name: name}, obj)
+				require.NoError(t, ctrlruntimeclient.IgnoreNotFound(err))
+				return err == nil
+			}
+
+			require.Equal(t, tc.expectCA, exists(&certmanagerv1.Certificate{}, "rooty-etcd-ca"))
+			require.Equal(t, tc.expectCA, exists(&certmanagerv1.Issuer{}, "rooty-etcd-ca"))
+			require.Equal(t, tc.expectCerts, exists(&certmanagerv1.Certificate{}, "rooty-etcd-server"))
+			require.Equal(t, tc.expectCerts, exists(&certmanagerv1.Certificate{}, "rooty-etcd-client"))
+		})
+	}
+}
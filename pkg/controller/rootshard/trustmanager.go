@@ -0,0 +1,146 @@
+/*
+Copyright 2026 The kcp Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package rootshard
+
+import (
+	"context"
+	"fmt"
+
+	k8creconciling "k8c.io/reconciler/pkg/reconciling"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/sets"
+	ctrlruntimeclient "sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/kcp-dev/kcp-operator/internal/resources"
+	"github.com/kcp-dev/kcp-operator/internal/resources/rootshard"
+	"github.com/kcp-dev/kcp-operator/internal/resources/utils"
+	operatorv1alpha1 "github.com/kcp-dev/kcp-operator/sdk/apis/operator/v1alpha1"
+)
+
+// trustBundleFinalizer makes sure that the cluster-scoped trust-manager Bundle, which is not
+// garbage collected along with its RootShard, is deleted once it is no longer wanted.
+const trustBundleFinalizer = "operator.kcp.io/cleanup-trust-bundle"
+
+// +kubebuilder:rbac:groups=trust.cert-manager.io,resources=bundles,verbs=get;list;watch;create;update;patch;delete
+
+// cleanupTrustBundle removes all published Bundles and the finalizer once the RootShard is
+// deleted or no longer asks for a Bundle. It reports whether the finalizer has been removed.
+func (r *RootShardReconciler) cleanupTrustBundle(ctx context.Context, client ctrlruntimeclient.Client, rootShard *operatorv1alpha1.RootShard) (bool, error) {
+	if !sets.New(rootShard.Finalizers...).Has(trustBundleFinalizer) {
+		return false, nil
+	}
+
+	if err := deleteServerCABundles(ctx, client, rootShard, ""); err != nil {
+		return false, err
+	}
+
+	if err := updateTrustBundleFinalizer(ctx, client, rootShard, false); err != nil {
+		return false, err
+	}
+
+	return true, nil
+}
+
+// reconcileTrustBundle publishes the server CA as a trust-manager Bundle if the RootShard asks
+// for it. Removing it again is handled by cleanupTrustBundle.
+func (r *RootShardReconciler) reconcileTrustBundle(ctx context.Context, client ctrlruntimeclient.Client, rootShard *operatorv1alpha1.RootShard) error {
+	if rootShard.DeletionTimestamp != nil || rootShard.Spec.TrustBundle == nil {
+		return nil
+	}
+
+	if err := updateTrustBundleFinalizer(ctx, client, rootShard, true); err != nil {
+		return err
+	}
+
+	// The Bundle might have been renamed.
+	if err := deleteServerCABundles(ctx, client, rootShard, resources.GetRootShardServerCABundleName(rootShard)); err != nil {
+		return err
+	}
+
+	// During a CA rotation, the Bundle contains both the previous and the current server CA.
+	caSecret := &corev1.Secret{}
+	key := types.NamespacedName{Namespace: rootShard.Namespace, Name: resources.GetRootShardCATrustName(rootShard, operatorv1alpha1.ServerCA)}
+	if err := client.Get(ctx, key, caSecret); err != nil {
+		// The server CA has not been issued yet.
+		if apierrors.IsNotFound(err) {
+			return nil
+		}
+		return fmt.Errorf("failed to get server CA: %w", err)
+	}
+
+	userCABundle, err := utils.FetchCABundle(ctx, client, rootShard.Namespace, rootShard.Spec.CABundleSecretRef, rootShard.Spec.CABundleConfigMapRef)
+	if err != nil {
+		return fmt.Errorf("failed to get user CA bundle: %w", err)
+	}
+
+	caBundle := utils.MergeCertificates(caSecret.Data[corev1.TLSCertKey], userCABundle)
+
+	return k8creconciling.ReconcileUnstructureds(ctx, []k8creconciling.NamedUnstructuredReconcilerFactory{
+		rootshard.ServerCABundleReconciler(rootShard, caBundle),
+	}, "", client)
+}
+
+// deleteServerCABundles deletes all Bundles published for the RootShard, except for the one
+// with the given name.
+func deleteServerCABundles(ctx context.Context, client ctrlruntimeclient.Client, rootShard *operatorv1alpha1.RootShard, keep string) error {
+	bundles := &unstructured.UnstructuredList{}
+	bundles.SetAPIVersion(rootshard.TrustBundleAPIVersion)
+	bundles.SetKind(rootshard.TrustBundleKind + "List")
+
+	if err := client.List(ctx, bundles, ctrlruntimeclient.MatchingLabels(rootshard.TrustBundleLabels(rootShard))); err != nil {
+		// Without trust-manager, there cannot be any Bundles.
+		if meta.IsNoMatchError(err) {
+			return nil
+		}
+		return fmt.Errorf("failed to list trust-manager Bundles: %w", err)
+	}
+
+	for _, bundle := range bundles.Items {
+		if bundle.GetName() == keep {
+			continue
+		}
+
+		if err := client.Delete(ctx, &bundle); ctrlruntimeclient.IgnoreNotFound(err) != nil {
+			return fmt.Errorf("failed to delete trust-manager Bundle %s: %w", bundle.GetName(), err)
+		}
+	}
+
+	return nil
+}
+
+func updateTrustBundleFinalizer(ctx context.Context, client ctrlruntimeclient.Client, rootShard *operatorv1alpha1.RootShard, present bool) error {
+	finalizers := sets.New(rootShard.GetFinalizers()...)
+	if finalizers.Has(trustBundleFinalizer) == present {
+		return nil
+	}
+
+	original := rootShard.DeepCopy()
+	if present {
+		finalizers.Insert(trustBundleFinalizer)
+	} else {
+		finalizers.Delete(trustBundleFinalizer)
+	}
+	rootShard.SetFinalizers(sets.List(finalizers))
+
+	// The finalizers are patched as a whole, so concurrent changes by others must not be lost.
+	return client.Patch(ctx, rootShard, ctrlruntimeclient.MergeFromWithOptions(original, ctrlruntimeclient.MergeFromWithOptimisticLock{}))
+}
@@ -0,0 +1,212 @@
+/*
+Copyright 2026 The kcp Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package rootshard
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	ctrlruntimeclient "sigs.k8s.io/controller-runtime/pkg/client"
+	ctrlruntimefakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+	mcreconcile "sigs.k8s.io/multicluster-runtime/pkg/reconcile"
+
+	"github.com/kcp-dev/kcp-operator/internal/resources/rootshard"
+	"github.com/kcp-dev/kcp-operator/pkg/controller/util"
+	"github.com/kcp-dev/kcp-operator/pkg/pki"
+	operatorv1alpha1 "github.com/kcp-dev/kcp-operator/sdk/apis/operator/v1alpha1"
+)
+
+func newTrustBundleClient(objects ...ctrlruntimeclient.Object) ctrlruntimeclient.Client {
+	scheme := util.GetTestScheme()
+
+	restMapper := meta.NewDefaultRESTMapper(nil)
+	for gvk := range scheme.AllKnownTypes() {
+		restMapper.Add(gvk, meta.RESTScopeNamespace)
+	}
+	restMapper.Add(trustBundleGVK, meta.RESTScopeRoot)
+
+	return ctrlruntimefakeclient.
+		NewClientBuilder().
+		WithScheme(scheme).
+		WithRESTMapper(restMapper).
+		WithStatusSubresource(&operatorv1alpha1.RootShard{}).
+		WithObjects(objects...).
+		Build()
+}
+
+var trustBundleGVK = schema.FromAPIVersionAndKind(rootshard.TrustBundleAPIVersion, rootshard.TrustBundleKind)
+
+func getTrustBundle(client ctrlruntimeclient.Client, name string) (*unstructured.Unstructured, error) {
+	bundle := &unstructured.Unstructured{}
+	bundle.SetGroupVersionKind(trustBundleGVK)
+	return bundle, client.Get(context.Background(), types.NamespacedName{Name: name}, bundle)
+}
+
+func newTrustBundleRootShard(namespace string) *operatorv1alpha1.RootShard {
+	return &operatorv1alpha1.RootShard{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "rooty",
+			Namespace: namespace,
+		},
+		Spec: operatorv1alpha1.RootShardSpec{
+			External: operatorv1alpha1.ExternalConfig{
+				Hostname: "example.kcp.io",
+				Port:     6443,
+			},
+			CommonShardSpec: operatorv1alpha1.CommonShardSpec{
+				Etcd: operatorv1alpha1.EtcdConfig{
+					Endpoints: []string{"https://localhost:2379"},
+				},
+				CABundleConfigMapRef: &operatorv1alpha1.LocalDataKeyReference{
+					Name: "corporate-ca",
+					Key:  "bundle.pem",
+				},
+			},
+			TrustBundle: &operatorv1alpha1.TrustBundleSpec{
+				NamespaceSelector: &metav1.LabelSelector{
+					MatchLabels: map[string]string{"kcp.io/trust": "true"},
+				},
+			},
+		},
+	}
+}
+
+func newTrustBundleSecrets(namespace string) []ctrlruntimeclient.Object {
+	return []ctrlruntimeclient.Object{
+		&corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "rooty-server-ca",
+				Namespace: namespace,
+			},
+			Data: map[string][]byte{
+				corev1.TLSCertKey: []byte("server-ca-cert"),
+			},
+		},
+		&corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "rooty-client-ca",
+				Namespace: namespace,
+			},
+			Data: map[string][]byte{
+				corev1.TLSCertKey: []byte("client-ca-cert"),
+			},
+		},
+		&corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "corporate-ca",
+				Namespace: namespace,
+			},
+			Data: map[string]string{
+				"bundle.pem": "corporate-ca-cert",
+			},
+		},
+	}
+}
+
+func reconcileRootShard(t *testing.T, client ctrlruntimeclient.Client, rootShard *operatorv1alpha1.RootShard) {
+	t.Helper()
+
+	controllerReconciler := &RootShardReconciler{
+		GetCluster: util.FakeSingleCluster(client),
+		PKI:        pki.CertManager{},
+	}
+
+	_, err := controllerReconciler.Reconcile(context.Background(), mcreconcile.Request{
+		Request: reconcile.Request{
+			NamespacedName: ctrlruntimeclient.ObjectKeyFromObject(rootShard),
+		},
+	})
+	require.NoError(t, err)
+}
+
+func TestReconcileTrustBundle(t *testing.T) {
+	const namespace = "rootshard-tests"
+
+	rootShard := newTrustBundleRootShard(namespace)
+	client := newTrustBundleClient(append(newTrustBundleSecrets(namespace), rootShard)...)
+	ctx := context.Background()
+
+	reconcileRootShard(t, client, rootShard)
+
+	require.NoError(t, client.Get(ctx, ctrlruntimeclient.ObjectKeyFromObject(rootShard), rootShard))
+	require.Contains(t, rootShard.Finalizers, trustBundleFinalizer)
+
+	bundle, err := getTrustBundle(client, "rootshard-tests-rooty-server-ca")
+	require.NoError(t, err)
+	require.Equal(t, "rooty", bundle.GetLabels()["operator.kcp.io/rootshard"])
+
+	sources, _, err := unstructured.NestedSlice(bundle.Object, "spec", "sources")
+	require.NoError(t, err)
+	require.Equal(t, []any{map[string]any{"inLine": "server-ca-cert\ncorporate-ca-cert"}}, sources)
+
+	key, _, err := unstructured.NestedString(bundle.Object, "spec", "target", "configMap", "key")
+	require.NoError(t, err)
+	require.Equal(t, "ca.crt", key)
+
+	selector, _, err := unstructured.NestedStringMap(bundle.Object, "spec", "target", "namespaceSelector", "matchLabels")
+	require.NoError(t, err)
+	require.Equal(t, map[string]string{"kcp.io/trust": "true"}, selector)
+
+	// Removing the trustBundle removes the Bundle again.
+	rootShard.Spec.TrustBundle = nil
+	require.NoError(t, client.Update(ctx, rootShard))
+
+	reconcileRootShard(t, client, rootShard)
+
+	require.NoError(t, client.Get(ctx, ctrlruntimeclient.ObjectKeyFromObject(rootShard), rootShard))
+	require.NotContains(t, rootShard.Finalizers, trustBundleFinalizer)
+
+	_, err = getTrustBundle(client, "rootshard-tests-rooty-server-ca")
+	require.True(t, apierrors.IsNotFound(err), "expected Bundle to be deleted, got %v", err)
+}
+
+func TestDeletePausedRootShardWithTrustBundle(t *testing.T) {
+	const namespace = "rootshard-tests"
+
+	rootShard := newTrustBundleRootShard(namespace)
+	client := newTrustBundleClient(append(newTrustBundleSecrets(namespace), rootShard)...)
+	ctx := context.Background()
+
+	reconcileRootShard(t, client, rootShard)
+
+	_, err := getTrustBundle(client, "rootshard-tests-rooty-server-ca")
+	require.NoError(t, err)
+
+	require.NoError(t, client.Get(ctx, ctrlruntimeclient.ObjectKeyFromObject(rootShard), rootShard))
+	rootShard.Annotations = map[string]string{operatorv1alpha1.PausedAnnotation: "true"}
+	require.NoError(t, client.Update(ctx, rootShard))
+	require.NoError(t, client.Delete(ctx, rootShard))
+
+	// The deletion finishes although the RootShard is paused, without failing on the gone object.
+	reconcileRootShard(t, client, rootShard)
+
+	err = client.Get(ctx, ctrlruntimeclient.ObjectKeyFromObject(rootShard), rootShard)
+	require.True(t, apierrors.IsNotFound(err), "expected RootShard to be deleted, got %v", err)
+
+	_, err = getTrustBundle(client, "rootshard-tests-rooty-server-ca")
+	require.True(t, apierrors.IsNotFound(err), "expected Bundle to be deleted, got %v", err)
+}
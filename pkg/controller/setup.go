@@ -29,20 +29,31 @@ import (
 	"github.com/kcp-dev/kcp-operator/pkg/controller/compiledrootshard"
 	"github.com/kcp-dev/kcp-operator/pkg/controller/compiledshard"
 	"github.com/kcp-dev/kcp-operator/pkg/controller/compiledvirtualworkspace"
+	"github.com/kcp-dev/kcp-operator/pkg/controller/etcdbackupschedule"
+	"github.com/kcp-dev/kcp-operator/pkg/controller/etcdrestore"
+	"github.com/kcp-dev/kcp-operator/pkg/controller/etcdsnapshot"
 	"github.com/kcp-dev/kcp-operator/pkg/controller/frontproxy"
 	"github.com/kcp-dev/kcp-operator/pkg/controller/kubeconfig"
 	kubeconfigrbac "github.com/kcp-dev/kcp-operator/pkg/controller/kubeconfig-rbac"
 	"github.com/kcp-dev/kcp-operator/pkg/controller/rootshard"
 	"github.com/kcp-dev/kcp-operator/pkg/controller/shard"
+	"github.com/kcp-dev/kcp-operator/pkg/controller/util"
 	"github.com/kcp-dev/kcp-operator/pkg/controller/virtualworkspace"
+	"github.com/kcp-dev/kcp-operator/pkg/pki"
 )
 
+// eventRecorderName is the reporting controller of all Events recorded by the operator.
+const eventRecorderName = "kcp-operator"
+
 // Options configures a controller group.
 type Options struct {
 	// Engage are multicluster options that are passed to each
 	// SetupWithManager and applied for For, Owns and Watches.
 	Engage  []mcbuilder.EngageOptions
 	Address operatorclient.Addresser
+	// PKI issues the certificates of kcp components. It is only used by the
+	// config controllers.
+	PKI pki.Backend
 }
 
 // AddConfigControllers registers the controllers that configure a kcp instance: they own the
@@ -51,71 +62,113 @@ func AddConfigControllers(mgr mcmanager.Manager, options Options) error {
 	if options.Address == nil {
 		return fmt.Errorf("Options.Address is required")
 	}
+	if options.PKI == nil {
+		return fmt.Errorf("Options.PKI is required")
+	}
+
+	events := util.NewEventRecorder(eventRecorderName)
+
 	if err := (&rootshard.RootShardReconciler{
 		GetCluster: mgr.GetCluster,
+		Events:     events,
+		PKI:        options.PKI,
 	}).SetupWithManager(mgr, options.Engage...); err != nil {
 		return fmt.Errorf("unable to create controller %s: %w", "RootShard", err)
 	}
 	if err := (&frontproxy.FrontProxyReconciler{
 		GetCluster: mgr.GetCluster,
+		Events:     events,
+		PKI:        options.PKI,
 	}).SetupWithManager(mgr, options.Engage...); err != nil {
 		return fmt.Errorf("unable to create controller %s: %w", "FrontProxy", err)
 	}
 	if err := (&shard.ShardReconciler{
 		GetCluster: mgr.GetCluster,
 		Address:    options.Address,
+		Events:     events,
+		PKI:        options.PKI,
 	}).SetupWithManager(mgr, options.Engage...); err != nil {
 		return fmt.Errorf("unable to create controller %s: %w", "Shard", err)
 	}
 	if err := (&cacheserver.CacheServerReconciler{
 		GetCluster: mgr.GetCluster,
+		Events:     events,
+		PKI:        options.PKI,
 	}).SetupWithManager(mgr, options.Engage...); err != nil {
 		return fmt.Errorf("unable to create controller %s: %w", "CacheServer", err)
 	}
 	if err := (&kubeconfig.KubeconfigReconciler{
 		GetCluster: mgr.GetCluster,
+		Events:     events,
+		PKI:        options.PKI,
 	}).SetupWithManager(mgr, options.Engage...); err != nil {
 		return fmt.Errorf("unable to create controller %s: %w", "Kubeconfig", err)
 	}
 	if err := (&virtualworkspace.Reconciler{
 		GetCluster: mgr.GetCluster,
+		Events:     events,
+		PKI:        options.PKI,
 	}).SetupWithManager(mgr, options.Engage...); err != nil {
 		return fmt.Errorf("unable to create controller %s: %w", "VirtualWorkspace", err)
 	}
 	if err := (&kubeconfigrbac.KubeconfigRBACReconciler{
 		GetCluster: mgr.GetCluster,
 		Address:    options.Address,
+		Events:     events,
 	}).SetupWithManager(mgr, options.Engage...); err != nil {
 		return fmt.Errorf("unable to create controller %s: %w", "KubeconfigRBAC", err)
 	}
+	if err := (&etcdsnapshot.EtcdSnapshotReconciler{
+		GetCluster: mgr.GetCluster,
+	}).SetupWithManager(mgr, options.Engage...); err != nil {
+		return fmt.Errorf("unable to create controller %s: %w", "EtcdSnapshot", err)
+	}
+	if err := (&etcdbackupschedule.EtcdBackupScheduleReconciler{
+		GetCluster: mgr.GetCluster,
+	}).SetupWithManager(mgr, options.Engage...); err != nil {
+		return fmt.Errorf("unable to create controller %s: %w", "EtcdBackupSchedule", err)
+	}
+	if err := (&etcdrestore.EtcdRestoreReconciler{
+		GetCluster: mgr.GetCluster,
+	}).SetupWithManager(mgr, options.Engage...); err != nil {
+		return fmt.Errorf("unable to create controller %s: %w", "EtcdRestore", err)
+	}
 	return nil
 }
 
 // AddWorkloadControllers registers the controllers that turn compiled render inputs into
 // workloads such as Deployments and Services.
 func AddWorkloadControllers(mgr mcmanager.Manager, options Options) error {
+	if options.Address == nil {
+		return fmt.Errorf("Options.Address is required")
+	}
 	if err := (&compiledrootshard.CompiledRootShardReconciler{
 		GetCluster: mgr.GetCluster,
+		Address:    options.Address,
 	}).SetupWithManager(mgr, options.Engage...); err != nil {
 		return fmt.Errorf("unable to create controller %s: %w", "CompiledRootShard", err)
 	}
 	if err := (&compiledfrontproxy.CompiledFrontProxyReconciler{
 		GetCluster: mgr.GetCluster,
+		Address:    options.Address,
 	}).SetupWithManager(mgr, options.Engage...); err != nil {
 		return fmt.Errorf("unable to create controller %s: %w", "CompiledFrontProxy", err)
 	}
 	if err := (&compiledshard.CompiledShardReconciler{
 		GetCluster: mgr.GetCluster,
+		Address:    options.Address,
 	}).SetupWithManager(mgr, options.Engage...); err != nil {
 		return fmt.Errorf("unable to create controller %s: %w", "CompiledShard", err)
 	}
 	if err := (&compiledcacheserver.CompiledCacheServerReconciler{
 		GetCluster: mgr.GetCluster,
+		Address:    options.Address,
 	}).SetupWithManager(mgr, options.Engage...); err != nil {
 		return fmt.Errorf("unable to create controller %s: %w", "CompiledCacheServer", err)
 	}
 	if err := (&compiledvirtualworkspace.CompiledVirtualWorkspaceReconciler{
 		GetCluster: mgr.GetCluster,
+		Address:    options.Address,
 	}).SetupWithManager(mgr, options.Engage...); err != nil {
 		return fmt.Errorf("unable to create controller %s: %w", "CompiledVirtualWorkspace", err)
 	}
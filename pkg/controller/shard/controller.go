@@ -20,6 +20,7 @@ import (
 	"context"
 	"fmt"
 	"slices"
+	"strings"
 	"time"
 
 	certmanagerv1 "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
@@ -48,21 +49,37 @@ import (
 	mcreconcile "sigs.k8s.io/multicluster-runtime/pkg/reconcile"
 
 	"github.com/kcp-dev/kcp-operator/internal/resources/shard"
+	"github.com/kcp-dev/kcp-operator/internal/resources/utils"
 	operatorclient "github.com/kcp-dev/kcp-operator/pkg/client"
 	"github.com/kcp-dev/kcp-operator/pkg/controller/util"
 	"github.com/kcp-dev/kcp-operator/pkg/metrics"
+	"github.com/kcp-dev/kcp-operator/pkg/pki"
 	"github.com/kcp-dev/kcp-operator/pkg/reconciling"
 	"github.com/kcp-dev/kcp-operator/pkg/reconciling/modifier"
+	"github.com/kcp-dev/kcp-operator/pkg/tracing"
 	deployv1alpha1 "github.com/kcp-dev/kcp-operator/sdk/apis/deploy/v1alpha1"
 	operatorv1alpha1 "github.com/kcp-dev/kcp-operator/sdk/apis/operator/v1alpha1"
 )
 
-const cleanupFinalizer = "operator.kcp.io/cleanup-shard"
+const (
+	cleanupFinalizer = "operator.kcp.io/cleanup-shard"
+
+	// unschedulableAnnotation is honored by the kcp workspace scheduler, which will not place
+	// any new workspaces onto a shard that carries it. It is read as unschedulableAnnotationKey
+	// in pkg/reconciler/tenancy/workspace of kcp v0.32, the release matching our
+	// github.com/kcp-dev/sdk dependency, which does not export the key itself.
+	unschedulableAnnotation = "experimental.core.kcp.io/unschedulable"
+
+	// drainInterval is how often a deleted shard is checked for remaining logical clusters.
+	drainInterval = 30 * time.Second
+)
 
 // ShardReconciler reconciles a Shard object
 type ShardReconciler struct {
 	GetCluster func(ctx context.Context, clusterName multicluster.ClusterName) (cluster.Cluster, error)
 	Address    operatorclient.Addresser
+	Events     *util.EventRecorder
+	PKI        pki.Backend
 }
 
 // SetupWithManager sets up the controller with the Manager.
@@ -110,10 +127,10 @@ func (r *ShardReconciler) SetupWithManager(mgr mcmanager.Manager, opts ...mcbuil
 		For(&operatorv1alpha1.Shard{}, util.EngageFor(opts)...).
 		Owns(&deployv1alpha1.CompiledShard{}, util.EngageOwns(opts)...).
 		Owns(&corev1.Secret{}, util.EngageOwns(opts)...).
-		Owns(&certmanagerv1.Certificate{}, util.EngageOwns(opts)...).
+		Owns(r.PKI.CertificateType(), util.EngageOwns(opts)...).
 		Watches(&operatorv1alpha1.RootShard{}, rootShardHandler, util.EngageWatches(opts)...).
 		Watches(&operatorv1alpha1.VirtualWorkspace{}, vwHandler, util.EngageWatches(opts)...).
-		Complete(r)
+		Complete(tracing.Reconciler("shard", r))
 }
 
 // +kubebuilder:rbac:groups=operator.kcp.io,resources=shards,verbs=get;list;watch;update;patch
@@ -149,25 +166,55 @@ func (r *ShardReconciler) Reconcile(ctx context.Context, req mcreconcile.Request
 		return ctrl.Result{}, nil
 	}
 
-	conditions, recErr := r.reconcile(ctx, cl.GetClient(), cl.GetScheme(), &s)
+	recorder := r.Events.For(req.ClusterName, cl)
+
+	var (
+		conditions   []metav1.Condition
+		certs        []*certmanagerv1.Certificate
+		requeueAfter time.Duration
+	)
+
+	switch {
+	case util.IsPaused(&s):
+		logger.V(4).Info("Skipping reconciliation because the object is paused")
+		recErr = util.PauseCompiled(ctx, cl.GetClient(), req.NamespacedName, &deployv1alpha1.CompiledShard{})
+
+	case s.DeletionTimestamp != nil:
+		requeueAfter, conditions, recErr = r.handleDeletion(ctx, cl.GetClient(), cl.GetScheme(), recorder, &s)
 
-	if err := r.reconcileStatus(ctx, cl.GetClient(), &s, conditions); err != nil {
+	default:
+		metrics.RecordGeneration(&s)
+		conditions, recErr = r.reconcile(ctx, cl.GetClient(), cl.GetScheme(), recorder, &s, &certs)
+	}
+
+	certsCond, certsRequeueAfter, err := util.GetCertificatesReadyCondition(ctx, cl.GetClient(), r.PKI, &s)
+	if err != nil {
+		recErr = kerrors.NewAggregate([]error{recErr, err})
+	} else {
+		conditions = append(conditions, certsCond)
+	}
+
+	if requeueAfter == 0 || (certsRequeueAfter > 0 && certsRequeueAfter < requeueAfter) {
+		requeueAfter = certsRequeueAfter
+	}
+
+	if err := r.reconcileStatus(ctx, cl.GetClient(), recorder, &s, conditions, certs); err != nil {
 		recErr = kerrors.NewAggregate([]error{recErr, err})
 	}
 
-	return ctrl.Result{}, recErr
+	recorder.ReconcileFailed(&s, recErr)
+
+	requeueAfter = util.MinRequeueAfter(requeueAfter, util.CertificateRotationRequeueAfter(&s))
+
+	return ctrl.Result{RequeueAfter: requeueAfter}, recErr
 }
 
-func (r *ShardReconciler) reconcile(ctx context.Context, client ctrlruntimeclient.Client, scheme *runtime.Scheme, s *operatorv1alpha1.Shard) ([]metav1.Condition, error) {
+func (r *ShardReconciler) reconcile(ctx context.Context, client ctrlruntimeclient.Client, scheme *runtime.Scheme, recorder *util.Recorder, s *operatorv1alpha1.Shard, certs *[]*certmanagerv1.Certificate) ([]metav1.Condition, error) {
 	var (
 		errs       []error
 		conditions []metav1.Condition
 	)
 
-	if s.DeletionTimestamp != nil {
-		return r.handleDeletion(ctx, client, scheme, s)
-	}
-
 	// Ensure finalizer before any other work
 	if updated, err := r.ensureFinalizer(ctx, client, s); err != nil {
 		return conditions, fmt.Errorf("failed to ensure cleanup finalizer: %w", err)
@@ -179,6 +226,7 @@ func (r *ShardReconciler) reconcile(ctx context.Context, client ctrlruntimeclien
 	conditions = append(conditions, cond)
 
 	if rootShard == nil {
+		recorder.ReferenceMissing(s, cond)
 		return conditions, nil
 	}
 
@@ -194,8 +242,20 @@ func (r *ShardReconciler) reconcile(ctx context.Context, client ctrlruntimeclien
 		shard.ExternalLogicalClusterAdminCertificateReconciler(s, rootShard),
 	}
 
-	var certs []*certmanagerv1.Certificate
-	if err := reconciling.ReconcileCertificates(ctx, certReconcilers, s.Namespace, client, ownerRefWrapper, modifier.Capture(&certs)); err != nil {
+	if s.Spec.Etcd.Managed != nil {
+		certReconcilers = append(certReconcilers,
+			shard.EtcdServerCertificateReconciler(s, rootShard),
+			shard.EtcdClientCertificateReconciler(s, rootShard),
+		)
+	}
+
+	if err := tracing.Trace(ctx, "ReconcileCertificates", func(ctx context.Context) error {
+		return r.PKI.ReconcileCertificates(ctx, certReconcilers, s.Namespace, client, ownerRefWrapper, modifier.Capture(certs))
+	}); err != nil {
+		errs = append(errs, err)
+	}
+
+	if err := util.RotateCertificates(ctx, client, r.PKI, recorder, s, s.Status.CertificateRotation, *certs); err != nil {
 		errs = append(errs, err)
 	}
 
@@ -207,7 +267,7 @@ func (r *ShardReconciler) reconcile(ctx context.Context, client ctrlruntimeclien
 		errs = append(errs, err)
 	}
 
-	if s.Spec.CABundleSecretRef != nil {
+	if utils.HasCABundle(s.Spec.CABundleSecretRef, s.Spec.CABundleConfigMapRef) {
 		if err := k8creconciling.ReconcileSecrets(ctx, []k8creconciling.NamedSecretReconcilerFactory{
 			shard.MergedCABundleSecretReconciler(ctx, s, client),
 		}, s.Namespace, client, ownerRefWrapper); err != nil {
@@ -233,6 +293,10 @@ func (r *ShardReconciler) reconcile(ctx context.Context, client ctrlruntimeclien
 		kcpVW = &operatorv1alpha1.VirtualWorkspace{}
 		key := types.NamespacedName{Namespace: s.Namespace, Name: s.Spec.KCPVirtualWorkspace.Name}
 		if err := client.Get(ctx, key, kcpVW); err != nil {
+			if apierrors.IsNotFound(err) {
+				recorder.Warning(s, util.EventReasonReferenceMissing, util.EventActionResolveReferences, "VirtualWorkspace %s does not exist.", key.Name)
+			}
+
 			errs = append(errs, fmt.Errorf("failed to find associated VirtualWorkspace %s: %w", key.Name, err))
 			vwConfigValid = false
 		}
@@ -245,14 +309,35 @@ func (r *ShardReconciler) reconcile(ctx context.Context, client ctrlruntimeclien
 
 	// Only publish the render input once every Certificate is ready, so that whoever consumes
 	// it can rely on the Secrets it mounts already existing.
-	revisions, certsReady := util.CertificateRevisions(certs)
+	revisions, certsReady := util.CertificateRevisions(*certs)
+	if certsReady {
+		recorder.CertificatesIssued(s, len(*certs))
+	}
+
+	// New kcp versions are rolled out step by step across the installation, so the shard
+	// might have to stay on its current image for now.
+	plan, planErr := util.GetUpgradePlan(ctx, client, rootShard)
+	if planErr != nil {
+		errs = append(errs, fmt.Errorf("failed to determine upgrade plan: %w", planErr))
+	}
 
 	// The workloads themselves are rendered by the CompiledShard controller.
-	if vwConfigValid && shardsErr == nil && certsReady {
-		if err := reconciling.ReconcileCompiledShards(ctx, []reconciling.NamedCompiledShardReconcilerFactory{
-			shard.CompiledShardReconciler(s, rootShard, kcpVW, shards, util.MutateKeys(revisions, "cert-", "-revision")),
-		}, s.Namespace, client, ownerRefWrapper); err != nil {
+	if vwConfigValid && shardsErr == nil && planErr == nil && certsReady {
+		compiledShard := s
+		if decision := plan.Decide(util.UpgradeKindShard, s.Name); decision.HeldBack() {
+			compiledShard = s.DeepCopy()
+			compiledShard.Spec.Image = decision.Image
+		}
+
+		var published bool
+		if err := tracing.Trace(ctx, "PublishCompiled", func(ctx context.Context) error {
+			return reconciling.ReconcileCompiledShards(ctx, []reconciling.NamedCompiledShardReconcilerFactory{
+				shard.CompiledShardReconciler(compiledShard, rootShard, kcpVW, shards, util.MutateKeys(revisions, "cert-", "-revision")),
+			}, s.Namespace, client, ownerRefWrapper, modifier.PublishedAt(time.Now(), &published), modifier.TraceParent(ctx))
+		}); err != nil {
 			errs = append(errs, err)
+		} else {
+			metrics.RecordPublished(metrics.ShardResourceType, s, published)
 		}
 	}
 
@@ -260,26 +345,63 @@ func (r *ShardReconciler) reconcile(ctx context.Context, client ctrlruntimeclien
 }
 
 // reconcileStatus sets both phase and conditions on the reconciled Shard object.
-func (r *ShardReconciler) reconcileStatus(ctx context.Context, client ctrlruntimeclient.Client, oldShard *operatorv1alpha1.Shard, conditions []metav1.Condition) error {
+func (r *ShardReconciler) reconcileStatus(ctx context.Context, client ctrlruntimeclient.Client, recorder *util.Recorder, oldShard *operatorv1alpha1.Shard, conditions []metav1.Condition, certs []*certmanagerv1.Certificate) error {
 	newShard := oldShard.DeepCopy()
 	var errs []error
 
+	// Certificates are only known if they have been reconciled.
+	if certs != nil {
+		newShard.Status.Certificates = util.CertificateInventory(certs)
+	}
+
 	compiled := &deployv1alpha1.CompiledShard{}
 	key := types.NamespacedName{Namespace: newShard.Namespace, Name: newShard.Name}
 	if err := client.Get(ctx, key, compiled); ctrlruntimeclient.IgnoreNotFound(err) != nil {
 		errs = append(errs, err)
 	} else {
 		conditions = append(conditions, util.GetCompiledAvailableCondition(compiled.Status.Conditions, "CompiledShard "+newShard.Name))
+		newShard.Status.Version = util.AdoptVersionStatus(newShard.Spec.Image, compiled.Status.Version)
+		newShard.Status.Placement = compiled.Status.Placement
+
+		if rotation, cond := util.CertificateRotation(newShard, newShard.Status.CertificateRotation, certs, compiled, compiled.Status.Conditions); cond != nil {
+			newShard.Status.CertificateRotation = rotation
+			cond.ObservedGeneration = newShard.Generation
+			apimeta.SetStatusCondition(&newShard.Status.Conditions, *cond)
+		}
+
+		recorder.CompiledPublished(newShard, compiled, "CompiledShard")
+		recorder.DeploymentRolled(newShard, oldShard.Status.Version, newShard.Status.Version)
+
+		if cond := util.GetCompiledCondition(compiled.Status.Conditions, operatorv1alpha1.ConditionTypeHealthy); cond != nil {
+			conditions = append(conditions, *cond)
+		}
+
+		if cond := util.GetCompiledCondition(compiled.Status.Conditions, operatorv1alpha1.ConditionTypeEtcdHealthy); cond != nil && newShard.Spec.Etcd.Managed != nil {
+			conditions = append(conditions, *cond)
+		} else {
+			apimeta.RemoveStatusCondition(&newShard.Status.Conditions, string(operatorv1alpha1.ConditionTypeEtcdHealthy))
+		}
 	}
 
 	for _, condition := range conditions {
 		condition.ObservedGeneration = newShard.Generation
 		newShard.Status.Conditions = util.UpdateCondition(newShard.Status.Conditions, condition)
 	}
+	newShard.Status.Conditions = util.UpdatePausedCondition(newShard, newShard.Status.Conditions)
 
 	availableCond := apimeta.FindStatusCondition(newShard.Status.Conditions, string(operatorv1alpha1.ConditionTypeAvailable))
+	drainedCond := apimeta.FindStatusCondition(newShard.Status.Conditions, string(operatorv1alpha1.ConditionTypeDrained))
+
+	// A kcp shard registers itself with its root shard before it reports as ready.
+	if newShard.DeletionTimestamp == nil && availableCond != nil && availableCond.Status == metav1.ConditionTrue &&
+		!apimeta.IsStatusConditionTrue(oldShard.Status.Conditions, string(operatorv1alpha1.ConditionTypeAvailable)) {
+		recorder.Normal(newShard, util.EventReasonShardRegistered, util.EventActionRegisterShard, "Shard is available and has registered itself with its RootShard.")
+	}
 
 	switch {
+	case newShard.DeletionTimestamp != nil && drainedCond != nil && drainedCond.Status != metav1.ConditionTrue:
+		newShard.Status.Phase = operatorv1alpha1.ShardPhaseDraining
+
 	case availableCond != nil && availableCond.Status == metav1.ConditionTrue:
 		newShard.Status.Phase = operatorv1alpha1.ShardPhaseRunning
 
@@ -300,11 +422,14 @@ func (r *ShardReconciler) reconcileStatus(ctx context.Context, client ctrlruntim
 	return kerrors.NewAggregate(errs)
 }
 
-func (r *ShardReconciler) handleDeletion(ctx context.Context, client ctrlruntimeclient.Client, scheme *runtime.Scheme, s *operatorv1alpha1.Shard) ([]metav1.Condition, error) {
+// handleDeletion drains the shard before removing it from kcp: the kcp Shard object is marked
+// as unschedulable and the deletion is blocked for as long as logical clusters remain on the
+// shard, unless the Shard has been annotated with operatorv1alpha1.ForceDeleteAnnotation.
+func (r *ShardReconciler) handleDeletion(ctx context.Context, client ctrlruntimeclient.Client, scheme *runtime.Scheme, recorder *util.Recorder, s *operatorv1alpha1.Shard) (time.Duration, []metav1.Condition, error) {
 	logger := log.FromContext(ctx)
 
 	if !slices.Contains(s.Finalizers, cleanupFinalizer) {
-		return nil, nil
+		return 0, nil, nil
 	}
 
 	// Fetch RootShard
@@ -313,15 +438,49 @@ func (r *ShardReconciler) handleDeletion(ctx context.Context, client ctrlruntime
 		logger.Info("RootShard not found, cannot clean up kcp Shard object", "condition", cond.Message)
 		// Remove finalizer anyway - we can't clean up without the root shard
 		if err := r.removeFinalizer(ctx, client, s); err != nil {
-			return []metav1.Condition{cond}, fmt.Errorf("failed to remove finalizer: %w", err)
+			return 0, []metav1.Condition{cond}, fmt.Errorf("failed to remove finalizer: %w", err)
 		}
-		return []metav1.Condition{cond}, nil
+		return 0, []metav1.Condition{cond}, nil
 	}
 
 	// Create client to root shard
 	kcpClient, err := operatorclient.NewRootShardClient(ctx, client, r.Address, rootShard, logicalcluster.NewPath("root"), scheme)
 	if err != nil {
-		return nil, fmt.Errorf("failed to create root shard client: %w", err)
+		return 0, nil, fmt.Errorf("failed to create root shard client: %w", err)
+	}
+
+	var drainedCond metav1.Condition
+
+	if s.Annotations[operatorv1alpha1.ForceDeleteAnnotation] == "true" {
+		logger.Info("Skipping drain because the Shard is force-deleted")
+
+		drainedCond = metav1.Condition{
+			Type:    string(operatorv1alpha1.ConditionTypeDrained),
+			Status:  metav1.ConditionTrue,
+			Reason:  string(operatorv1alpha1.ConditionReasonForceDeleted),
+			Message: fmt.Sprintf("Drain skipped because of the %s annotation.", operatorv1alpha1.ForceDeleteAnnotation),
+		}
+	} else {
+		// Make sure no new workspaces are scheduled onto the shard while we wait for it to drain.
+		if err := markUnschedulable(ctx, kcpClient, s.Name); err != nil {
+			return 0, nil, fmt.Errorf("failed to mark kcp Shard as unschedulable: %w", err)
+		}
+
+		shardClient, err := operatorclient.NewShardClient(ctx, client, r.Address, s, logicalcluster.Wildcard, scheme)
+		if err != nil {
+			return 0, nil, fmt.Errorf("failed to create shard client: %w", err)
+		}
+
+		clusters := &kcpcorev1alpha1.LogicalClusterList{}
+		if err := shardClient.List(ctx, clusters); err != nil {
+			return 0, nil, fmt.Errorf("failed to list logical clusters on shard: %w", err)
+		}
+
+		drainedCond = drainedCondition(remainingLogicalClusters(clusters.Items))
+		if drainedCond.Status != metav1.ConditionTrue {
+			logger.Info("Waiting for shard to be drained", "message", drainedCond.Message)
+			return drainInterval, []metav1.Condition{drainedCond}, nil
+		}
 	}
 
 	// Delete the kcp Shard object
@@ -331,17 +490,91 @@ func (r *ShardReconciler) handleDeletion(ctx context.Context, client ctrlruntime
 	logger.Info("Deleting kcp Shard object from root workspace", "name", s.Name)
 	if err := kcpClient.Delete(ctx, kcpShard); err != nil {
 		if !apierrors.IsNotFound(err) {
-			return nil, fmt.Errorf("failed to delete kcp Shard: %w", err)
+			return 0, []metav1.Condition{drainedCond}, fmt.Errorf("failed to delete kcp Shard: %w", err)
 		}
 		logger.V(2).Info("kcp Shard object already deleted")
+	} else {
+		recorder.Normal(s, util.EventReasonShardUnregistered, util.EventActionRegisterShard, "Removed kcp Shard %s from RootShard %s.", s.Name, rootShard.Name)
 	}
 
 	// Remove finalizer
 	if err := r.removeFinalizer(ctx, client, s); err != nil {
-		return nil, fmt.Errorf("failed to remove finalizer: %w", err)
+		return 0, []metav1.Condition{drainedCond}, fmt.Errorf("failed to remove finalizer: %w", err)
+	}
+
+	return 0, []metav1.Condition{drainedCond}, nil
+}
+
+// markUnschedulable annotates the kcp Shard object so that the kcp scheduler stops placing
+// workspaces onto it.
+func markUnschedulable(ctx context.Context, kcpClient ctrlruntimeclient.Client, name string) error {
+	kcpShard := &kcpcorev1alpha1.Shard{}
+	if err := kcpClient.Get(ctx, types.NamespacedName{Name: name}, kcpShard); err != nil {
+		return ctrlruntimeclient.IgnoreNotFound(err)
+	}
+
+	if _, exists := kcpShard.Annotations[unschedulableAnnotation]; exists {
+		return nil
+	}
+
+	original := kcpShard.DeepCopy()
+	if kcpShard.Annotations == nil {
+		kcpShard.Annotations = map[string]string{}
+	}
+	kcpShard.Annotations[unschedulableAnnotation] = "true"
+
+	return kcpClient.Patch(ctx, kcpShard, ctrlruntimeclient.MergeFrom(original))
+}
+
+// remainingLogicalClusters returns the sorted names of all logical clusters that still need to
+// be moved off a shard before it can be deleted. System logical clusters are created by kcp
+// on every shard and are ignored.
+func remainingLogicalClusters(clusters []kcpcorev1alpha1.LogicalCluster) []string {
+	var names []string
+	for _, cluster := range clusters {
+		name := logicalcluster.From(&cluster).String()
+		if name == "" || strings.HasPrefix(name, "system:") {
+			continue
+		}
+
+		names = append(names, name)
+	}
+
+	slices.Sort(names)
+
+	return names
+}
+
+func drainedCondition(remaining []string) metav1.Condition {
+	if len(remaining) == 0 {
+		return metav1.Condition{
+			Type:    string(operatorv1alpha1.ConditionTypeDrained),
+			Status:  metav1.ConditionTrue,
+			Reason:  string(operatorv1alpha1.ConditionReasonShardEmpty),
+			Message: "No logical clusters remain on the shard.",
+		}
 	}
 
-	return nil, nil
+	// do not flood the condition with thousands of names
+	const maxListed = 5
+
+	listed := remaining
+	if len(listed) > maxListed {
+		listed = listed[:maxListed]
+	}
+
+	message := fmt.Sprintf("%d logical cluster(s) remain on the shard (%s", len(remaining), strings.Join(listed, ", "))
+	if len(remaining) > maxListed {
+		message += ", ..."
+	}
+	message += fmt.Sprintf("); move them to other shards or set the %s annotation to delete the shard anyway.", operatorv1alpha1.ForceDeleteAnnotation)
+
+	return metav1.Condition{
+		Type:    string(operatorv1alpha1.ConditionTypeDrained),
+		Status:  metav1.ConditionFalse,
+		Reason:  string(operatorv1alpha1.ConditionReasonLogicalClustersRemaining),
+		Message: message,
+	}
 }
 
 func (r *ShardReconciler) ensureFinalizer(ctx context.Context, client ctrlruntimeclient.Client, s *operatorv1alpha1.Shard) (bool, error) {
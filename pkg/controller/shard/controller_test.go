@@ -21,6 +21,8 @@ import (
 	"testing"
 
 	certmanagerv1 "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
+	"github.com/kcp-dev/logicalcluster/v3"
+	kcpcorev1alpha1 "github.com/kcp-dev/sdk/apis/core/v1alpha1"
 	"github.com/stretchr/testify/require"
 
 	appsv1 "k8s.io/api/apps/v1"
@@ -35,6 +37,7 @@ import (
 	mcreconcile "sigs.k8s.io/multicluster-runtime/pkg/reconcile"
 
 	"github.com/kcp-dev/kcp-operator/pkg/controller/util"
+	"github.com/kcp-dev/kcp-operator/pkg/pki"
 	deployv1alpha1 "github.com/kcp-dev/kcp-operator/sdk/apis/deploy/v1alpha1"
 	operatorv1alpha1 "github.com/kcp-dev/kcp-operator/sdk/apis/operator/v1alpha1"
 )
@@ -270,6 +273,7 @@ func TestReconciling(t *testing.T) {
 
 			controllerReconciler := &ShardReconciler{
 				GetCluster: util.FakeSingleCluster(client),
+				PKI:        pki.CertManager{},
 			}
 
 			// First reconcile adds finalizer and returns early
@@ -556,6 +560,7 @@ func TestClientCABundleMerging(t *testing.T) {
 
 			controllerReconciler := &ShardReconciler{
 				GetCluster: util.FakeSingleCluster(client),
+				PKI:        pki.CertManager{},
 			}
 
 			// First reconcile adds finalizer
@@ -597,3 +602,95 @@ func TestClientCABundleMerging(t *testing.T) {
 		})
 	}
 }
+
+func TestDrainedCondition(t *testing.T) {
+	newCluster := func(name string) kcpcorev1alpha1.LogicalCluster {
+		return kcpcorev1alpha1.LogicalCluster{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:        kcpcorev1alpha1.LogicalClusterName,
+				Annotations: map[string]string{logicalcluster.AnnotationKey: name},
+			},
+		}
+	}
+
+	testcases := []struct {
+		name            string
+		clusters        []kcpcorev1alpha1.LogicalCluster
+		expectedStatus  metav1.ConditionStatus
+		expectedReason  operatorv1alpha1.ConditionReason
+		expectedMessage string
+	}{
+		{
+			name:           "empty shard",
+			expectedStatus: metav1.ConditionTrue,
+			expectedReason: operatorv1alpha1.ConditionReasonShardEmpty,
+		},
+		{
+			name: "only system logical clusters",
+			clusters: []kcpcorev1alpha1.LogicalCluster{
+				newCluster("system:admin"),
+				newCluster("system:shard"),
+			},
+			expectedStatus: metav1.ConditionTrue,
+			expectedReason: operatorv1alpha1.ConditionReasonShardEmpty,
+		},
+		{
+			name: "tenant logical clusters remain",
+			clusters: []kcpcorev1alpha1.LogicalCluster{
+				newCluster("system:admin"),
+				newCluster("2pzr1xa0mkr4owcr"),
+				newCluster("1jk2oxbkoqkpy3ez"),
+			},
+			expectedStatus:  metav1.ConditionFalse,
+			expectedReason:  operatorv1alpha1.ConditionReasonLogicalClustersRemaining,
+			expectedMessage: "2 logical cluster(s) remain on the shard (1jk2oxbkoqkpy3ez, 2pzr1xa0mkr4owcr)",
+		},
+		{
+			name: "long lists are truncated",
+			clusters: []kcpcorev1alpha1.LogicalCluster{
+				newCluster("a"), newCluster("b"), newCluster("c"), newCluster("d"), newCluster("e"), newCluster("f"),
+			},
+			expectedStatus:  metav1.ConditionFalse,
+			expectedReason:  operatorv1alpha1.ConditionReasonLogicalClustersRemaining,
+			expectedMessage: "6 logical cluster(s) remain on the shard (a, b, c, d, e, ...)",
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			cond := drainedCondition(remainingLogicalClusters(tc.clusters))
+
+			require.Equal(t, string(operatorv1alpha1.ConditionTypeDrained), cond.Type)
+			require.Equal(t, tc.expectedStatus, cond.Status)
+			require.Equal(t, string(tc.expectedReason), cond.Reason)
+			require.Contains(t, cond.Message, tc.expectedMessage)
+		})
+	}
+}
+
+func TestMarkUnschedulable(t *testing.T) {
+	scheme := runtime.NewScheme()
+	require.Nil(t, kcpcorev1alpha1.AddToScheme(scheme))
+
+	client := ctrlruntimefakeclient.
+		NewClientBuilder().
+		WithScheme(scheme).
+		WithObjects(&kcpcorev1alpha1.Shard{
+			ObjectMeta: metav1.ObjectMeta{Name: "shard-1"},
+		}).
+		Build()
+
+	ctx := context.Background()
+
+	// marking the shard twice must be a no-op
+	require.NoError(t, markUnschedulable(ctx, client, "shard-1"))
+	require.NoError(t, markUnschedulable(ctx, client, "shard-1"))
+
+	kcpShard := &kcpcorev1alpha1.Shard{}
+	require.NoError(t, client.Get(ctx, ctrlruntimeclient.ObjectKey{Name: "shard-1"}, kcpShard))
+	require.Equal(t, "experimental.core.kcp.io/unschedulable", unschedulableAnnotation)
+	require.Equal(t, "true", kcpShard.Annotations[unschedulableAnnotation])
+
+	// shards that are already gone are not an error
+	require.NoError(t, markUnschedulable(ctx, client, "shard-2"))
+}
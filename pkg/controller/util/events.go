@@ -0,0 +1,230 @@
+/*
+Copyright 2026 The kcp Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package util
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/events"
+	"k8s.io/utils/lru"
+	ctrlruntimeclient "sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/cluster"
+	"sigs.k8s.io/multicluster-runtime/pkg/multicluster"
+
+	operatorv1alpha1 "github.com/kcp-dev/kcp-operator/sdk/apis/operator/v1alpha1"
+)
+
+// Reasons for the Events recorded by the controllers.
+const (
+	EventReasonCertificatesIssued  = "CertificatesIssued"
+	EventReasonCompiledPublished   = "CompiledPublished"
+	EventReasonDeploymentRolled    = "DeploymentRolled"
+	EventReasonShardRegistered     = "ShardRegistered"
+	EventReasonShardUnregistered   = "ShardUnregistered"
+	EventReasonRBACProvisioned     = "RBACProvisioned"
+	EventReasonReferenceMissing    = "ReferenceMissing"
+	EventReasonReconcileFailed     = "ReconcileFailed"
+	EventReasonCARotation          = "CARotation"
+	EventReasonCertificatesRotated = "CertificatesRotated"
+)
+
+// Actions for the Events recorded by the controllers. Events are deduplicated per object
+// and action, so a Normal Event following a Warning for the same action is always recorded.
+const (
+	EventActionIssueCertificates  = "IssueCertificates"
+	EventActionCompile            = "Compile"
+	EventActionRollout            = "Rollout"
+	EventActionRegisterShard      = "RegisterShard"
+	EventActionProvisionRBAC      = "ProvisionRBAC"
+	EventActionResolveReferences  = "ResolveReferences"
+	EventActionReconcile          = "Reconcile"
+	EventActionRotateCAs          = "RotateCAs"
+	EventActionRotateCertificates = "RotateCertificates"
+)
+
+const (
+	// eventCacheSize is the number of objects and actions for which the last Event is remembered.
+	eventCacheSize = 4096
+
+	// warningInterval is the time after which an unchanged Warning is recorded again.
+	warningInterval = 10 * time.Minute
+)
+
+// +kubebuilder:rbac:groups=events.k8s.io,resources=events,verbs=create;patch
+
+// EventRecorder remembers the Events that have recently been recorded by a controller, so
+// that it can report the current state on every reconciliation without flooding the API server
+// with identical Events. Normal Events are only recorded when their message changes, Warnings
+// are repeated every warningInterval for as long as they persist.
+type EventRecorder struct {
+	name string
+	now  func() time.Time
+
+	lock   sync.Mutex
+	recent *lru.Cache
+}
+
+type eventKey struct {
+	cluster multicluster.ClusterName
+	uid     string
+	object  string
+	action  string
+}
+
+type recentEvent struct {
+	eventType string
+	reason    string
+	message   string
+	time      time.Time
+}
+
+// NewEventRecorder returns an EventRecorder that records Events on behalf of the given component.
+func NewEventRecorder(name string) *EventRecorder {
+	return &EventRecorder{
+		name:   name,
+		now:    time.Now,
+		recent: lru.New(eventCacheSize),
+	}
+}
+
+// For returns a Recorder for objects in the given cluster. A nil EventRecorder returns a
+// Recorder that drops all Events.
+func (r *EventRecorder) For(clusterName multicluster.ClusterName, cl cluster.Cluster) *Recorder {
+	if r == nil {
+		return &Recorder{}
+	}
+
+	return &Recorder{
+		parent:      r,
+		clusterName: clusterName,
+		recorder:    cl.GetEventRecorder(r.name),
+	}
+}
+
+// shouldRecord reports whether an Event should be recorded and remembers it if so.
+func (r *EventRecorder) shouldRecord(key eventKey, event recentEvent) bool {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	if value, ok := r.recent.Get(key); ok {
+		last := value.(recentEvent)
+
+		if last.eventType == event.eventType && last.reason == event.reason && last.message == event.message {
+			if event.eventType != corev1.EventTypeWarning || event.time.Sub(last.time) < warningInterval {
+				return false
+			}
+		}
+	}
+
+	r.recent.Add(key, event)
+
+	return true
+}
+
+// Recorder records deduplicated Events for objects in a single cluster.
+type Recorder struct {
+	parent      *EventRecorder
+	clusterName multicluster.ClusterName
+	recorder    events.EventRecorder
+}
+
+// Normal records a Normal Event, unless the last Event for the object and action was identical.
+func (r *Recorder) Normal(obj ctrlruntimeclient.Object, reason, action, messageFmt string, args ...any) {
+	r.record(obj, corev1.EventTypeNormal, reason, action, messageFmt, args...)
+}
+
+// Warning records a Warning Event, unless an identical Event for the object and action has been
+// recorded recently.
+func (r *Recorder) Warning(obj ctrlruntimeclient.Object, reason, action, messageFmt string, args ...any) {
+	r.record(obj, corev1.EventTypeWarning, reason, action, messageFmt, args...)
+}
+
+func (r *Recorder) record(obj ctrlruntimeclient.Object, eventType, reason, action, messageFmt string, args ...any) {
+	if r.recorder == nil {
+		return
+	}
+
+	message := fmt.Sprintf(messageFmt, args...)
+
+	key := eventKey{
+		cluster: r.clusterName,
+		uid:     string(obj.GetUID()),
+		object:  obj.GetNamespace() + "/" + obj.GetName(),
+		action:  action,
+	}
+
+	if !r.parent.shouldRecord(key, recentEvent{eventType: eventType, reason: reason, message: message, time: r.parent.now()}) {
+		return
+	}
+
+	r.recorder.Eventf(obj, nil, eventType, reason, action, "%s", message)
+}
+
+// ReconcileFailed records a Warning for a failed reconciliation. It does nothing if err is nil.
+func (r *Recorder) ReconcileFailed(obj ctrlruntimeclient.Object, err error) {
+	if err == nil {
+		return
+	}
+
+	r.Warning(obj, EventReasonReconcileFailed, EventActionReconcile, "%v", err)
+}
+
+// ReferenceMissing records a Warning if the given condition reports an invalid reference.
+func (r *Recorder) ReferenceMissing(obj ctrlruntimeclient.Object, cond metav1.Condition) {
+	switch operatorv1alpha1.ConditionType(cond.Type) {
+	case operatorv1alpha1.ConditionTypeReferenceValid, operatorv1alpha1.ConditionTypeRootShard:
+	default:
+		return
+	}
+
+	if cond.Status != metav1.ConditionFalse {
+		return
+	}
+
+	r.Warning(obj, EventReasonReferenceMissing, EventActionResolveReferences, "%s", cond.Message)
+}
+
+// CertificatesIssued records that all Certificates of an object are ready.
+func (r *Recorder) CertificatesIssued(obj ctrlruntimeclient.Object, count int) {
+	r.Normal(obj, EventReasonCertificatesIssued, EventActionIssueCertificates, "All %d certificates have been issued.", count)
+}
+
+// CompiledPublished records that the compiled render input of an object has been updated.
+func (r *Recorder) CompiledPublished(obj ctrlruntimeclient.Object, compiled ctrlruntimeclient.Object, kind string) {
+	if compiled.GetResourceVersion() == "" {
+		return
+	}
+
+	r.Normal(obj, EventReasonCompiledPublished, EventActionCompile, "Published %s %s with generation %d.", kind, compiled.GetName(), compiled.GetGeneration())
+}
+
+// DeploymentRolled records a finished rollout if the current image of a component changed.
+func (r *Recorder) DeploymentRolled(obj ctrlruntimeclient.Object, oldVersion, newVersion *operatorv1alpha1.VersionStatus) {
+	if newVersion == nil || newVersion.CurrentImage == "" {
+		return
+	}
+
+	if oldVersion != nil && oldVersion.CurrentImage == newVersion.CurrentImage {
+		return
+	}
+
+	r.Normal(obj, EventReasonDeploymentRolled, EventActionRollout, "Rolled out image %s.", newVersion.CurrentImage)
+}
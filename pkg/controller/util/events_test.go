@@ -0,0 +1,148 @@
+/*
+Copyright 2026 The kcp Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package util
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/events"
+
+	operatorv1alpha1 "github.com/kcp-dev/kcp-operator/sdk/apis/operator/v1alpha1"
+)
+
+func drainEvents(recorder *events.FakeRecorder) []string {
+	var recorded []string
+	for {
+		select {
+		case event := <-recorder.Events:
+			recorded = append(recorded, event)
+		default:
+			return recorded
+		}
+	}
+}
+
+func TestEventRecorderDeduplicates(t *testing.T) {
+	now := time.Now()
+
+	parent := NewEventRecorder("test")
+	parent.now = func() time.Time { return now }
+
+	fakeRecorder := events.NewFakeRecorder(10)
+	recorder := parent.For("cluster", &testCluster{recorder: fakeRecorder})
+
+	shard := &operatorv1alpha1.Shard{ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "shardy", UID: "1234"}}
+
+	recorder.CertificatesIssued(shard, 3)
+	recorder.CertificatesIssued(shard, 3)
+	if recorded := drainEvents(fakeRecorder); len(recorded) != 1 {
+		t.Fatalf("Expected identical Normal events to be recorded once, got %v.", recorded)
+	}
+
+	recorder.CertificatesIssued(shard, 4)
+	if recorded := drainEvents(fakeRecorder); len(recorded) != 1 {
+		t.Fatalf("Expected changed Normal event to be recorded, got %v.", recorded)
+	}
+
+	// Normal events never repeat, no matter how much time has passed.
+	now = now.Add(time.Hour)
+	recorder.CertificatesIssued(shard, 4)
+	if recorded := drainEvents(fakeRecorder); len(recorded) != 0 {
+		t.Fatalf("Expected unchanged Normal event not to be repeated, got %v.", recorded)
+	}
+
+	err := errors.New("boom")
+	recorder.ReconcileFailed(shard, err)
+	recorder.ReconcileFailed(shard, err)
+	recorder.ReconcileFailed(shard, nil)
+	if recorded := drainEvents(fakeRecorder); len(recorded) != 1 || recorded[0] != "Warning ReconcileFailed boom" {
+		t.Fatalf("Expected a single Warning, got %v.", recorded)
+	}
+
+	now = now.Add(warningInterval)
+	recorder.ReconcileFailed(shard, err)
+	if recorded := drainEvents(fakeRecorder); len(recorded) != 1 {
+		t.Fatalf("Expected persisting Warning to be repeated, got %v.", recorded)
+	}
+
+	// the same event for another object is recorded independently
+	other := shard.DeepCopy()
+	other.Name = "other"
+	other.UID = "5678"
+
+	recorder.CertificatesIssued(other, 4)
+	if recorded := drainEvents(fakeRecorder); len(recorded) != 1 {
+		t.Fatalf("Expected event for another object to be recorded, got %v.", recorded)
+	}
+}
+
+func TestEventRecorderDeploymentRolled(t *testing.T) {
+	fakeRecorder := events.NewFakeRecorder(10)
+	recorder := NewEventRecorder("test").For("cluster", &testCluster{recorder: fakeRecorder})
+
+	shard := &operatorv1alpha1.Shard{ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "shardy", UID: "1234"}}
+
+	testcases := []struct {
+		name     string
+		old      *operatorv1alpha1.VersionStatus
+		new      *operatorv1alpha1.VersionStatus
+		expected int
+	}{
+		{
+			name: "nothing rolled out yet",
+			new:  &operatorv1alpha1.VersionStatus{DesiredImage: "kcp:v1"},
+		},
+		{
+			name:     "first rollout",
+			new:      &operatorv1alpha1.VersionStatus{CurrentImage: "kcp:v1"},
+			expected: 1,
+		},
+		{
+			name: "unchanged image",
+			old:  &operatorv1alpha1.VersionStatus{CurrentImage: "kcp:v1"},
+			new:  &operatorv1alpha1.VersionStatus{CurrentImage: "kcp:v1"},
+		},
+		{
+			name:     "new image",
+			old:      &operatorv1alpha1.VersionStatus{CurrentImage: "kcp:v1"},
+			new:      &operatorv1alpha1.VersionStatus{CurrentImage: "kcp:v2"},
+			expected: 1,
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			recorder.DeploymentRolled(shard, tc.old, tc.new)
+
+			if recorded := drainEvents(fakeRecorder); len(recorded) != tc.expected {
+				t.Fatalf("Expected %d events, got %v.", tc.expected, recorded)
+			}
+		})
+	}
+}
+
+func TestNilEventRecorder(t *testing.T) {
+	var parent *EventRecorder
+
+	shard := &operatorv1alpha1.Shard{ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "shardy"}}
+
+	// must not panic
+	parent.For("cluster", nil).ReconcileFailed(shard, errors.New("boom"))
+}
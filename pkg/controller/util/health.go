@@ -0,0 +1,84 @@
+/*
+Copyright 2026 The kcp Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package util
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	appsv1 "k8s.io/api/apps/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	ctrlruntimeclient "sigs.k8s.io/controller-runtime/pkg/client"
+
+	operatorv1alpha1 "github.com/kcp-dev/kcp-operator/sdk/apis/operator/v1alpha1"
+)
+
+// HealthProbeInterval is how often components are probed for their health. Nothing in the
+// cluster changes when a component becomes unhealthy, so the compiled controllers have to
+// requeue their objects to notice.
+const HealthProbeInterval = 30 * time.Second
+
+// GetHealthyCondition reports on the health checks of a component, as returned by the given
+// probe. Components are only probed once their Deployment has ready replicas; until then the
+// Available condition already tells the story and the Healthy condition is left Unknown.
+func GetHealthyCondition(ctx context.Context, client ctrlruntimeclient.Client, key types.NamespacedName, probe func(ctx context.Context) ([]string, error)) (metav1.Condition, error) {
+	var dep appsv1.Deployment
+	if err := client.Get(ctx, key, &dep); ctrlruntimeclient.IgnoreNotFound(err) != nil {
+		return metav1.Condition{}, err
+	}
+
+	if dep.Status.ReadyReplicas == 0 {
+		return metav1.Condition{
+			Type:    string(operatorv1alpha1.ConditionTypeHealthy),
+			Status:  metav1.ConditionUnknown,
+			Reason:  string(operatorv1alpha1.ConditionReasonProbeFailed),
+			Message: fmt.Sprintf("Deployment %s has no ready replicas to probe.", key),
+		}, nil
+	}
+
+	failed, err := probe(ctx)
+
+	return healthyCondition(failed, err), nil
+}
+
+func healthyCondition(failed []string, err error) metav1.Condition {
+	cond := metav1.Condition{
+		Type: string(operatorv1alpha1.ConditionTypeHealthy),
+	}
+
+	switch {
+	case err != nil:
+		cond.Status = metav1.ConditionUnknown
+		cond.Reason = string(operatorv1alpha1.ConditionReasonProbeFailed)
+		cond.Message = fmt.Sprintf("Failed to probe health endpoints: %v", err)
+
+	case len(failed) > 0:
+		cond.Status = metav1.ConditionFalse
+		cond.Reason = string(operatorv1alpha1.ConditionReasonChecksFailed)
+		cond.Message = fmt.Sprintf("Failing health checks: %s", strings.Join(failed, ", "))
+
+	default:
+		cond.Status = metav1.ConditionTrue
+		cond.Reason = string(operatorv1alpha1.ConditionReasonChecksPassed)
+		cond.Message = "All health checks pass."
+	}
+
+	return cond
+}
@@ -0,0 +1,96 @@
+/*
+Copyright 2026 The kcp Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package util
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	appsv1 "k8s.io/api/apps/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	operatorv1alpha1 "github.com/kcp-dev/kcp-operator/sdk/apis/operator/v1alpha1"
+)
+
+func TestGetHealthyCondition(t *testing.T) {
+	testcases := []struct {
+		name       string
+		deployment *appsv1.Deployment
+		failed     []string
+		probeErr   error
+		status     metav1.ConditionStatus
+		reason     operatorv1alpha1.ConditionReason
+		message    string
+	}{
+		{
+			name:     "no deployment yet",
+			probeErr: errors.New("must not be called"),
+			status:   metav1.ConditionUnknown,
+			reason:   operatorv1alpha1.ConditionReasonProbeFailed,
+			message:  "Deployment default/test has no ready replicas to probe.",
+		},
+		{
+			name:       "all checks pass",
+			deployment: versionTestDeployment("kcp:v0.31.0", true),
+			status:     metav1.ConditionTrue,
+			reason:     operatorv1alpha1.ConditionReasonChecksPassed,
+			message:    "All health checks pass.",
+		},
+		{
+			name:       "failing checks are listed",
+			deployment: versionTestDeployment("kcp:v0.31.0", true),
+			failed:     []string{"livez/etcd", "readyz/etcd"},
+			status:     metav1.ConditionFalse,
+			reason:     operatorv1alpha1.ConditionReasonChecksFailed,
+			message:    "Failing health checks: livez/etcd, readyz/etcd",
+		},
+		{
+			name:       "component cannot be probed",
+			deployment: versionTestDeployment("kcp:v0.31.0", true),
+			probeErr:   errors.New("connection refused"),
+			status:     metav1.ConditionUnknown,
+			reason:     operatorv1alpha1.ConditionReasonProbeFailed,
+			message:    "Failed to probe health endpoints: connection refused",
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			builder := fake.NewClientBuilder().WithScheme(GetTestScheme())
+			if tc.deployment != nil {
+				builder = builder.WithObjects(tc.deployment)
+			}
+
+			probe := func(context.Context) ([]string, error) {
+				return tc.failed, tc.probeErr
+			}
+
+			key := types.NamespacedName{Namespace: "default", Name: "test"}
+			cond, err := GetHealthyCondition(context.Background(), builder.Build(), key, probe)
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+
+			if cond.Type != string(operatorv1alpha1.ConditionTypeHealthy) || cond.Status != tc.status || cond.Reason != string(tc.reason) || cond.Message != tc.message {
+				t.Fatalf("Expected %s/%s %q, got %+v.", tc.status, tc.reason, tc.message, cond)
+			}
+		})
+	}
+}
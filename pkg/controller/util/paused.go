@@ -0,0 +1,77 @@
+/*
+Copyright 2026 The kcp Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package util
+
+import (
+	"context"
+	"fmt"
+
+	apimeta "k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	ctrlruntimeclient "sigs.k8s.io/controller-runtime/pkg/client"
+
+	operatorv1alpha1 "github.com/kcp-dev/kcp-operator/sdk/apis/operator/v1alpha1"
+)
+
+// IsPaused returns true if reconciling the object has been paused using the
+// operatorv1alpha1.PausedAnnotation.
+func IsPaused(obj metav1.Object) bool {
+	return obj.GetAnnotations()[operatorv1alpha1.PausedAnnotation] == "true"
+}
+
+// UpdatePausedCondition sets the Paused condition while the object is paused and removes it
+// otherwise.
+func UpdatePausedCondition(obj metav1.Object, conditions []metav1.Condition) []metav1.Condition {
+	if !IsPaused(obj) {
+		apimeta.RemoveStatusCondition(&conditions, string(operatorv1alpha1.ConditionTypePaused))
+		return conditions
+	}
+
+	return UpdateCondition(conditions, metav1.Condition{
+		Type:               string(operatorv1alpha1.ConditionTypePaused),
+		Status:             metav1.ConditionTrue,
+		ObservedGeneration: obj.GetGeneration(),
+		Reason:             string(operatorv1alpha1.ConditionReasonPausedByAnnotation),
+		Message:            fmt.Sprintf("Reconciliation is paused by the %s annotation.", operatorv1alpha1.PausedAnnotation),
+	})
+}
+
+// PauseCompiled propagates the operatorv1alpha1.PausedAnnotation onto the Compiled* object that
+// was rendered for a paused source object. Since the source object is not reconciled anymore,
+// this is the only way for the workload controllers to learn about the pause. Once the source
+// object is unpaused, its regular reconciliation overwrites the annotations again.
+func PauseCompiled(ctx context.Context, client ctrlruntimeclient.Client, key types.NamespacedName, compiled ctrlruntimeclient.Object) error {
+	if err := client.Get(ctx, key, compiled); err != nil {
+		return ctrlruntimeclient.IgnoreNotFound(err)
+	}
+
+	if IsPaused(compiled) {
+		return nil
+	}
+
+	original := compiled.DeepCopyObject().(ctrlruntimeclient.Object)
+
+	annotations := compiled.GetAnnotations()
+	if annotations == nil {
+		annotations = map[string]string{}
+	}
+	annotations[operatorv1alpha1.PausedAnnotation] = "true"
+	compiled.SetAnnotations(annotations)
+
+	return client.Patch(ctx, compiled, ctrlruntimeclient.MergeFrom(original))
+}
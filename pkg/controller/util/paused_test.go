@@ -0,0 +1,92 @@
+/*
+Copyright 2026 The kcp Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package util
+
+import (
+	"context"
+	"testing"
+
+	apimeta "k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	deployv1alpha1 "github.com/kcp-dev/kcp-operator/sdk/apis/deploy/v1alpha1"
+	operatorv1alpha1 "github.com/kcp-dev/kcp-operator/sdk/apis/operator/v1alpha1"
+)
+
+func TestUpdatePausedCondition(t *testing.T) {
+	shard := &operatorv1alpha1.Shard{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        "shardy",
+			Annotations: map[string]string{operatorv1alpha1.PausedAnnotation: "true"},
+		},
+	}
+
+	conditions := UpdatePausedCondition(shard, nil)
+	if cond := apimeta.FindStatusCondition(conditions, string(operatorv1alpha1.ConditionTypePaused)); cond == nil || cond.Status != metav1.ConditionTrue {
+		t.Fatalf("Expected Paused condition to be True, got %+v.", cond)
+	}
+
+	// any other value than "true" does not pause the object
+	shard.Annotations[operatorv1alpha1.PausedAnnotation] = "false"
+
+	conditions = UpdatePausedCondition(shard, conditions)
+	if cond := apimeta.FindStatusCondition(conditions, string(operatorv1alpha1.ConditionTypePaused)); cond != nil {
+		t.Fatalf("Expected Paused condition to be removed, got %+v.", cond)
+	}
+}
+
+func TestPauseCompiled(t *testing.T) {
+	ctx := context.Background()
+	key := types.NamespacedName{Namespace: "default", Name: "shardy"}
+
+	client := fake.NewClientBuilder().WithScheme(GetTestScheme()).Build()
+
+	// nothing has been rendered yet
+	if err := PauseCompiled(ctx, client, key, &deployv1alpha1.CompiledShard{}); err != nil {
+		t.Fatalf("Expected no error for missing compiled object, got %v.", err)
+	}
+
+	compiled := &deployv1alpha1.CompiledShard{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        key.Name,
+			Namespace:   key.Namespace,
+			Annotations: map[string]string{"operator.kcp.io/cert-server-revision": "1"},
+		},
+	}
+	if err := client.Create(ctx, compiled); err != nil {
+		t.Fatalf("Failed to create compiled object: %v", err)
+	}
+
+	if err := PauseCompiled(ctx, client, key, &deployv1alpha1.CompiledShard{}); err != nil {
+		t.Fatalf("Failed to pause compiled object: %v", err)
+	}
+
+	paused := &deployv1alpha1.CompiledShard{}
+	if err := client.Get(ctx, key, paused); err != nil {
+		t.Fatalf("Failed to get compiled object: %v", err)
+	}
+
+	if !IsPaused(paused) {
+		t.Fatal("Expected compiled object to be paused.")
+	}
+
+	if paused.Annotations["operator.kcp.io/cert-server-revision"] != "1" {
+		t.Fatalf("Expected existing annotations to be kept, got %v.", paused.Annotations)
+	}
+}
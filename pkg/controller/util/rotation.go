@@ -0,0 +1,266 @@
+/*
+Copyright 2026 The kcp Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package util
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	certmanagerv1 "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
+
+	apimeta "k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+	ctrlruntimeclient "sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/kcp-dev/kcp-operator/pkg/pki"
+	operatorv1alpha1 "github.com/kcp-dev/kcp-operator/sdk/apis/operator/v1alpha1"
+)
+
+// maxRotationRequestSkew is how far a requested rotation may lie in the future, to allow for
+// clock skew between the client setting the annotation and the operator.
+const maxRotationRequestSkew = 5 * time.Minute
+
+// certificateRotationRequest returns the time requested via the
+// operatorv1alpha1.RotateCertificatesAnnotation, or the zero time if there is no such request
+// or it is not due yet (see CertificateRotationRequeueAfter).
+func certificateRotationRequest(obj ctrlruntimeclient.Object, now time.Time) (time.Time, error) {
+	value := obj.GetAnnotations()[operatorv1alpha1.RotateCertificatesAnnotation]
+	if value == "" {
+		return time.Time{}, nil
+	}
+
+	requested, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("invalid %s annotation %q, must be an RFC 3339 timestamp", operatorv1alpha1.RotateCertificatesAnnotation, value)
+	}
+
+	// Certificates issued now would still be older than a timestamp in the future, and would
+	// be issued over and over again. Slightly skewed clocks are waited out instead.
+	if requested.After(now) {
+		if requested.Sub(now) <= maxRotationRequestSkew {
+			return time.Time{}, nil
+		}
+
+		return time.Time{}, fmt.Errorf("invalid %s annotation %q, must not be in the future", operatorv1alpha1.RotateCertificatesAnnotation, value)
+	}
+
+	return requested, nil
+}
+
+// CertificateRotationRequeueAfter returns the time after which a rotation requested via the
+// operatorv1alpha1.RotateCertificatesAnnotation is due, or zero if there is no pending request.
+// A request can only be pending if it was made with a clock slightly ahead of the operator's,
+// in which case the rotation needs to happen in a moment, without another event triggering it.
+func CertificateRotationRequeueAfter(obj ctrlruntimeclient.Object) time.Duration {
+	return certificateRotationRequeueAfter(obj, time.Now())
+}
+
+func certificateRotationRequeueAfter(obj ctrlruntimeclient.Object, now time.Time) time.Duration {
+	requested, err := time.Parse(time.RFC3339, obj.GetAnnotations()[operatorv1alpha1.RotateCertificatesAnnotation])
+	if err != nil || !requested.After(now) || requested.Sub(now) > maxRotationRequestSkew {
+		return 0
+	}
+
+	// RFC 3339 timestamps have a precision of seconds.
+	return requested.Sub(now) + time.Second
+}
+
+// certificatesToRotate returns the certificates that have been issued before the requested
+// time. CAs are left alone, as replacing them would break trust in every certificate they
+// issued; they are rotated via the operatorv1alpha1.RotateCAsAnnotation instead.
+func certificatesToRotate(certs []*certmanagerv1.Certificate, requested time.Time) []*certmanagerv1.Certificate {
+	var rotate []*certmanagerv1.Certificate
+	for _, cert := range certs {
+		if needsRotation(cert, requested) {
+			rotate = append(rotate, cert)
+		}
+	}
+
+	return rotate
+}
+
+func needsRotation(cert *certmanagerv1.Certificate, requested time.Time) bool {
+	return !cert.Spec.IsCA && cert.Status.NotBefore != nil && cert.Status.NotBefore.Time.Before(requested)
+}
+
+// RotateCertificates issues the certificates of obj again if they were issued before the time
+// requested via the operatorv1alpha1.RotateCertificatesAnnotation. The certificates are the
+// ones captured while reconciling them. Once reissued, their new revisions are published on
+// the Compiled* object, which rolls out the workloads using them. Requests that status reports
+// as completed or invalid are not handled again.
+func RotateCertificates(ctx context.Context, client ctrlruntimeclient.Client, backend pki.Backend, recorder *Recorder, obj ctrlruntimeclient.Object, status *operatorv1alpha1.CertificateRotationStatus, certs []*certmanagerv1.Certificate) error {
+	value := obj.GetAnnotations()[operatorv1alpha1.RotateCertificatesAnnotation]
+	handled := status != nil && status.Request == value
+	if handled && status.Phase != operatorv1alpha1.CertificateRotationPhaseRollingOut {
+		return nil
+	}
+
+	// An invalid request must not hold back the rest of the reconciliation; it is reconsidered
+	// once the annotation is changed.
+	requested, err := certificateRotationRequest(obj, time.Now())
+	if err != nil {
+		recorder.Warning(obj, EventReasonCertificatesRotated, EventActionRotateCertificates, "%v", err)
+		return nil
+	}
+
+	if requested.IsZero() {
+		return nil
+	}
+
+	rotate := certificatesToRotate(certs, requested)
+	if len(rotate) == 0 {
+		return nil
+	}
+
+	for _, cert := range rotate {
+		if err := backend.Reissue(ctx, client, cert); err != nil {
+			return fmt.Errorf("failed to reissue certificate %s: %w", cert.Name, err)
+		}
+	}
+
+	if !handled {
+		recorder.Normal(obj, EventReasonCertificatesRotated, EventActionRotateCertificates, "Issuing %d certificates again as requested at %s.", len(rotate), requested.Format(time.RFC3339))
+	}
+
+	return nil
+}
+
+// CertificateRotation reports on the rotation requested via the
+// operatorv1alpha1.RotateCertificatesAnnotation of obj. A rotation is in progress until every
+// certificate has been issued again and compiled, the Compiled* object published for obj, has
+// rolled out their new revisions. The returned condition is nil as long as no rotation has been
+// requested.
+func CertificateRotation(obj ctrlruntimeclient.Object, status *operatorv1alpha1.CertificateRotationStatus, certs []*certmanagerv1.Certificate, compiled ctrlruntimeclient.Object, compiledConditions []metav1.Condition) (*operatorv1alpha1.CertificateRotationStatus, *metav1.Condition) {
+	status = certificateRotation(obj, status, certs, compiled, compiledConditions, time.Now())
+	if status == nil {
+		return nil, nil
+	}
+
+	return status, certificateRotationCondition(status)
+}
+
+func certificateRotation(obj ctrlruntimeclient.Object, status *operatorv1alpha1.CertificateRotationStatus, certs []*certmanagerv1.Certificate, compiled ctrlruntimeclient.Object, compiledConditions []metav1.Condition, now time.Time) *operatorv1alpha1.CertificateRotationStatus {
+	// Each request is only handled once, so it is not evaluated over and over again once its
+	// rotation has completed.
+	if value := obj.GetAnnotations()[operatorv1alpha1.RotateCertificatesAnnotation]; value != "" && (status == nil || status.Request != value) {
+		requested, err := certificateRotationRequest(obj, now)
+		switch {
+		case err != nil:
+			return &operatorv1alpha1.CertificateRotationStatus{
+				Request:   value,
+				Phase:     operatorv1alpha1.CertificateRotationPhaseInvalid,
+				StartTime: metav1.NewTime(now),
+				Message:   err.Error(),
+			}
+
+		case requested.IsZero():
+			// not due yet
+
+		default:
+			status = &operatorv1alpha1.CertificateRotationStatus{
+				Request:   value,
+				Phase:     operatorv1alpha1.CertificateRotationPhaseRollingOut,
+				StartTime: metav1.NewTime(now),
+			}
+		}
+	}
+
+	// Certificates are only known if they have been reconciled.
+	if status == nil || status.Phase != operatorv1alpha1.CertificateRotationPhaseRollingOut || certs == nil {
+		return status
+	}
+
+	requested, err := time.Parse(time.RFC3339, status.Request)
+	if err != nil {
+		return status
+	}
+
+	status = status.DeepCopy()
+	status.Message = certificateRotationProgress(certs, requested, compiled, compiledConditions)
+	if status.Message == "" {
+		status.Phase = operatorv1alpha1.CertificateRotationPhaseCompleted
+		status.CompletionTime = ptr.To(metav1.NewTime(now))
+	}
+
+	return status
+}
+
+// certificateRotationProgress returns what the rotation is waiting for, or an empty string once
+// it is complete.
+func certificateRotationProgress(certs []*certmanagerv1.Certificate, requested time.Time, compiled ctrlruntimeclient.Object, compiledConditions []metav1.Condition) string {
+	pending := 0
+	revisions := make(map[string]string, len(certs))
+
+	for _, cert := range certs {
+		if needsRotation(cert, requested) || !certificateReady(cert) {
+			pending++
+			continue
+		}
+
+		revisions[CertificateRevisionAnnotation(cert.Name)] = strconv.Itoa(ptr.Deref(cert.Status.Revision, 0))
+	}
+
+	if pending > 0 {
+		return fmt.Sprintf("Waiting for %d certificates to be issued again.", pending)
+	}
+
+	// Compiled objects carry the revisions of the certificates they mount, which tells whether
+	// they have been published with the new certificates.
+	annotations := compiled.GetAnnotations()
+	for key, revision := range revisions {
+		if annotations[key] != revision {
+			return "Waiting for the new certificates to be published."
+		}
+	}
+
+	cond := apimeta.FindStatusCondition(compiledConditions, string(operatorv1alpha1.ConditionTypeAvailable))
+	if cond == nil || cond.Status != metav1.ConditionTrue || cond.ObservedGeneration != compiled.GetGeneration() {
+		return "Waiting for the workloads to roll out the new certificates."
+	}
+
+	return ""
+}
+
+func certificateRotationCondition(status *operatorv1alpha1.CertificateRotationStatus) *metav1.Condition {
+	cond := &metav1.Condition{
+		Type:    string(operatorv1alpha1.ConditionTypeCertificatesRotated),
+		Status:  metav1.ConditionFalse,
+		Message: status.Message,
+	}
+
+	switch status.Phase {
+	case operatorv1alpha1.CertificateRotationPhaseCompleted:
+		cond.Status = metav1.ConditionTrue
+		cond.Reason = string(operatorv1alpha1.ConditionReasonRotationCompleted)
+		cond.Message = fmt.Sprintf("All certificates have been rotated as requested at %s.", status.Request)
+	case operatorv1alpha1.CertificateRotationPhaseInvalid:
+		cond.Reason = string(operatorv1alpha1.ConditionReasonRotationRequestInvalid)
+	default:
+		cond.Reason = string(operatorv1alpha1.ConditionReasonRotationProgressing)
+	}
+
+	return cond
+}
+
+// CertificateRevisionAnnotation is the annotation on compiled objects that holds the revision
+// of a certificate they mount.
+func CertificateRevisionAnnotation(certName string) string {
+	return fmt.Sprintf("%s/cert-%s-revision", operatorv1alpha1.GroupName, certName)
+}
@@ -0,0 +1,302 @@
+/*
+Copyright 2026 The kcp Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package util
+
+import (
+	"testing"
+	"time"
+
+	certmanagerv1 "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
+	certmanagermetav1 "github.com/cert-manager/cert-manager/pkg/apis/meta/v1"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+
+	deployv1alpha1 "github.com/kcp-dev/kcp-operator/sdk/apis/deploy/v1alpha1"
+	operatorv1alpha1 "github.com/kcp-dev/kcp-operator/sdk/apis/operator/v1alpha1"
+)
+
+func TestCertificateRotationRequest(t *testing.T) {
+	now := time.Date(2026, 1, 2, 12, 0, 0, 0, time.UTC)
+
+	testcases := []struct {
+		name       string
+		annotation string
+		expected   time.Time
+		expectErr  bool
+	}{
+		{
+			name: "no annotation",
+		},
+		{
+			name:       "timestamp in the past",
+			annotation: "2026-01-02T11:00:00Z",
+			expected:   now.Add(-time.Hour),
+		},
+		{
+			name:       "timestamp in the future",
+			annotation: "2026-01-02T13:00:00Z",
+			expectErr:  true,
+		},
+		{
+			name:       "timestamp slightly in the future is not due yet",
+			annotation: "2026-01-02T12:01:00Z",
+		},
+		{
+			name:       "not a timestamp",
+			annotation: "now",
+			expectErr:  true,
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			shard := &operatorv1alpha1.Shard{}
+			if tc.annotation != "" {
+				shard.Annotations = map[string]string{operatorv1alpha1.RotateCertificatesAnnotation: tc.annotation}
+			}
+
+			requested, err := certificateRotationRequest(shard, now)
+			if tc.expectErr {
+				if err == nil {
+					t.Fatal("Expected an error, got none.")
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			if !requested.Equal(tc.expected) {
+				t.Fatalf("Expected %v, got %v.", tc.expected, requested)
+			}
+		})
+	}
+}
+
+func TestCertificateRotationRequeueAfter(t *testing.T) {
+	now := time.Date(2026, 1, 2, 12, 0, 0, 0, time.UTC)
+
+	testcases := []struct {
+		annotation string
+		expected   time.Duration
+	}{
+		{annotation: ""},
+		{annotation: "now"},
+		{annotation: "2026-01-02T11:00:00Z"},
+		{annotation: "2026-01-02T12:01:00Z", expected: time.Minute + time.Second},
+		{annotation: "2026-01-02T13:00:00Z"},
+	}
+
+	for _, tc := range testcases {
+		shard := &operatorv1alpha1.Shard{}
+		shard.Annotations = map[string]string{operatorv1alpha1.RotateCertificatesAnnotation: tc.annotation}
+
+		if requeueAfter := certificateRotationRequeueAfter(shard, now); requeueAfter != tc.expected {
+			t.Errorf("%q: expected requeue after %v, got %v", tc.annotation, tc.expected, requeueAfter)
+		}
+	}
+}
+
+func TestCertificatesToRotate(t *testing.T) {
+	requested := time.Date(2026, 1, 2, 12, 0, 0, 0, time.UTC)
+
+	cert := func(name string, isCA bool, notBefore *time.Time) *certmanagerv1.Certificate {
+		cert := &certmanagerv1.Certificate{
+			ObjectMeta: metav1.ObjectMeta{Name: name},
+			Spec:       certmanagerv1.CertificateSpec{IsCA: isCA},
+		}
+		if notBefore != nil {
+			cert.Status.NotBefore = &metav1.Time{Time: *notBefore}
+		}
+		return cert
+	}
+
+	before := requested.Add(-time.Hour)
+	after := requested.Add(time.Second)
+
+	rotate := certificatesToRotate([]*certmanagerv1.Certificate{
+		cert("old", false, &before),
+		cert("new", false, &after),
+		cert("same", false, &requested),
+		cert("pending", false, nil),
+		cert("ca", true, &before),
+	}, requested)
+
+	if len(rotate) != 1 || rotate[0].Name != "old" {
+		t.Fatalf("Expected only the old certificate to be rotated, got %+v.", rotate)
+	}
+}
+
+func TestCertificateRotation(t *testing.T) {
+	now := time.Date(2026, 1, 2, 12, 0, 0, 0, time.UTC)
+	request := "2026-01-02T11:00:00Z"
+
+	issuedCert := func(notBefore time.Time, revision int) *certmanagerv1.Certificate {
+		return &certmanagerv1.Certificate{
+			ObjectMeta: metav1.ObjectMeta{Name: "server"},
+			Status: certmanagerv1.CertificateStatus{
+				NotBefore: &metav1.Time{Time: notBefore},
+				Revision:  ptr.To(revision),
+				Conditions: []certmanagerv1.CertificateCondition{{
+					Type:   certmanagerv1.CertificateConditionReady,
+					Status: certmanagermetav1.ConditionTrue,
+				}},
+			},
+		}
+	}
+
+	compiled := func(revision string, available metav1.ConditionStatus) *deployv1alpha1.CompiledShard {
+		compiled := &deployv1alpha1.CompiledShard{
+			ObjectMeta: metav1.ObjectMeta{
+				Generation:  2,
+				Annotations: map[string]string{CertificateRevisionAnnotation("server"): revision},
+			},
+		}
+		compiled.Status.Conditions = []metav1.Condition{{
+			Type:               string(operatorv1alpha1.ConditionTypeAvailable),
+			Status:             available,
+			ObservedGeneration: 2,
+		}}
+		return compiled
+	}
+
+	rollingOut := &operatorv1alpha1.CertificateRotationStatus{
+		Request:   request,
+		Phase:     operatorv1alpha1.CertificateRotationPhaseRollingOut,
+		StartTime: metav1.NewTime(now.Add(-time.Minute)),
+	}
+	completed := &operatorv1alpha1.CertificateRotationStatus{
+		Request:        request,
+		Phase:          operatorv1alpha1.CertificateRotationPhaseCompleted,
+		StartTime:      metav1.NewTime(now.Add(-time.Hour)),
+		CompletionTime: ptr.To(metav1.NewTime(now.Add(-time.Minute))),
+	}
+
+	testcases := []struct {
+		name          string
+		annotation    string
+		status        *operatorv1alpha1.CertificateRotationStatus
+		certs         []*certmanagerv1.Certificate
+		compiled      *deployv1alpha1.CompiledShard
+		expectedPhase operatorv1alpha1.CertificateRotationPhase
+		expectedMsg   string
+	}{
+		{
+			name: "no request",
+		},
+		{
+			name:          "new request starts rolling out",
+			annotation:    request,
+			certs:         []*certmanagerv1.Certificate{issuedCert(now.Add(-24*time.Hour), 1)},
+			compiled:      compiled("1", metav1.ConditionTrue),
+			expectedPhase: operatorv1alpha1.CertificateRotationPhaseRollingOut,
+			expectedMsg:   "Waiting for 1 certificates to be issued again.",
+		},
+		{
+			name:          "invalid request",
+			annotation:    "now",
+			certs:         []*certmanagerv1.Certificate{issuedCert(now.Add(-24*time.Hour), 1)},
+			compiled:      compiled("1", metav1.ConditionTrue),
+			expectedPhase: operatorv1alpha1.CertificateRotationPhaseInvalid,
+		},
+		{
+			name:          "new revision not published yet",
+			annotation:    request,
+			status:        rollingOut,
+			certs:         []*certmanagerv1.Certificate{issuedCert(now, 2)},
+			compiled:      compiled("1", metav1.ConditionTrue),
+			expectedPhase: operatorv1alpha1.CertificateRotationPhaseRollingOut,
+			expectedMsg:   "Waiting for the new certificates to be published.",
+		},
+		{
+			name:          "new revision not rolled out yet",
+			annotation:    request,
+			status:        rollingOut,
+			certs:         []*certmanagerv1.Certificate{issuedCert(now, 2)},
+			compiled:      compiled("2", metav1.ConditionFalse),
+			expectedPhase: operatorv1alpha1.CertificateRotationPhaseRollingOut,
+			expectedMsg:   "Waiting for the workloads to roll out the new certificates.",
+		},
+		{
+			name:          "new revision rolled out",
+			annotation:    request,
+			status:        rollingOut,
+			certs:         []*certmanagerv1.Certificate{issuedCert(now, 2)},
+			compiled:      compiled("2", metav1.ConditionTrue),
+			expectedPhase: operatorv1alpha1.CertificateRotationPhaseCompleted,
+		},
+		{
+			name:          "completed request is not evaluated again",
+			annotation:    request,
+			status:        completed,
+			certs:         []*certmanagerv1.Certificate{issuedCert(now.Add(-24*time.Hour), 1)},
+			compiled:      compiled("1", metav1.ConditionFalse),
+			expectedPhase: operatorv1alpha1.CertificateRotationPhaseCompleted,
+		},
+		{
+			name:          "new request after a completed one",
+			annotation:    "2026-01-02T11:30:00Z",
+			status:        completed,
+			certs:         []*certmanagerv1.Certificate{issuedCert(now.Add(-time.Hour), 1)},
+			compiled:      compiled("1", metav1.ConditionTrue),
+			expectedPhase: operatorv1alpha1.CertificateRotationPhaseRollingOut,
+			expectedMsg:   "Waiting for 1 certificates to be issued again.",
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			shard := &operatorv1alpha1.Shard{}
+			if tc.annotation != "" {
+				shard.Annotations = map[string]string{operatorv1alpha1.RotateCertificatesAnnotation: tc.annotation}
+			}
+
+			compiledShard := tc.compiled
+			if compiledShard == nil {
+				compiledShard = &deployv1alpha1.CompiledShard{}
+			}
+
+			status := certificateRotation(shard, tc.status, tc.certs, compiledShard, compiledShard.Status.Conditions, now)
+			if tc.expectedPhase == "" {
+				if status != nil {
+					t.Fatalf("Expected no rotation status, got %+v.", status)
+				}
+				return
+			}
+
+			if status == nil {
+				t.Fatal("Expected a rotation status, got none.")
+			}
+			if status.Phase != tc.expectedPhase {
+				t.Fatalf("Expected phase %q, got %q (%s).", tc.expectedPhase, status.Phase, status.Message)
+			}
+			if tc.expectedMsg != "" && status.Message != tc.expectedMsg {
+				t.Errorf("Expected message %q, got %q.", tc.expectedMsg, status.Message)
+			}
+			if status.Request != tc.annotation {
+				t.Errorf("Expected request %q, got %q.", tc.annotation, status.Request)
+			}
+			if (status.Phase == operatorv1alpha1.CertificateRotationPhaseCompleted) != (status.CompletionTime != nil) {
+				t.Errorf("Expected a completion time only for completed rotations, got %v.", status.CompletionTime)
+			}
+			if tc.status == completed && tc.annotation == completed.Request && status != completed {
+				t.Error("Expected a completed rotation to be kept as it is.")
+			}
+		})
+	}
+}
@@ -22,10 +22,14 @@ import (
 	certmanagerv1 "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
 
 	appsv1 "k8s.io/api/apps/v1"
+	autoscalingv2 "k8s.io/api/autoscaling/v2"
+	batchv1 "k8s.io/api/batch/v1"
 	corev1 "k8s.io/api/core/v1"
+	policyv1 "k8s.io/api/policy/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	"k8s.io/client-go/tools/events"
 	ctrlruntimeclient "sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/cluster"
 	"sigs.k8s.io/multicluster-runtime/pkg/multicluster"
@@ -42,6 +46,9 @@ func GetTestScheme() *runtime.Scheme {
 	utilruntime.Must(deployv1alpha1.AddToScheme(scheme))
 	utilruntime.Must(certmanagerv1.AddToScheme(scheme))
 	utilruntime.Must(appsv1.AddToScheme(scheme))
+	utilruntime.Must(batchv1.AddToScheme(scheme))
+	utilruntime.Must(policyv1.AddToScheme(scheme))
+	utilruntime.Must(autoscalingv2.AddToScheme(scheme))
 
 	return scheme
 }
@@ -49,8 +56,13 @@ func GetTestScheme() *runtime.Scheme {
 // FakeSingleCluster serves the given client for every cluster name, so a reconciler can be tested
 // without a manager or a provider.
 func FakeSingleCluster(c ctrlruntimeclient.Client) func(context.Context, multicluster.ClusterName) (cluster.Cluster, error) {
+	return FakeSingleClusterWithEvents(c, &events.FakeRecorder{})
+}
+
+// FakeSingleClusterWithEvents is like FakeSingleCluster, but records all Events using the given recorder.
+func FakeSingleClusterWithEvents(c ctrlruntimeclient.Client, recorder events.EventRecorder) func(context.Context, multicluster.ClusterName) (cluster.Cluster, error) {
 	return func(context.Context, multicluster.ClusterName) (cluster.Cluster, error) {
-		return &testCluster{client: c}, nil
+		return &testCluster{client: c, recorder: recorder}, nil
 	}
 }
 
@@ -58,7 +70,8 @@ func FakeSingleCluster(c ctrlruntimeclient.Client) func(context.Context, multicl
 type testCluster struct {
 	cluster.Cluster
 
-	client ctrlruntimeclient.Client
+	client   ctrlruntimeclient.Client
+	recorder events.EventRecorder
 }
 
 func (c *testCluster) GetClient() ctrlruntimeclient.Client {
@@ -68,3 +81,7 @@ func (c *testCluster) GetClient() ctrlruntimeclient.Client {
 func (c *testCluster) GetScheme() *runtime.Scheme {
 	return c.client.Scheme()
 }
+
+func (c *testCluster) GetEventRecorder(string) events.EventRecorder {
+	return c.recorder
+}
@@ -0,0 +1,380 @@
+/*
+Copyright 2026 The kcp Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package util
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/Masterminds/semver/v3"
+
+	apimeta "k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	ctrlruntimeclient "sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/kcp-dev/kcp-operator/internal/resources"
+	deployv1alpha1 "github.com/kcp-dev/kcp-operator/sdk/apis/deploy/v1alpha1"
+	operatorv1alpha1 "github.com/kcp-dev/kcp-operator/sdk/apis/operator/v1alpha1"
+)
+
+// UpgradeStep determines the order in which the components of a kcp installation are upgraded.
+// A component only changes its version once all components of the previous steps run their
+// desired version and are available.
+type UpgradeStep int
+
+const (
+	UpgradeStepCacheServer UpgradeStep = iota
+	UpgradeStepRootShard
+	UpgradeStepShards
+	UpgradeStepVirtualWorkspaces
+	UpgradeStepFrontProxies
+)
+
+const (
+	UpgradeKindCacheServer      = "CacheServer"
+	UpgradeKindRootShard        = "RootShard"
+	UpgradeKindShard            = "Shard"
+	UpgradeKindVirtualWorkspace = "VirtualWorkspace"
+	UpgradeKindFrontProxy       = "FrontProxy"
+	// UpgradeKindRootShardProxy is the internal front-proxy of a root shard. It is rendered as part of
+	// the CompiledRootShard, but upgraded together with all other front-proxies.
+	UpgradeKindRootShardProxy = "RootShardProxy"
+)
+
+// UpgradeComponent is a single versioned workload of a kcp installation.
+type UpgradeComponent struct {
+	Step UpgradeStep
+	Kind string
+	Name string
+
+	// Desired is the image configured on the source object.
+	Desired *operatorv1alpha1.ImageSpec
+	// Current is the image in the Compiled* object and only meaningful if Compiled is true.
+	Current *operatorv1alpha1.ImageSpec
+	// Compiled is false if no Compiled* object exists for the component yet.
+	Compiled bool
+	// Available is true if the Compiled* object reports its current generation as available.
+	Available bool
+	// Object is the Compiled* object, it is nil if Compiled is false.
+	Object ctrlruntimeclient.Object
+}
+
+func (c *UpgradeComponent) upToDate() bool {
+	if !c.Compiled {
+		return false
+	}
+
+	current, _, _ := resources.GetImageSettings(c.Current)
+	desired, _, _ := resources.GetImageSettings(c.Desired)
+
+	return current == desired
+}
+
+func (c *UpgradeComponent) settled() bool {
+	return c.upToDate() && c.Available
+}
+
+// UpgradeDecision tells a controller which image to render for a component.
+type UpgradeDecision struct {
+	State   operatorv1alpha1.UpgradeState
+	Message string
+
+	// Image is the image to render instead of the configured one. It is only meaningful if the
+	// component is held back, in which case it is the currently rolled out image.
+	Image *operatorv1alpha1.ImageSpec
+}
+
+// HeldBack returns true if the component must stay on its current image.
+func (d UpgradeDecision) HeldBack() bool {
+	return d.State == operatorv1alpha1.UpgradeStateWaiting || d.State == operatorv1alpha1.UpgradeStateBlocked
+}
+
+// UpgradePlan orders all components of a kcp installation and decides which of them are allowed
+// to change their version.
+type UpgradePlan struct {
+	components []UpgradeComponent
+}
+
+func NewUpgradePlan(components []UpgradeComponent) *UpgradePlan {
+	components = slices.Clone(components)
+	slices.SortStableFunc(components, func(a, b UpgradeComponent) int {
+		return int(a.Step) - int(b.Step)
+	})
+
+	return &UpgradePlan{components: components}
+}
+
+// Components returns all components in upgrade order.
+func (p *UpgradePlan) Components() []UpgradeComponent {
+	return p.components
+}
+
+// Decide determines whether the given component may roll out its desired image. Components that
+// are not part of the plan are never held back.
+func (p *UpgradePlan) Decide(kind, name string) UpgradeDecision {
+	idx := slices.IndexFunc(p.components, func(c UpgradeComponent) bool {
+		return c.Kind == kind && c.Name == name
+	})
+	if idx < 0 {
+		return UpgradeDecision{State: operatorv1alpha1.UpgradeStateProgressing}
+	}
+
+	return p.decide(&p.components[idx])
+}
+
+func (p *UpgradePlan) decide(component *UpgradeComponent) UpgradeDecision {
+	switch {
+	// New components are simply deployed with their desired version.
+	case !component.Compiled:
+		return UpgradeDecision{State: operatorv1alpha1.UpgradeStateProgressing}
+
+	case component.upToDate():
+		if component.Available {
+			return UpgradeDecision{State: operatorv1alpha1.UpgradeStateUpToDate}
+		}
+		return UpgradeDecision{State: operatorv1alpha1.UpgradeStateProgressing}
+	}
+
+	if msg := p.checkSkew(component); msg != "" {
+		return UpgradeDecision{State: operatorv1alpha1.UpgradeStateBlocked, Message: msg, Image: component.Current}
+	}
+
+	for i := range p.components {
+		other := &p.components[i]
+		if other.Step >= component.Step {
+			break
+		}
+
+		if !other.settled() {
+			return UpgradeDecision{
+				State:   operatorv1alpha1.UpgradeStateWaiting,
+				Message: fmt.Sprintf("Waiting for %s %s to be upgraded and available.", other.Kind, other.Name),
+				Image:   component.Current,
+			}
+		}
+	}
+
+	return UpgradeDecision{State: operatorv1alpha1.UpgradeStateProgressing}
+}
+
+// checkSkew returns a message if rolling out the desired version of the component would result
+// in more than one minor version between it and any other component. Versions that cannot be
+// parsed (i.e. custom image tags) are not checked.
+func (p *UpgradePlan) checkSkew(component *UpgradeComponent) string {
+	_, _, desired := resources.GetImageSettings(component.Desired)
+	if desired == nil {
+		return ""
+	}
+
+	for _, other := range p.components {
+		if !other.Compiled {
+			continue
+		}
+
+		_, _, current := resources.GetImageSettings(other.Current)
+		if current == nil || supportedSkew(desired, current) {
+			continue
+		}
+
+		return fmt.Sprintf("Upgrading to %s is not supported while %s %s runs %s, only one minor version of skew is allowed.", desired.Original(), other.Kind, other.Name, current.Original())
+	}
+
+	return ""
+}
+
+func supportedSkew(a, b *semver.Version) bool {
+	if a.Major() != b.Major() {
+		return false
+	}
+
+	return max(a.Minor(), b.Minor())-min(a.Minor(), b.Minor()) <= 1
+}
+
+// Status summarizes the plan for the RootShard status.
+func (p *UpgradePlan) Status() *operatorv1alpha1.UpgradeStatus {
+	status := &operatorv1alpha1.UpgradeStatus{
+		Components: make([]operatorv1alpha1.ComponentUpgradeStatus, 0, len(p.components)),
+	}
+
+	for i := range p.components {
+		component := &p.components[i]
+		decision := p.decide(component)
+
+		cs := operatorv1alpha1.ComponentUpgradeStatus{
+			Kind:           component.Kind,
+			Name:           component.Name,
+			DesiredVersion: imageVersion(component.Desired),
+			State:          decision.State,
+			Message:        decision.Message,
+		}
+		if component.Compiled {
+			cs.CurrentVersion = imageVersion(component.Current)
+		}
+
+		status.Components = append(status.Components, cs)
+	}
+
+	return status
+}
+
+func imageVersion(imageSpec *operatorv1alpha1.ImageSpec) string {
+	image, _, _ := resources.GetImageSettings(imageSpec)
+	return image[strings.LastIndex(image, ":")+1:]
+}
+
+// +kubebuilder:rbac:groups=operator.kcp.io,resources=rootshards;shards;virtualworkspaces;frontproxies;cacheservers,verbs=get;list;watch
+// +kubebuilder:rbac:groups=deploy.operator.kcp.io,resources=compiledrootshards;compiledshards;compiledvirtualworkspaces;compiledfrontproxies;compiledcacheservers,verbs=get;list;watch
+
+// GetUpgradePlan collects all components belonging to the given root shard, together with the
+// images currently rolled out for them.
+func GetUpgradePlan(ctx context.Context, client ctrlruntimeclient.Client, rootShard *operatorv1alpha1.RootShard) (*UpgradePlan, error) {
+	var components []UpgradeComponent
+
+	if rootShard.Spec.Cache.Reference != nil {
+		server := &operatorv1alpha1.CacheServer{}
+		key := types.NamespacedName{Namespace: rootShard.Namespace, Name: rootShard.Spec.Cache.Reference.Name}
+		if err := client.Get(ctx, key, server); ctrlruntimeclient.IgnoreNotFound(err) != nil {
+			return nil, fmt.Errorf("failed to get CacheServer: %w", err)
+		} else if err == nil {
+			compiled := &deployv1alpha1.CompiledCacheServer{}
+			component, err := newUpgradeComponent(ctx, client, key, compiled, func() (*operatorv1alpha1.ImageSpec, []metav1.Condition) {
+				return compiled.Spec.CacheServer.Image, compiled.Status.Conditions
+			})
+			if err != nil {
+				return nil, err
+			}
+
+			component.Step, component.Kind, component.Name, component.Desired = UpgradeStepCacheServer, UpgradeKindCacheServer, server.Name, server.Spec.Image
+			components = append(components, component)
+		}
+	}
+
+	compiledRootShard := &deployv1alpha1.CompiledRootShard{}
+	key := types.NamespacedName{Namespace: rootShard.Namespace, Name: rootShard.Name}
+	component, err := newUpgradeComponent(ctx, client, key, compiledRootShard, func() (*operatorv1alpha1.ImageSpec, []metav1.Condition) {
+		return compiledRootShard.Spec.RootShard.Image, compiledRootShard.Status.Conditions
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	component.Step, component.Kind, component.Name, component.Desired = UpgradeStepRootShard, UpgradeKindRootShard, rootShard.Name, rootShard.Spec.Image
+	components = append(components, component)
+
+	// The root shard proxy lives and dies with the CompiledRootShard.
+	proxy := component
+	proxy.Step, proxy.Kind, proxy.Desired, proxy.Current = UpgradeStepFrontProxies, UpgradeKindRootShardProxy, nil, nil
+	if rootShard.Spec.Proxy != nil {
+		proxy.Desired = rootShard.Spec.Proxy.Image
+	}
+	if compiledRootShard.Spec.RootShard.Proxy != nil {
+		proxy.Current = compiledRootShard.Spec.RootShard.Proxy.Image
+	}
+	components = append(components, proxy)
+
+	shards, err := GetRootShardChildren(ctx, client, rootShard)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list shards: %w", err)
+	}
+
+	shardNames := make([]string, 0, len(shards))
+	for _, shard := range shards {
+		compiled := &deployv1alpha1.CompiledShard{}
+		component, err := newUpgradeComponent(ctx, client, ctrlruntimeclient.ObjectKeyFromObject(&shard), compiled, func() (*operatorv1alpha1.ImageSpec, []metav1.Condition) {
+			return compiled.Spec.Shard.Image, compiled.Status.Conditions
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		component.Step, component.Kind, component.Name, component.Desired = UpgradeStepShards, UpgradeKindShard, shard.Name, shard.Spec.Image
+		components = append(components, component)
+		shardNames = append(shardNames, shard.Name)
+	}
+
+	var vws operatorv1alpha1.VirtualWorkspaceList
+	if err := client.List(ctx, &vws, ctrlruntimeclient.InNamespace(rootShard.Namespace)); err != nil {
+		return nil, fmt.Errorf("failed to list VirtualWorkspaces: %w", err)
+	}
+
+	for _, vw := range vws.Items {
+		target := vw.Spec.Target
+		if (target.RootShardRef == nil || target.RootShardRef.Name != rootShard.Name) && (target.ShardRef == nil || !slices.Contains(shardNames, target.ShardRef.Name)) {
+			continue
+		}
+
+		compiled := &deployv1alpha1.CompiledVirtualWorkspace{}
+		component, err := newUpgradeComponent(ctx, client, ctrlruntimeclient.ObjectKeyFromObject(&vw), compiled, func() (*operatorv1alpha1.ImageSpec, []metav1.Condition) {
+			return compiled.Spec.VirtualWorkspace.Image, compiled.Status.Conditions
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		component.Step, component.Kind, component.Name, component.Desired = UpgradeStepVirtualWorkspaces, UpgradeKindVirtualWorkspace, vw.Name, vw.Spec.Image
+		components = append(components, component)
+	}
+
+	var frontProxies operatorv1alpha1.FrontProxyList
+	if err := client.List(ctx, &frontProxies, ctrlruntimeclient.InNamespace(rootShard.Namespace)); err != nil {
+		return nil, fmt.Errorf("failed to list FrontProxies: %w", err)
+	}
+
+	for _, frontProxy := range frontProxies.Items {
+		if ref := frontProxy.Spec.RootShard.Reference; ref == nil || ref.Name != rootShard.Name {
+			continue
+		}
+
+		compiled := &deployv1alpha1.CompiledFrontProxy{}
+		component, err := newUpgradeComponent(ctx, client, ctrlruntimeclient.ObjectKeyFromObject(&frontProxy), compiled, func() (*operatorv1alpha1.ImageSpec, []metav1.Condition) {
+			return compiled.Spec.FrontProxy.Image, compiled.Status.Conditions
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		component.Step, component.Kind, component.Name, component.Desired = UpgradeStepFrontProxies, UpgradeKindFrontProxy, frontProxy.Name, frontProxy.Spec.Image
+		components = append(components, component)
+	}
+
+	return NewUpgradePlan(components), nil
+}
+
+// newUpgradeComponent fetches the Compiled* object and fills in the current image and
+// availability; the caller is responsible for identifying the component.
+func newUpgradeComponent(ctx context.Context, client ctrlruntimeclient.Client, key types.NamespacedName, compiled ctrlruntimeclient.Object, extract func() (*operatorv1alpha1.ImageSpec, []metav1.Condition)) (UpgradeComponent, error) {
+	if err := client.Get(ctx, key, compiled); err != nil {
+		if ctrlruntimeclient.IgnoreNotFound(err) != nil {
+			return UpgradeComponent{}, fmt.Errorf("failed to get %T %s: %w", compiled, key.Name, err)
+		}
+
+		return UpgradeComponent{}, nil
+	}
+
+	image, conditions := extract()
+	cond := apimeta.FindStatusCondition(conditions, string(operatorv1alpha1.ConditionTypeAvailable))
+
+	return UpgradeComponent{
+		Current:   image,
+		Compiled:  true,
+		Available: cond != nil && cond.Status == metav1.ConditionTrue && cond.ObservedGeneration == compiled.GetGeneration(),
+		Object:    compiled,
+	}, nil
+}
@@ -0,0 +1,205 @@
+/*
+Copyright 2026 The kcp Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package util
+
+import (
+	"testing"
+
+	operatorv1alpha1 "github.com/kcp-dev/kcp-operator/sdk/apis/operator/v1alpha1"
+)
+
+func image(tag string) *operatorv1alpha1.ImageSpec {
+	return &operatorv1alpha1.ImageSpec{Tag: tag}
+}
+
+// settledComponent runs its desired version and is available.
+func settledComponent(step UpgradeStep, kind, name, tag string) UpgradeComponent {
+	return UpgradeComponent{
+		Step:      step,
+		Kind:      kind,
+		Name:      name,
+		Desired:   image(tag),
+		Current:   image(tag),
+		Compiled:  true,
+		Available: true,
+	}
+}
+
+func upgradingComponent(step UpgradeStep, kind, name, from, to string) UpgradeComponent {
+	c := settledComponent(step, kind, name, from)
+	c.Desired = image(to)
+	return c
+}
+
+func TestUpgradePlanDecide(t *testing.T) {
+	testcases := []struct {
+		name       string
+		components []UpgradeComponent
+		kind       string
+		expected   operatorv1alpha1.UpgradeState
+	}{
+		{
+			name: "up to date",
+			components: []UpgradeComponent{
+				settledComponent(UpgradeStepRootShard, UpgradeKindRootShard, "root", "v0.30.0"),
+			},
+			kind:     UpgradeKindRootShard,
+			expected: operatorv1alpha1.UpgradeStateUpToDate,
+		},
+		{
+			name: "not yet compiled",
+			components: []UpgradeComponent{
+				settledComponent(UpgradeStepRootShard, UpgradeKindRootShard, "root", "v0.30.0"),
+				{Step: UpgradeStepShards, Kind: UpgradeKindShard, Name: "test", Desired: image("v0.40.0")},
+			},
+			kind:     UpgradeKindShard,
+			expected: operatorv1alpha1.UpgradeStateProgressing,
+		},
+		{
+			name: "first step upgrades immediately",
+			components: []UpgradeComponent{
+				upgradingComponent(UpgradeStepRootShard, UpgradeKindRootShard, "root", "v0.30.0", "v0.31.0"),
+				upgradingComponent(UpgradeStepShards, UpgradeKindShard, "test", "v0.30.0", "v0.31.0"),
+			},
+			kind:     UpgradeKindRootShard,
+			expected: operatorv1alpha1.UpgradeStateProgressing,
+		},
+		{
+			name: "later step waits for previous step to be upgraded",
+			components: []UpgradeComponent{
+				upgradingComponent(UpgradeStepRootShard, UpgradeKindRootShard, "root", "v0.30.0", "v0.31.0"),
+				upgradingComponent(UpgradeStepShards, UpgradeKindShard, "test", "v0.30.0", "v0.31.0"),
+			},
+			kind:     UpgradeKindShard,
+			expected: operatorv1alpha1.UpgradeStateWaiting,
+		},
+		{
+			name: "later step waits for previous step to be available",
+			components: []UpgradeComponent{
+				func() UpgradeComponent {
+					c := settledComponent(UpgradeStepRootShard, UpgradeKindRootShard, "root", "v0.31.0")
+					c.Available = false
+					return c
+				}(),
+				upgradingComponent(UpgradeStepFrontProxies, UpgradeKindFrontProxy, "test", "v0.30.0", "v0.31.0"),
+			},
+			kind:     UpgradeKindFrontProxy,
+			expected: operatorv1alpha1.UpgradeStateWaiting,
+		},
+		{
+			name: "later step upgrades once previous steps are done",
+			components: []UpgradeComponent{
+				settledComponent(UpgradeStepCacheServer, UpgradeKindCacheServer, "cache", "v0.31.0"),
+				settledComponent(UpgradeStepRootShard, UpgradeKindRootShard, "root", "v0.31.0"),
+				upgradingComponent(UpgradeStepShards, UpgradeKindShard, "test", "v0.30.0", "v0.31.0"),
+				upgradingComponent(UpgradeStepFrontProxies, UpgradeKindFrontProxy, "test", "v0.30.0", "v0.31.0"),
+			},
+			kind:     UpgradeKindShard,
+			expected: operatorv1alpha1.UpgradeStateProgressing,
+		},
+		{
+			name: "skipping a minor version is blocked",
+			components: []UpgradeComponent{
+				upgradingComponent(UpgradeStepRootShard, UpgradeKindRootShard, "root", "v0.30.0", "v0.32.0"),
+			},
+			kind:     UpgradeKindRootShard,
+			expected: operatorv1alpha1.UpgradeStateBlocked,
+		},
+		{
+			name: "skew towards other components is blocked",
+			components: []UpgradeComponent{
+				settledComponent(UpgradeStepRootShard, UpgradeKindRootShard, "root", "v0.31.0"),
+				settledComponent(UpgradeStepShards, UpgradeKindShard, "test", "v0.30.0"),
+				upgradingComponent(UpgradeStepFrontProxies, UpgradeKindFrontProxy, "test", "v0.31.0", "v0.32.0"),
+			},
+			kind:     UpgradeKindFrontProxy,
+			expected: operatorv1alpha1.UpgradeStateBlocked,
+		},
+		{
+			name: "major version change is blocked",
+			components: []UpgradeComponent{
+				upgradingComponent(UpgradeStepRootShard, UpgradeKindRootShard, "root", "v0.30.0", "v1.0.0"),
+			},
+			kind:     UpgradeKindRootShard,
+			expected: operatorv1alpha1.UpgradeStateBlocked,
+		},
+		{
+			name: "custom tags are not checked for skew",
+			components: []UpgradeComponent{
+				upgradingComponent(UpgradeStepRootShard, UpgradeKindRootShard, "root", "v0.30.0", "main"),
+			},
+			kind:     UpgradeKindRootShard,
+			expected: operatorv1alpha1.UpgradeStateProgressing,
+		},
+		{
+			name:     "unknown components are never held back",
+			kind:     UpgradeKindShard,
+			expected: operatorv1alpha1.UpgradeStateProgressing,
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			name := "test"
+			if tc.kind == UpgradeKindRootShard {
+				name = "root"
+			}
+
+			decision := NewUpgradePlan(tc.components).Decide(tc.kind, name)
+			if decision.State != tc.expected {
+				t.Fatalf("Expected state %q, got %q (%s).", tc.expected, decision.State, decision.Message)
+			}
+
+			if decision.HeldBack() {
+				for _, c := range tc.components {
+					if c.Kind == tc.kind && c.Name == name && decision.Image != c.Current {
+						t.Fatalf("Expected held back component to keep its current image, got %v.", decision.Image)
+					}
+				}
+			}
+		})
+	}
+}
+
+func TestUpgradePlanStatus(t *testing.T) {
+	plan := NewUpgradePlan([]UpgradeComponent{
+		upgradingComponent(UpgradeStepFrontProxies, UpgradeKindFrontProxy, "proxy", "v0.30.0", "v0.31.0"),
+		upgradingComponent(UpgradeStepRootShard, UpgradeKindRootShard, "root", "v0.30.0", "v0.31.0"),
+		{Step: UpgradeStepShards, Kind: UpgradeKindShard, Name: "new", Desired: image("v0.31.0")},
+	})
+
+	status := plan.Status()
+
+	expected := []operatorv1alpha1.ComponentUpgradeStatus{
+		{Kind: UpgradeKindRootShard, Name: "root", CurrentVersion: "v0.30.0", DesiredVersion: "v0.31.0", State: operatorv1alpha1.UpgradeStateProgressing},
+		{Kind: UpgradeKindShard, Name: "new", DesiredVersion: "v0.31.0", State: operatorv1alpha1.UpgradeStateProgressing},
+		{Kind: UpgradeKindFrontProxy, Name: "proxy", CurrentVersion: "v0.30.0", DesiredVersion: "v0.31.0", State: operatorv1alpha1.UpgradeStateWaiting},
+	}
+
+	if len(status.Components) != len(expected) {
+		t.Fatalf("Expected %d components, got %d.", len(expected), len(status.Components))
+	}
+
+	for i, e := range expected {
+		got := status.Components[i]
+		got.Message = ""
+
+		if got != e {
+			t.Errorf("Expected component %d to be %+v, got %+v.", i, e, got)
+		}
+	}
+}
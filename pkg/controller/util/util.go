@@ -22,6 +22,7 @@ import (
 	"slices"
 	"strconv"
 	"strings"
+	"time"
 
 	certmanagerv1 "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
 	certmanagermetav1 "github.com/cert-manager/cert-manager/pkg/apis/meta/v1"
@@ -42,6 +43,9 @@ import (
 	mchandler "sigs.k8s.io/multicluster-runtime/pkg/handler"
 	"sigs.k8s.io/multicluster-runtime/pkg/multicluster"
 
+	"github.com/kcp-dev/kcp-operator/internal/resources"
+	"github.com/kcp-dev/kcp-operator/pkg/metrics"
+	"github.com/kcp-dev/kcp-operator/pkg/pki"
 	operatorv1alpha1 "github.com/kcp-dev/kcp-operator/sdk/apis/operator/v1alpha1"
 )
 
@@ -129,7 +133,10 @@ func EnqueueAllInNamespace(newList func() ctrlruntimeclient.ObjectList) mchandle
 }
 
 func deploymentReady(dep appsv1.Deployment) bool {
-	return dep.Status.UpdatedReplicas == dep.Status.ReadyReplicas && dep.Status.ReadyReplicas == ptr.Deref(dep.Spec.Replicas, 0)
+	// Until the Deployment controller has observed the latest spec, the replica counts still
+	// describe the previous rollout.
+	return dep.Status.ObservedGeneration >= dep.Generation &&
+		dep.Status.UpdatedReplicas == dep.Status.ReadyReplicas && dep.Status.ReadyReplicas == ptr.Deref(dep.Spec.Replicas, 0)
 }
 
 // CertificateRevisions returns a map of name to revision for each certificate.
@@ -157,6 +164,133 @@ func certificateReady(cert *certmanagerv1.Certificate) bool {
 	return false
 }
 
+// CertificateInventory describes the given certificates for the status of the object they
+// were reconciled for, sorted by name.
+func CertificateInventory(certs []*certmanagerv1.Certificate) []operatorv1alpha1.CertificateStatus {
+	inventory := make([]operatorv1alpha1.CertificateStatus, 0, len(certs))
+
+	for _, cert := range certs {
+		inventory = append(inventory, operatorv1alpha1.CertificateStatus{
+			Name:        cert.Name,
+			Certificate: operatorv1alpha1.Certificate(cert.Labels[resources.CertificateLabel]),
+			CA:          operatorv1alpha1.CA(cert.Labels[resources.CALabel]),
+			SecretName:  cert.Spec.SecretName,
+			Issuer:      cert.Spec.IssuerRef.Name,
+			NotAfter:    cert.Status.NotAfter,
+			Revision:    ptr.Deref(cert.Status.Revision, 0),
+			Ready:       certificateReady(cert),
+		})
+	}
+
+	slices.SortFunc(inventory, func(a, b operatorv1alpha1.CertificateStatus) int {
+		return strings.Compare(a.Name, b.Name)
+	})
+
+	return inventory
+}
+
+// CertificateNotReadyThreshold is the time a Certificate may take to become ready before the
+// CertificatesReady condition of its owner turns False.
+const CertificateNotReadyThreshold = 10 * time.Minute
+
+// CertificateRenewalMinInterval is the shortest time after which an owner is reconciled again
+// to renew its certificates, so that a renewal that is overdue does not cause a hot loop.
+const CertificateRenewalMinInterval = 5 * time.Second
+
+// GetCertificatesReadyCondition reports on the Certificates controlled by owner. As issuing a
+// certificate takes a moment, Certificates are only reported once they have not been ready for
+// longer than CertificateNotReadyThreshold. The returned duration is the time after which the
+// condition has to be checked again or a certificate has to be renewed, or zero if neither
+// happens by itself.
+func GetCertificatesReadyCondition(ctx context.Context, client ctrlruntimeclient.Client, backend pki.Backend, owner metav1.Object) (metav1.Condition, time.Duration, error) {
+	certs, err := backend.ListCertificates(ctx, client, ctrlruntimeclient.InNamespace(owner.GetNamespace()))
+	if err != nil {
+		return metav1.Condition{}, 0, fmt.Errorf("failed to list Certificates: %w", err)
+	}
+
+	now := time.Now()
+	cond, requeueAfter := certificatesReadyCondition(certs, owner, now)
+
+	certs = slices.DeleteFunc(certs, func(cert certmanagerv1.Certificate) bool {
+		return !metav1.IsControlledBy(&cert, owner)
+	})
+
+	return cond, MinRequeueAfter(requeueAfter, CertificateRenewalRequeueAfter(backend, certs, now)), nil
+}
+
+// CertificateRenewalRequeueAfter returns the time after which one of the certificates has to be
+// renewed by reconciling its owner again, or zero if the backend renews them by itself.
+func CertificateRenewalRequeueAfter(backend pki.Backend, certs []certmanagerv1.Certificate, now time.Time) time.Duration {
+	renewalTime := backend.RenewalTime(certs)
+	if renewalTime == nil {
+		return 0
+	}
+
+	return max(renewalTime.Sub(now), CertificateRenewalMinInterval)
+}
+
+// MinRequeueAfter returns the shortest of the given durations, ignoring zero durations.
+func MinRequeueAfter(durations ...time.Duration) time.Duration {
+	var result time.Duration
+	for _, d := range durations {
+		if d > 0 && (result == 0 || d < result) {
+			result = d
+		}
+	}
+
+	return result
+}
+
+func certificatesReadyCondition(certs []certmanagerv1.Certificate, owner metav1.Object, now time.Time) (metav1.Condition, time.Duration) {
+	var (
+		notReady     []string
+		requeueAfter time.Duration
+	)
+
+	for _, cert := range certs {
+		if !metav1.IsControlledBy(&cert, owner) || certificateReady(&cert) {
+			continue
+		}
+
+		if wait := CertificateNotReadyThreshold - now.Sub(certificateNotReadySince(&cert)); wait > 0 {
+			if requeueAfter == 0 || wait < requeueAfter {
+				requeueAfter = wait
+			}
+			continue
+		}
+
+		notReady = append(notReady, cert.Name)
+	}
+
+	if len(notReady) > 0 {
+		slices.Sort(notReady)
+
+		return metav1.Condition{
+			Type:    string(operatorv1alpha1.ConditionTypeCertificatesReady),
+			Status:  metav1.ConditionFalse,
+			Reason:  string(operatorv1alpha1.ConditionReasonCertificatesNotReady),
+			Message: fmt.Sprintf("Certificates have not been ready for more than %v: %s", CertificateNotReadyThreshold, strings.Join(notReady, ", ")),
+		}, 0
+	}
+
+	return metav1.Condition{
+		Type:    string(operatorv1alpha1.ConditionTypeCertificatesReady),
+		Status:  metav1.ConditionTrue,
+		Reason:  string(operatorv1alpha1.ConditionReasonCertificatesReady),
+		Message: "All certificates are ready or being issued.",
+	}, requeueAfter
+}
+
+// certificateNotReadySince returns the time at which cert last stopped being ready.
+func certificateNotReadySince(cert *certmanagerv1.Certificate) time.Time {
+	for _, cond := range cert.Status.Conditions {
+		if cond.Type == certmanagerv1.CertificateConditionReady && cond.LastTransitionTime != nil {
+			return cond.LastTransitionTime.Time
+		}
+	}
+	return cert.CreationTimestamp.Time
+}
+
 // MutateKeys returns a copy of m with each key wrapped by prefix and suffix.
 func MutateKeys(m map[string]string, prefix, suffix string) map[string]string {
 	result := make(map[string]string, len(m))
@@ -217,6 +351,66 @@ func GetDeploymentAvailableCondition(ctx context.Context, client ctrlruntimeclie
 	}, nil
 }
 
+// RecordDeploymentRollout records how long it took to roll out the current revision of a
+// Compiled* object, once the Deployment rendered from it is fully up and running.
+func RecordDeploymentRollout(ctx context.Context, client ctrlruntimeclient.Client, resourceType string, compiled metav1.Object, key types.NamespacedName) error {
+	var dep appsv1.Deployment
+	if err := client.Get(ctx, key, &dep); err != nil {
+		return ctrlruntimeclient.IgnoreNotFound(err)
+	}
+
+	metrics.RecordRollout(resourceType, compiled, &dep, deploymentReady(dep))
+
+	return nil
+}
+
+// GetEtcdHealthyCondition reports on the members of an operator-managed etcd cluster. Members
+// only become ready once they are part of a cluster with an elected leader, so the StatefulSet's
+// ready replicas are a good proxy for the health of the cluster.
+func GetEtcdHealthyCondition(ctx context.Context, client ctrlruntimeclient.Client, key types.NamespacedName) (metav1.Condition, error) {
+	var sts appsv1.StatefulSet
+	if err := client.Get(ctx, key, &sts); ctrlruntimeclient.IgnoreNotFound(err) != nil {
+		return metav1.Condition{}, err
+	}
+
+	cond := metav1.Condition{
+		Type:    string(operatorv1alpha1.ConditionTypeEtcdHealthy),
+		Status:  metav1.ConditionFalse,
+		Reason:  string(operatorv1alpha1.ConditionReasonEtcdMembersUnavailable),
+		Message: fmt.Sprintf("StatefulSet %s does not exist.", key),
+	}
+
+	if sts.Name == "" {
+		return cond, nil
+	}
+
+	desired := ptr.Deref(sts.Spec.Replicas, 0)
+	cond.Message = fmt.Sprintf("%d/%d etcd members are ready.", sts.Status.ReadyReplicas, desired)
+
+	if sts.Status.ObservedGeneration == sts.Generation && sts.Status.ReadyReplicas == desired && sts.Status.UpdatedReplicas == desired {
+		cond.Status = metav1.ConditionTrue
+		cond.Reason = string(operatorv1alpha1.ConditionReasonEtcdMembersReady)
+	}
+
+	return cond, nil
+}
+
+// GetCompiledCondition adopts a condition of the given type published on a Compiled* object,
+// returning nil if the Compiled* object does not carry it.
+func GetCompiledCondition(conditions []metav1.Condition, condType operatorv1alpha1.ConditionType) *metav1.Condition {
+	cond := apimeta.FindStatusCondition(conditions, string(condType))
+	if cond == nil {
+		return nil
+	}
+
+	return &metav1.Condition{
+		Type:    cond.Type,
+		Status:  cond.Status,
+		Reason:  cond.Reason,
+		Message: cond.Message,
+	}
+}
+
 func UpdateCondition(conditions []metav1.Condition, newCondition metav1.Condition) []metav1.Condition {
 	if conditions == nil {
 		conditions = make([]metav1.Condition, 0)
@@ -268,6 +462,53 @@ func FetchRootShard(ctx context.Context, client ctrlruntimeclient.Client, namesp
 	}, rootShard
 }
 
+// FetchEtcdSnapshotTarget fetches the RootShard or Shard that an EtcdSnapshotTarget refers to and
+// returns it together with its etcd configuration. The returned object is nil if the reference
+// cannot be resolved, in which case the condition explains why.
+func FetchEtcdSnapshotTarget(ctx context.Context, client ctrlruntimeclient.Client, namespace string, target operatorv1alpha1.EtcdSnapshotTarget) (metav1.Condition, ctrlruntimeclient.Object, *operatorv1alpha1.EtcdConfig) {
+	var (
+		obj    ctrlruntimeclient.Object
+		config *operatorv1alpha1.EtcdConfig
+		key    types.NamespacedName
+	)
+
+	switch {
+	case target.RootShardRef != nil:
+		rootShard := &operatorv1alpha1.RootShard{}
+		obj, config = rootShard, &rootShard.Spec.Etcd
+		key = types.NamespacedName{Name: target.RootShardRef.Name, Namespace: namespace}
+
+	case target.ShardRef != nil:
+		shard := &operatorv1alpha1.Shard{}
+		obj, config = shard, &shard.Spec.Etcd
+		key = types.NamespacedName{Name: target.ShardRef.Name, Namespace: namespace}
+
+	default:
+		return metav1.Condition{
+			Type:    string(operatorv1alpha1.ConditionTypeReferenceValid),
+			Status:  metav1.ConditionFalse,
+			Reason:  string(operatorv1alpha1.ConditionReasonReferenceNotFound),
+			Message: "Neither a RootShard nor a Shard is referenced.",
+		}, nil, nil
+	}
+
+	if err := client.Get(ctx, key, obj); err != nil {
+		return metav1.Condition{
+			Type:    string(operatorv1alpha1.ConditionTypeReferenceValid),
+			Status:  metav1.ConditionFalse,
+			Reason:  string(operatorv1alpha1.ConditionReasonReferenceNotFound),
+			Message: fmt.Sprintf("Failed to retrieve target: %v.", err),
+		}, nil, nil
+	}
+
+	return metav1.Condition{
+		Type:    string(operatorv1alpha1.ConditionTypeReferenceValid),
+		Status:  metav1.ConditionTrue,
+		Reason:  string(operatorv1alpha1.ConditionReasonReferenceValid),
+		Message: "Target reference is valid.",
+	}, obj, config
+}
+
 // GetRootShardChildren returns all shards that are currently registered with the given root shard.
 // The shards are sorted by name before returning.
 func GetRootShardChildren(ctx context.Context, client ctrlruntimeclient.Client, rootShard *operatorv1alpha1.RootShard) ([]operatorv1alpha1.Shard, error) {
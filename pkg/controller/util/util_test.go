@@ -17,13 +17,23 @@ limitations under the License.
 package util
 
 import (
+	"context"
 	"testing"
+	"time"
 
 	certmanagerv1 "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
 	certmanagermetav1 "github.com/cert-manager/cert-manager/pkg/apis/meta/v1"
+	k8creconciling "k8c.io/reconciler/pkg/reconciling"
 
+	"k8s.io/apimachinery/pkg/api/equality"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/utils/ptr"
+	ctrlruntimefakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/kcp-dev/kcp-operator/internal/resources"
+	"github.com/kcp-dev/kcp-operator/pkg/pki"
+	"github.com/kcp-dev/kcp-operator/pkg/reconciling"
+	operatorv1alpha1 "github.com/kcp-dev/kcp-operator/sdk/apis/operator/v1alpha1"
 )
 
 func readyCertificate(name string, revision int) *certmanagerv1.Certificate {
@@ -75,3 +85,156 @@ func TestCertificateRevisionsNotReady(t *testing.T) {
 		t.Error("expected ready=false with an empty certificate")
 	}
 }
+
+func TestCertificateInventory(t *testing.T) {
+	notAfter := metav1.NewTime(time.Date(2027, 1, 1, 0, 0, 0, 0, time.UTC))
+
+	server := readyCertificate("root-server", 3)
+	server.Labels = map[string]string{resources.CertificateLabel: string(operatorv1alpha1.ServerCertificate)}
+	server.Spec.SecretName = "root-server"
+	server.Spec.IssuerRef.Name = "root-server-ca"
+	server.Status.NotAfter = &notAfter
+
+	ca := &certmanagerv1.Certificate{ObjectMeta: metav1.ObjectMeta{
+		Name:   "root-client-ca",
+		Labels: map[string]string{resources.CALabel: string(operatorv1alpha1.ClientCA)},
+	}}
+	ca.Spec.SecretName = "root-client-ca"
+
+	inventory := CertificateInventory([]*certmanagerv1.Certificate{server, ca})
+
+	expected := []operatorv1alpha1.CertificateStatus{{
+		Name:       "root-client-ca",
+		CA:         operatorv1alpha1.ClientCA,
+		SecretName: "root-client-ca",
+	}, {
+		Name:        "root-server",
+		Certificate: operatorv1alpha1.ServerCertificate,
+		SecretName:  "root-server",
+		Issuer:      "root-server-ca",
+		NotAfter:    &notAfter,
+		Revision:    3,
+		Ready:       true,
+	}}
+
+	if !equality.Semantic.DeepEqual(inventory, expected) {
+		t.Errorf("expected %+v, got %+v", expected, inventory)
+	}
+}
+
+func TestCertificatesReadyCondition(t *testing.T) {
+	now := time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)
+
+	owner := &operatorv1alpha1.RootShard{ObjectMeta: metav1.ObjectMeta{Name: "root", UID: "owner"}}
+	ownerRef := *metav1.NewControllerRef(owner, operatorv1alpha1.SchemeGroupVersion.WithKind("RootShard"))
+
+	notReadyCertificate := func(name string, since time.Duration) certmanagerv1.Certificate {
+		return certmanagerv1.Certificate{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:            name,
+				OwnerReferences: []metav1.OwnerReference{ownerRef},
+			},
+			Status: certmanagerv1.CertificateStatus{
+				Conditions: []certmanagerv1.CertificateCondition{{
+					Type:               certmanagerv1.CertificateConditionReady,
+					Status:             certmanagermetav1.ConditionFalse,
+					LastTransitionTime: &metav1.Time{Time: now.Add(-since)},
+				}},
+			},
+		}
+	}
+
+	ready := *readyCertificate("ready", 1)
+	ready.OwnerReferences = []metav1.OwnerReference{ownerRef}
+
+	foreign := notReadyCertificate("foreign", time.Hour)
+	foreign.OwnerReferences = nil
+
+	testcases := []struct {
+		name            string
+		certs           []certmanagerv1.Certificate
+		expectedStatus  metav1.ConditionStatus
+		expectedRequeue time.Duration
+	}{
+		{
+			name:           "all certificates ready",
+			certs:          []certmanagerv1.Certificate{ready, foreign},
+			expectedStatus: metav1.ConditionTrue,
+		},
+		{
+			name:            "certificate being issued",
+			certs:           []certmanagerv1.Certificate{ready, notReadyCertificate("issuing", time.Minute)},
+			expectedStatus:  metav1.ConditionTrue,
+			expectedRequeue: CertificateNotReadyThreshold - time.Minute,
+		},
+		{
+			name:           "certificate stuck",
+			certs:          []certmanagerv1.Certificate{notReadyCertificate("issuing", time.Minute), notReadyCertificate("stuck", time.Hour)},
+			expectedStatus: metav1.ConditionFalse,
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			cond, requeueAfter := certificatesReadyCondition(tc.certs, owner, now)
+
+			if cond.Status != tc.expectedStatus {
+				t.Errorf("expected status %s, got %s (%s)", tc.expectedStatus, cond.Status, cond.Message)
+			}
+			if requeueAfter != tc.expectedRequeue {
+				t.Errorf("expected requeue after %v, got %v", tc.expectedRequeue, requeueAfter)
+			}
+		})
+	}
+}
+
+func TestGetCertificatesReadyConditionSchedulesRenewal(t *testing.T) {
+	ctx := context.Background()
+	client := ctrlruntimefakeclient.NewClientBuilder().Build()
+	backend := pki.Builtin{}
+
+	owner := &operatorv1alpha1.Kubeconfig{ObjectMeta: metav1.ObjectMeta{Name: "short-lived", Namespace: "kcp", UID: "owner"}}
+	ownerRef := *metav1.NewControllerRef(owner, operatorv1alpha1.SchemeGroupVersion.WithKind("Kubeconfig"))
+
+	// A certificate that is valid for much shorter than the resync period of the operator.
+	if err := backend.ReconcileCertificates(ctx, []reconciling.NamedCertificateReconcilerFactory{
+		func() (string, reconciling.CertificateReconciler) {
+			return "short-lived", func(cert *certmanagerv1.Certificate) (*certmanagerv1.Certificate, error) {
+				cert.Labels = map[string]string{resources.CALabel: string(operatorv1alpha1.RootCA)}
+				cert.Spec = certmanagerv1.CertificateSpec{
+					IsCA:       true,
+					CommonName: "short-lived",
+					SecretName: "short-lived",
+					Duration:   &metav1.Duration{Duration: time.Hour},
+					PrivateKey: &certmanagerv1.CertificatePrivateKey{Algorithm: certmanagerv1.ECDSAKeyAlgorithm},
+				}
+				return cert, nil
+			}
+		},
+	}, owner.Namespace, client, k8creconciling.OwnerRefWrapper(ownerRef)); err != nil {
+		t.Fatalf("failed to reconcile certificates: %v", err)
+	}
+
+	cond, requeueAfter, err := GetCertificatesReadyCondition(ctx, client, backend, owner)
+	if err != nil {
+		t.Fatalf("failed to get condition: %v", err)
+	}
+
+	if cond.Status != metav1.ConditionTrue {
+		t.Errorf("expected certificates to be ready, got %s (%s)", cond.Status, cond.Message)
+	}
+
+	// The renewal is due after two thirds of the validity.
+	if requeueAfter <= 0 || requeueAfter > 40*time.Minute {
+		t.Errorf("expected requeue before the renewal time, got %v", requeueAfter)
+	}
+}
+
+func TestMinRequeueAfter(t *testing.T) {
+	if d := MinRequeueAfter(0, time.Minute, 0, time.Second); d != time.Second {
+		t.Errorf("expected 1s, got %v", d)
+	}
+	if d := MinRequeueAfter(0, 0); d != 0 {
+		t.Errorf("expected 0, got %v", d)
+	}
+}
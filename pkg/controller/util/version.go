@@ -0,0 +1,99 @@
+/*
+Copyright 2026 The kcp Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package util
+
+import (
+	"context"
+	"time"
+
+	appsv1 "k8s.io/api/apps/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/version"
+	ctrlruntimeclient "sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	"github.com/kcp-dev/kcp-operator/internal/resources"
+	operatorv1alpha1 "github.com/kcp-dev/kcp-operator/sdk/apis/operator/v1alpha1"
+)
+
+// VersionQueryInterval is how soon the kcp version is queried again after the query failed.
+const VersionQueryInterval = 5 * time.Second
+
+// GetVersionStatus reports on the image the given container of a Deployment has been rolled out
+// with. The kcp version is only queried once all replicas run a new image, as it cannot change
+// otherwise. Failing to query the version is not an error, the version is simply left empty and
+// the returned duration tells the caller when to requeue to query it again.
+func GetVersionStatus(
+	ctx context.Context,
+	client ctrlruntimeclient.Client,
+	key types.NamespacedName,
+	containerName string,
+	previous *operatorv1alpha1.VersionStatus,
+	getVersion func(ctx context.Context) (*version.Info, error),
+) (*operatorv1alpha1.VersionStatus, time.Duration, error) {
+	var dep appsv1.Deployment
+	if err := client.Get(ctx, key, &dep); err != nil {
+		return previous, 0, ctrlruntimeclient.IgnoreNotFound(err)
+	}
+
+	status := &operatorv1alpha1.VersionStatus{}
+	if previous != nil {
+		status = previous.DeepCopy()
+	}
+
+	for _, container := range dep.Spec.Template.Spec.Containers {
+		if container.Name == containerName {
+			status.DesiredImage = container.Image
+			break
+		}
+	}
+
+	if !deploymentReady(dep) {
+		return status, 0, nil
+	}
+
+	if status.CurrentImage != status.DesiredImage {
+		status.CurrentImage = status.DesiredImage
+		status.KCPVersion = ""
+	}
+
+	if status.KCPVersion == "" {
+		info, err := getVersion(ctx)
+		if err != nil {
+			log.FromContext(ctx).V(2).Info("Failed to query version", "deployment", key, "error", err)
+			return status, VersionQueryInterval, nil
+		}
+
+		status.KCPVersion = info.GitVersion
+	}
+
+	return status, 0, nil
+}
+
+// AdoptVersionStatus adopts the version status published on a Compiled* object. The desired image
+// is taken from the source object, as the Compiled* object might still be held back on its current
+// image during an upgrade.
+func AdoptVersionStatus(imageSpec *operatorv1alpha1.ImageSpec, compiled *operatorv1alpha1.VersionStatus) *operatorv1alpha1.VersionStatus {
+	status := &operatorv1alpha1.VersionStatus{}
+	if compiled != nil {
+		status = compiled.DeepCopy()
+	}
+
+	status.DesiredImage, _, _ = resources.GetImageSettings(imageSpec)
+
+	return status
+}
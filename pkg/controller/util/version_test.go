@@ -0,0 +1,131 @@
+/*
+Copyright 2026 The kcp Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package util
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/version"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	operatorv1alpha1 "github.com/kcp-dev/kcp-operator/sdk/apis/operator/v1alpha1"
+)
+
+func versionTestDeployment(image string, ready bool) *appsv1.Deployment {
+	dep := &appsv1.Deployment{
+		ObjectMeta: metav1.ObjectMeta{Name: "test", Namespace: "default"},
+		Spec: appsv1.DeploymentSpec{
+			Replicas: ptr.To[int32](2),
+			Template: corev1.PodTemplateSpec{
+				Spec: corev1.PodSpec{
+					Containers: []corev1.Container{{Name: "kcp", Image: image}},
+				},
+			},
+		},
+	}
+
+	if ready {
+		dep.Status = appsv1.DeploymentStatus{UpdatedReplicas: 2, ReadyReplicas: 2}
+	}
+
+	return dep
+}
+
+func TestGetVersionStatus(t *testing.T) {
+	testcases := []struct {
+		name       string
+		deployment *appsv1.Deployment
+		previous   *operatorv1alpha1.VersionStatus
+		versionErr error
+		expected   *operatorv1alpha1.VersionStatus
+		requeue    bool
+	}{
+		{
+			name: "no deployment yet",
+		},
+		{
+			name:       "rollout in progress",
+			deployment: versionTestDeployment("kcp:v0.31.0", false),
+			previous:   &operatorv1alpha1.VersionStatus{DesiredImage: "kcp:v0.30.0", CurrentImage: "kcp:v0.30.0", KCPVersion: "v0.30.0"},
+			expected:   &operatorv1alpha1.VersionStatus{DesiredImage: "kcp:v0.31.0", CurrentImage: "kcp:v0.30.0", KCPVersion: "v0.30.0"},
+		},
+		{
+			name:       "rollout finished",
+			deployment: versionTestDeployment("kcp:v0.31.0", true),
+			previous:   &operatorv1alpha1.VersionStatus{DesiredImage: "kcp:v0.31.0", CurrentImage: "kcp:v0.30.0", KCPVersion: "v0.30.0"},
+			expected:   &operatorv1alpha1.VersionStatus{DesiredImage: "kcp:v0.31.0", CurrentImage: "kcp:v0.31.0", KCPVersion: "v0.31.0"},
+		},
+		{
+			name:       "version is not queried again",
+			deployment: versionTestDeployment("kcp:v0.31.0", true),
+			previous:   &operatorv1alpha1.VersionStatus{DesiredImage: "kcp:v0.31.0", CurrentImage: "kcp:v0.31.0", KCPVersion: "v0.31.0-custom"},
+			versionErr: errors.New("must not be called"),
+			expected:   &operatorv1alpha1.VersionStatus{DesiredImage: "kcp:v0.31.0", CurrentImage: "kcp:v0.31.0", KCPVersion: "v0.31.0-custom"},
+		},
+		{
+			name:       "version cannot be queried",
+			deployment: versionTestDeployment("kcp:v0.31.0", true),
+			versionErr: errors.New("connection refused"),
+			expected:   &operatorv1alpha1.VersionStatus{DesiredImage: "kcp:v0.31.0", CurrentImage: "kcp:v0.31.0"},
+			requeue:    true,
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			builder := fake.NewClientBuilder().WithScheme(GetTestScheme())
+			if tc.deployment != nil {
+				builder = builder.WithObjects(tc.deployment)
+			}
+
+			getVersion := func(context.Context) (*version.Info, error) {
+				if tc.versionErr != nil {
+					return nil, tc.versionErr
+				}
+				return &version.Info{GitVersion: "v0.31.0"}, nil
+			}
+
+			key := types.NamespacedName{Namespace: "default", Name: "test"}
+			status, requeueAfter, err := GetVersionStatus(context.Background(), builder.Build(), key, "kcp", tc.previous, getVersion)
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+
+			if tc.requeue != (requeueAfter > 0) {
+				t.Errorf("Expected requeue to be %v, got requeue after %v.", tc.requeue, requeueAfter)
+			}
+
+			if tc.expected == nil {
+				if status != nil {
+					t.Fatalf("Expected no status, got %+v.", status)
+				}
+				return
+			}
+
+			if status == nil || *status != *tc.expected {
+				t.Fatalf("Expected %+v, got %+v.", tc.expected, status)
+			}
+		})
+	}
+}
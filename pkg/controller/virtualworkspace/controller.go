@@ -28,6 +28,7 @@ import (
 
 	corev1 "k8s.io/api/core/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	apimeta "k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
 	kerrors "k8s.io/apimachinery/pkg/util/errors"
@@ -44,8 +45,10 @@ import (
 	"github.com/kcp-dev/kcp-operator/internal/resources/virtualworkspace"
 	"github.com/kcp-dev/kcp-operator/pkg/controller/util"
 	"github.com/kcp-dev/kcp-operator/pkg/metrics"
+	"github.com/kcp-dev/kcp-operator/pkg/pki"
 	"github.com/kcp-dev/kcp-operator/pkg/reconciling"
 	"github.com/kcp-dev/kcp-operator/pkg/reconciling/modifier"
+	"github.com/kcp-dev/kcp-operator/pkg/tracing"
 	deployv1alpha1 "github.com/kcp-dev/kcp-operator/sdk/apis/deploy/v1alpha1"
 	operatorv1alpha1 "github.com/kcp-dev/kcp-operator/sdk/apis/operator/v1alpha1"
 )
@@ -53,6 +56,8 @@ import (
 // Reconciler reconciles a VirtualWorkspace object
 type Reconciler struct {
 	GetCluster func(ctx context.Context, clusterName multicluster.ClusterName) (cluster.Cluster, error)
+	Events     *util.EventRecorder
+	PKI        pki.Backend
 }
 
 // SetupWithManager sets up the controller with the Manager.
@@ -62,11 +67,11 @@ func (r *Reconciler) SetupWithManager(mgr mcmanager.Manager, opts ...mcbuilder.E
 		For(&operatorv1alpha1.VirtualWorkspace{}, util.EngageFor(opts)...).
 		Watches(&operatorv1alpha1.RootShard{}, util.EnqueueMapped(r.mapRootShardToVirtualWorkspaces), util.EngageWatches(opts)...).
 		Watches(&operatorv1alpha1.Shard{}, util.EnqueueMapped(r.mapShardToVirtualWorkspaces), util.EngageWatches(opts)...).
-		Watches(&certmanagerv1.Issuer{}, util.EnqueueMapped(r.mapIssuerToVirtualWorkspaces), util.EngageWatches(opts)...).
+		Watches(r.PKI.IssuerType(), util.EnqueueMapped(r.mapIssuerToVirtualWorkspaces), util.EngageWatches(opts)...).
 		Owns(&corev1.Secret{}, util.EngageOwns(opts)...).
-		Owns(&certmanagerv1.Certificate{}, util.EngageOwns(opts)...).
+		Owns(r.PKI.CertificateType(), util.EngageOwns(opts)...).
 		Owns(&deployv1alpha1.CompiledVirtualWorkspace{}, util.EngageOwns(opts)...).
-		Complete(r)
+		Complete(tracing.Reconciler("virtualworkspace", r))
 }
 
 // +kubebuilder:rbac:groups=operator.kcp.io,resources=virtualworkspaces,verbs=get;list;watch;update;patch
@@ -111,17 +116,39 @@ func (r *Reconciler) Reconcile(ctx context.Context, req mcreconcile.Request) (re
 	}
 
 	vwCopy := vw.DeepCopy()
+	recorder := r.Events.For(req.ClusterName, cl)
 
-	conditions, recErr := r.reconcile(ctx, cl.GetClient(), vwCopy)
+	var (
+		conditions []metav1.Condition
+		certs      []*certmanagerv1.Certificate
+	)
+	if util.IsPaused(vw) {
+		logger.V(4).Info("Skipping reconciliation because the object is paused")
+		recErr = util.PauseCompiled(ctx, cl.GetClient(), req.NamespacedName, &deployv1alpha1.CompiledVirtualWorkspace{})
+	} else {
+		metrics.RecordGeneration(vwCopy)
+		conditions, recErr = r.reconcile(ctx, cl.GetClient(), recorder, vwCopy, &certs)
+	}
+
+	certsCond, requeueAfter, err := util.GetCertificatesReadyCondition(ctx, cl.GetClient(), r.PKI, vw)
+	if err != nil {
+		recErr = kerrors.NewAggregate([]error{recErr, err})
+	} else {
+		conditions = append(conditions, certsCond)
+	}
 
-	if err := r.reconcileStatus(ctx, cl.GetClient(), vw, vwCopy, conditions); err != nil {
+	if err := r.reconcileStatus(ctx, cl.GetClient(), recorder, vw, vwCopy, conditions, certs); err != nil {
 		recErr = kerrors.NewAggregate([]error{recErr, err})
 	}
 
-	return ctrl.Result{}, recErr
+	recorder.ReconcileFailed(vw, recErr)
+
+	requeueAfter = util.MinRequeueAfter(requeueAfter, util.CertificateRotationRequeueAfter(vw))
+
+	return ctrl.Result{RequeueAfter: requeueAfter}, recErr
 }
 
-func (r *Reconciler) reconcile(ctx context.Context, client ctrlruntimeclient.Client, vw *operatorv1alpha1.VirtualWorkspace) ([]metav1.Condition, error) {
+func (r *Reconciler) reconcile(ctx context.Context, client ctrlruntimeclient.Client, recorder *util.Recorder, vw *operatorv1alpha1.VirtualWorkspace, certs *[]*certmanagerv1.Certificate) ([]metav1.Condition, error) {
 	var conditions []metav1.Condition
 
 	var (
@@ -202,14 +229,21 @@ func (r *Reconciler) reconcile(ctx context.Context, client ctrlruntimeclient.Cli
 
 	ownerRefWrapper := k8creconciling.OwnerRefWrapper(*metav1.NewControllerRef(vw, operatorv1alpha1.SchemeGroupVersion.WithKind("VirtualWorkspace")))
 
-	var certs []*certmanagerv1.Certificate
-	if err := reconciling.ReconcileCertificates(ctx, []reconciling.NamedCertificateReconcilerFactory{
-		virtualworkspace.ClientCertificateReconciler(vw, rootShard),
-		virtualworkspace.ServerCertificateReconciler(vw, rootShard),
-	}, vw.Namespace, client, ownerRefWrapper, modifier.Capture(&certs)); err != nil {
+	if err := tracing.Trace(ctx, "ReconcileCertificates", func(ctx context.Context) error {
+		return r.PKI.ReconcileCertificates(ctx, []reconciling.NamedCertificateReconcilerFactory{
+			virtualworkspace.ClientCertificateReconciler(vw, rootShard),
+			virtualworkspace.ServerCertificateReconciler(vw, rootShard),
+		}, vw.Namespace, client, ownerRefWrapper, modifier.Capture(certs))
+	}); err != nil {
+		return conditions, err
+	}
+
+	if err := util.RotateCertificates(ctx, client, r.PKI, recorder, vw, vw.Status.CertificateRotation, *certs); err != nil {
 		return conditions, err
 	}
 
+	vw.Status.Certificates = util.CertificateInventory(*certs)
+
 	if rootShard.Spec.ClientCABundleRef != nil || vw.Spec.ClientCABundleRef != nil {
 		if err := k8creconciling.ReconcileSecrets(ctx, []k8creconciling.NamedSecretReconcilerFactory{
 			virtualworkspace.MergedClientCABundleSecretReconciler(ctx, vw, rootShard, client),
@@ -220,22 +254,42 @@ func (r *Reconciler) reconcile(ctx context.Context, client ctrlruntimeclient.Cli
 
 	// Only publish the render input once every Certificate is ready, so that whoever consumes
 	// it can rely on the Secrets it mounts already existing.
-	revisions, certsReady := util.CertificateRevisions(certs)
+	revisions, certsReady := util.CertificateRevisions(*certs)
 	if !certsReady {
 		return conditions, nil
 	}
 
+	recorder.CertificatesIssued(vw, len(*certs))
+
+	// New kcp versions are rolled out step by step across the installation, so the virtual
+	// workspace might have to stay on its current image for now.
+	plan, err := util.GetUpgradePlan(ctx, client, rootShard)
+	if err != nil {
+		return conditions, fmt.Errorf("failed to determine upgrade plan: %w", err)
+	}
+
+	compiledVW := vw
+	if decision := plan.Decide(util.UpgradeKindVirtualWorkspace, vw.Name); decision.HeldBack() {
+		compiledVW = vw.DeepCopy()
+		compiledVW.Spec.Image = decision.Image
+	}
+
 	// The workloads themselves are rendered by the CompiledVirtualWorkspace controller.
-	if err := reconciling.ReconcileCompiledVirtualWorkspaces(ctx, []reconciling.NamedCompiledVirtualWorkspaceReconcilerFactory{
-		virtualworkspace.CompiledVirtualWorkspaceReconciler(vw, rootShard, shard, util.MutateKeys(revisions, "cert-", "-revision")),
-	}, vw.Namespace, client, ownerRefWrapper); err != nil {
+	var published bool
+	if err := tracing.Trace(ctx, "PublishCompiled", func(ctx context.Context) error {
+		return reconciling.ReconcileCompiledVirtualWorkspaces(ctx, []reconciling.NamedCompiledVirtualWorkspaceReconcilerFactory{
+			virtualworkspace.CompiledVirtualWorkspaceReconciler(compiledVW, rootShard, shard, util.MutateKeys(revisions, "cert-", "-revision")),
+		}, vw.Namespace, client, ownerRefWrapper, modifier.PublishedAt(time.Now(), &published), modifier.TraceParent(ctx))
+	}); err != nil {
 		return conditions, err
 	}
 
+	metrics.RecordPublished(metrics.VirtualWorkspaceResourceType, vw, published)
+
 	return conditions, nil
 }
 
-func (r *Reconciler) reconcileStatus(ctx context.Context, client ctrlruntimeclient.Client, oldVW *operatorv1alpha1.VirtualWorkspace, vw *operatorv1alpha1.VirtualWorkspace, conditions []metav1.Condition) error {
+func (r *Reconciler) reconcileStatus(ctx context.Context, client ctrlruntimeclient.Client, recorder *util.Recorder, oldVW *operatorv1alpha1.VirtualWorkspace, vw *operatorv1alpha1.VirtualWorkspace, conditions []metav1.Condition, certs []*certmanagerv1.Certificate) error {
 	// Check the workloads rendered from the compiled object
 	compiled := &deployv1alpha1.CompiledVirtualWorkspace{}
 	key := types.NamespacedName{Namespace: vw.Namespace, Name: vw.Name}
@@ -243,11 +297,25 @@ func (r *Reconciler) reconcileStatus(ctx context.Context, client ctrlruntimeclie
 		return err
 	}
 	conditions = append(conditions, util.GetCompiledAvailableCondition(compiled.Status.Conditions, "CompiledVirtualWorkspace "+vw.Name))
+	vw.Status.Version = util.AdoptVersionStatus(vw.Spec.Image, compiled.Status.Version)
+	vw.Status.Placement = compiled.Status.Placement
+
+	if rotation, cond := util.CertificateRotation(vw, vw.Status.CertificateRotation, certs, compiled, compiled.Status.Conditions); cond != nil {
+		vw.Status.CertificateRotation = rotation
+		cond.ObservedGeneration = vw.Generation
+		apimeta.SetStatusCondition(&vw.Status.Conditions, *cond)
+	}
+
+	recorder.CompiledPublished(vw, compiled, "CompiledVirtualWorkspace")
+	recorder.DeploymentRolled(vw, oldVW.Status.Version, vw.Status.Version)
 
 	for _, condition := range conditions {
+		recorder.ReferenceMissing(vw, condition)
+
 		condition.ObservedGeneration = vw.Generation
 		vw.Status.Conditions = util.UpdateCondition(vw.Status.Conditions, condition)
 	}
+	vw.Status.Conditions = util.UpdatePausedCondition(vw, vw.Status.Conditions)
 
 	if !equality.Semantic.DeepEqual(oldVW.Status, vw.Status) {
 		if err := client.Status().Patch(ctx, vw, ctrlruntimeclient.MergeFrom(oldVW)); err != nil {
@@ -20,9 +20,13 @@ import (
 	"context"
 	"time"
 
+	certmanagerv1 "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
+
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	ctrlruntimeclient "sigs.k8s.io/controller-runtime/pkg/client"
 
+	"github.com/kcp-dev/kcp-operator/internal/resources"
+	"github.com/kcp-dev/kcp-operator/pkg/pki"
 	operatorv1alpha1 "github.com/kcp-dev/kcp-operator/sdk/apis/operator/v1alpha1"
 )
 
@@ -30,13 +34,25 @@ const (
 	UnknownPhase = "Unknown"
 )
 
+// certificateOwnerTypes maps the kinds of objects that own Certificates to their resource type.
+var certificateOwnerTypes = map[string]string{
+	"RootShard":        RootShardResourceType,
+	"Shard":            ShardResourceType,
+	"FrontProxy":       FrontProxyResourceType,
+	"CacheServer":      CacheServerResourceType,
+	"VirtualWorkspace": VirtualWorkspaceResourceType,
+	"Kubeconfig":       KubeconfigResourceType,
+}
+
 type MetricsCollector struct {
 	client ctrlruntimeclient.Client
+	pki    pki.Backend
 }
 
-func NewMetricsCollector(client ctrlruntimeclient.Client) *MetricsCollector {
+func NewMetricsCollector(client ctrlruntimeclient.Client, backend pki.Backend) *MetricsCollector {
 	return &MetricsCollector{
 		client: client,
+		pki:    backend,
 	}
 }
 
@@ -63,14 +79,35 @@ func recordConditionStatuses(resourceType, name, namespace string, conditions []
 	}
 }
 
+func recordVersion(resourceType, name, namespace string, version *operatorv1alpha1.VersionStatus) {
+	if version == nil || version.CurrentImage == "" {
+		return
+	}
+
+	ComponentVersionInfo.
+		WithLabelValues(resourceType, name, namespace, version.CurrentImage, version.KCPVersion).
+		Set(1)
+}
+
+func recordPaused(resourceType string, obj metav1.Object) {
+	if obj.GetAnnotations()[operatorv1alpha1.PausedAnnotation] != "true" {
+		return
+	}
+
+	PausedObjectCount.WithLabelValues(resourceType, obj.GetNamespace()).Inc()
+}
+
 func (mc *MetricsCollector) updateObjectCounts(ctx context.Context) {
 	ConditionStatus.Reset()
+	ComponentVersionInfo.Reset()
+	PausedObjectCount.Reset()
 	mc.updateRootShardCounts(ctx)
 	mc.updateShardCounts(ctx)
 	mc.updateFrontProxyCounts(ctx)
 	mc.updateCacheServerCounts(ctx)
 	mc.updateKubeconfigCounts(ctx)
 	mc.updateVirtualWorkspaceCounts(ctx)
+	mc.updateCertificates(ctx)
 }
 
 func (mc *MetricsCollector) updateRootShardCounts(ctx context.Context) {
@@ -93,6 +130,8 @@ func (mc *MetricsCollector) updateRootShardCounts(ctx context.Context) {
 		phaseCounts[phase][rs.Namespace]++
 
 		recordConditionStatuses(RootShardResourceType, rs.Name, rs.Namespace, rs.Status.Conditions)
+		recordVersion(RootShardResourceType, rs.Name, rs.Namespace, rs.Status.Version)
+		recordPaused(RootShardResourceType, &rs)
 	}
 
 	for phase, namespaceCounts := range phaseCounts {
@@ -122,6 +161,8 @@ func (mc *MetricsCollector) updateShardCounts(ctx context.Context) {
 		phaseCounts[phase][s.Namespace]++
 
 		recordConditionStatuses(ShardResourceType, s.Name, s.Namespace, s.Status.Conditions)
+		recordVersion(ShardResourceType, s.Name, s.Namespace, s.Status.Version)
+		recordPaused(ShardResourceType, &s)
 	}
 
 	for phase, namespaceCounts := range phaseCounts {
@@ -151,6 +192,8 @@ func (mc *MetricsCollector) updateFrontProxyCounts(ctx context.Context) {
 		phaseCounts[phase][fp.Namespace]++
 
 		recordConditionStatuses(FrontProxyResourceType, fp.Name, fp.Namespace, fp.Status.Conditions)
+		recordVersion(FrontProxyResourceType, fp.Name, fp.Namespace, fp.Status.Version)
+		recordPaused(FrontProxyResourceType, &fp)
 	}
 
 	for phase, namespaceCounts := range phaseCounts {
@@ -168,13 +211,25 @@ func (mc *MetricsCollector) updateCacheServerCounts(ctx context.Context) {
 
 	CacheServerCount.Reset()
 
-	namespaceCounts := make(map[string]int)
+	phaseCounts := make(map[string]map[string]int)
 	for _, cs := range cacheServers.Items {
-		namespaceCounts[cs.Namespace]++
+		phase := string(cs.Status.Phase)
+		if phase == "" {
+			phase = UnknownPhase
+		}
+		if phaseCounts[phase] == nil {
+			phaseCounts[phase] = make(map[string]int)
+		}
+		phaseCounts[phase][cs.Namespace]++
+
+		recordConditionStatuses(CacheServerResourceType, cs.Name, cs.Namespace, cs.Status.Conditions)
+		recordPaused(CacheServerResourceType, &cs)
 	}
 
-	for namespace, count := range namespaceCounts {
-		CacheServerCount.WithLabelValues(namespace).Set(float64(count))
+	for phase, namespaceCounts := range phaseCounts {
+		for namespace, count := range namespaceCounts {
+			CacheServerCount.WithLabelValues(phase, namespace).Set(float64(count))
+		}
 	}
 }
 
@@ -209,9 +264,56 @@ func (mc *MetricsCollector) updateVirtualWorkspaceCounts(ctx context.Context) {
 	namespaceCounts := make(map[string]int)
 	for _, vw := range virtualWorkspaces.Items {
 		namespaceCounts[vw.Namespace]++
+
+		recordVersion(VirtualWorkspaceResourceType, vw.Name, vw.Namespace, vw.Status.Version)
+		recordPaused(VirtualWorkspaceResourceType, &vw)
 	}
 
 	for namespace, count := range namespaceCounts {
 		VirtualWorkspaceCount.WithLabelValues(namespace).Set(float64(count))
 	}
 }
+
+func (mc *MetricsCollector) updateCertificates(ctx context.Context) {
+	certificates, err := mc.pki.ListCertificates(ctx, mc.client)
+	if err != nil {
+		return
+	}
+
+	CertificateExpirationTime.Reset()
+	CertificateRenewalTime.Reset()
+
+	for _, cert := range certificates {
+		recordCertificate(&cert)
+	}
+}
+
+func recordCertificate(cert *certmanagerv1.Certificate) {
+	certName := cert.Labels[resources.CertificateLabel]
+	caName := cert.Labels[resources.CALabel]
+	if certName == "" && caName == "" {
+		return
+	}
+
+	owner := metav1.GetControllerOf(cert)
+	if owner == nil {
+		return
+	}
+
+	resourceType, ok := certificateOwnerTypes[owner.Kind]
+	if !ok {
+		return
+	}
+
+	if notAfter := cert.Status.NotAfter; notAfter != nil {
+		CertificateExpirationTime.
+			WithLabelValues(resourceType, owner.Name, cert.Namespace, certName, caName).
+			Set(float64(notAfter.Unix()))
+	}
+
+	if renewalTime := cert.Status.RenewalTime; renewalTime != nil {
+		CertificateRenewalTime.
+			WithLabelValues(resourceType, owner.Name, cert.Namespace, certName, caName).
+			Set(float64(renewalTime.Unix()))
+	}
+}
@@ -0,0 +1,74 @@
+/*
+Copyright 2026 The kcp Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package metrics
+
+import (
+	"testing"
+	"time"
+
+	certmanagerv1 "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/kcp-dev/kcp-operator/internal/resources"
+	operatorv1alpha1 "github.com/kcp-dev/kcp-operator/sdk/apis/operator/v1alpha1"
+)
+
+func TestRecordCertificate(t *testing.T) {
+	CertificateExpirationTime.Reset()
+	CertificateRenewalTime.Reset()
+
+	notAfter := time.Date(2027, 1, 1, 0, 0, 0, 0, time.UTC)
+	renewalTime := time.Date(2026, 12, 1, 0, 0, 0, 0, time.UTC)
+
+	owner := &operatorv1alpha1.RootShard{ObjectMeta: metav1.ObjectMeta{Name: "root", UID: "owner"}}
+
+	cert := &certmanagerv1.Certificate{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:            "root-server-ca",
+			Namespace:       "kcp",
+			Labels:          map[string]string{resources.CALabel: string(operatorv1alpha1.ServerCA)},
+			OwnerReferences: []metav1.OwnerReference{*metav1.NewControllerRef(owner, operatorv1alpha1.SchemeGroupVersion.WithKind("RootShard"))},
+		},
+		Status: certmanagerv1.CertificateStatus{
+			NotAfter:    &metav1.Time{Time: notAfter},
+			RenewalTime: &metav1.Time{Time: renewalTime},
+		},
+	}
+	recordCertificate(cert)
+
+	// Certificates that have not been created by the operator are ignored.
+	unlabelled := cert.DeepCopy()
+	unlabelled.Name = "foreign"
+	unlabelled.Labels = nil
+	recordCertificate(unlabelled)
+
+	if count := testutil.CollectAndCount(CertificateExpirationTime); count != 1 {
+		t.Fatalf("Expected 1 expiration time, got %d.", count)
+	}
+
+	expiration := testutil.ToFloat64(CertificateExpirationTime.WithLabelValues(RootShardResourceType, "root", "kcp", "", "server"))
+	if expiration != float64(notAfter.Unix()) {
+		t.Errorf("Expected expiration time %d, got %v.", notAfter.Unix(), expiration)
+	}
+
+	renewal := testutil.ToFloat64(CertificateRenewalTime.WithLabelValues(RootShardResourceType, "root", "kcp", "", "server"))
+	if renewal != float64(renewalTime.Unix()) {
+		t.Errorf("Expected renewal time %d, got %v.", renewalTime.Unix(), renewal)
+	}
+}
@@ -28,6 +28,10 @@ const (
 	KubeconfigResourceType       = "kubeconfig"
 	VirtualWorkspaceResourceType = "virtualworkspace"
 
+	EtcdSnapshotResourceType       = "etcdsnapshot"
+	EtcdBackupScheduleResourceType = "etcdbackupschedule"
+	EtcdRestoreResourceType        = "etcdrestore"
+
 	CompiledRootShardResourceType        = "compiledrootshard"
 	CompiledShardResourceType            = "compiledshard"
 	CompiledFrontProxyResourceType       = "compiledfrontproxy"
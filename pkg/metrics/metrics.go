@@ -53,14 +53,14 @@ var (
 		[]string{"phase", "namespace"},
 	)
 
-	// CacheServerCount tracks the number of CacheServer objects by namespace.
-	// Labels: namespace
+	// CacheServerCount tracks the number of CacheServer objects by their current phase.
+	// Labels: phase (Provisioning|Running|Deleting), namespace
 	CacheServerCount = prometheus.NewGaugeVec(
 		prometheus.GaugeOpts{
 			Name: "kcp_operator_cacheserver_count",
-			Help: "Number of CacheServer objects by namespace",
+			Help: "Number of CacheServer objects by phase",
 		},
-		[]string{"namespace"},
+		[]string{"phase", "namespace"},
 	)
 
 	// KubeconfigCount tracks the number of Kubeconfig objects by namespace.
@@ -104,6 +104,77 @@ var (
 		[]string{"controller", "error_type"},
 	)
 
+	// PublishDuration measures the time from a generation change of an object until its Compiled*
+	// object has been updated.
+	// Labels: resource_type (rootshard|shard|frontproxy|cacheserver|virtualworkspace)
+	PublishDuration = prometheus.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Name:    "kcp_operator_publish_duration_seconds",
+			Help:    "Time from a generation change until the Compiled object was updated",
+			Buckets: propagationBuckets,
+		},
+		[]string{"resource_type"},
+	)
+
+	// RolloutDuration measures the time from updating a Compiled* object until the Deployment
+	// rendered from it is fully available.
+	// Labels: resource_type (rootshard|shard|frontproxy|cacheserver|virtualworkspace)
+	RolloutDuration = prometheus.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Name:    "kcp_operator_rollout_duration_seconds",
+			Help:    "Time from updating a Compiled object until its Deployment was fully available",
+			Buckets: propagationBuckets,
+		},
+		[]string{"resource_type"},
+	)
+
+	// ComponentVersionInfo exposes the image and kcp version a component is running. The value is
+	// always 1.
+	// Labels: resource_type (rootshard|shard|frontproxy|virtualworkspace), resource_name, namespace,
+	//         image, version
+	ComponentVersionInfo = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: "kcp_operator_component_version_info",
+			Help: "Image and kcp version running for each component",
+		},
+		[]string{"resource_type", "resource_name", "namespace", "image", "version"},
+	)
+
+	// PausedObjectCount tracks the number of objects that are paused using the
+	// operator.kcp.io/paused annotation.
+	// Labels: resource_type (rootshard|shard|frontproxy|cacheserver|virtualworkspace), namespace
+	PausedObjectCount = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: "kcp_operator_paused_objects",
+			Help: "Number of objects whose reconciliation is paused",
+		},
+		[]string{"resource_type", "namespace"},
+	)
+
+	// CertificateExpirationTime exposes the time at which a certificate or CA expires, as a Unix
+	// timestamp.
+	// Labels: resource_type (rootshard|shard|frontproxy|cacheserver|virtualworkspace|kubeconfig),
+	//         resource_name, namespace, certificate, ca
+	CertificateExpirationTime = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: "kcp_operator_certificate_expiration_timestamp_seconds",
+			Help: "Time at which a certificate expires",
+		},
+		[]string{"resource_type", "resource_name", "namespace", "certificate", "ca"},
+	)
+
+	// CertificateRenewalTime exposes the time at which cert-manager will renew a certificate or
+	// CA, as a Unix timestamp.
+	// Labels: resource_type (rootshard|shard|frontproxy|cacheserver|virtualworkspace|kubeconfig),
+	//         resource_name, namespace, certificate, ca
+	CertificateRenewalTime = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: "kcp_operator_certificate_renewal_timestamp_seconds",
+			Help: "Time at which a certificate will be renewed",
+		},
+		[]string{"resource_type", "resource_name", "namespace", "certificate", "ca"},
+	)
+
 	// ConditionStatus tracks the status of conditions on kcp operator resources.
 	// Values: 1.0 (True), 0.0 (False), -1.0 (Unknown)
 	// Labels: resource_type (rootshard|shard|frontproxy|cacheserver|kubeconfig),
@@ -127,6 +198,12 @@ func RegisterMetrics() {
 		VirtualWorkspaceCount,
 		ReconciliationDuration,
 		ReconciliationErrors,
+		PublishDuration,
+		RolloutDuration,
 		ConditionStatus,
+		ComponentVersionInfo,
+		PausedObjectCount,
+		CertificateExpirationTime,
+		CertificateRenewalTime,
 	)
 }
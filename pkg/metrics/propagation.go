@@ -0,0 +1,126 @@
+/*
+Copyright 2026 The kcp Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package metrics
+
+import (
+	"sync"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/lru"
+
+	deployv1alpha1 "github.com/kcp-dev/kcp-operator/sdk/apis/deploy/v1alpha1"
+)
+
+// propagationCacheSize is the number of objects for which the propagation state is remembered.
+const propagationCacheSize = 4096
+
+// propagationBuckets range from the time a single reconcile takes to rollouts of large
+// Deployments.
+var propagationBuckets = []float64{0.1, 0.5, 1, 2.5, 5, 10, 30, 60, 120, 300, 600, 1800}
+
+var (
+	// processStart is used to ignore revisions that were published before the operator started.
+	processStart = time.Now()
+
+	propagationLock    sync.Mutex
+	pendingGenerations = lru.New(propagationCacheSize)
+	observedRollouts   = lru.New(propagationCacheSize)
+)
+
+type pendingGeneration struct {
+	generation int64
+	// changedAt is the time the oldest unpublished generation was first reconciled, or zero if
+	// all generations have been published.
+	changedAt time.Time
+}
+
+// RecordGeneration remembers when a new generation of obj was first reconciled. The first
+// generation that is seen for an object is not considered a change, as the operator might have
+// been restarted long after it was made.
+func RecordGeneration(obj metav1.Object) {
+	propagationLock.Lock()
+	defer propagationLock.Unlock()
+
+	value, ok := pendingGenerations.Get(obj.GetUID())
+	if !ok {
+		pendingGenerations.Add(obj.GetUID(), pendingGeneration{generation: obj.GetGeneration()})
+		return
+	}
+
+	pending := value.(pendingGeneration)
+	if pending.generation == obj.GetGeneration() {
+		return
+	}
+
+	pending.generation = obj.GetGeneration()
+	if pending.changedAt.IsZero() {
+		pending.changedAt = time.Now()
+	}
+	pendingGenerations.Add(obj.GetUID(), pending)
+}
+
+// RecordPublished observes the time since the oldest unpublished generation change of obj if its
+// Compiled* object has been changed. If not, the change did not need to be propagated. Either
+// way, obj is considered published afterwards.
+func RecordPublished(resourceType string, obj metav1.Object, changed bool) {
+	propagationLock.Lock()
+	defer propagationLock.Unlock()
+
+	value, ok := pendingGenerations.Get(obj.GetUID())
+	if !ok {
+		return
+	}
+
+	pending := value.(pendingGeneration)
+	if pending.changedAt.IsZero() {
+		return
+	}
+
+	if changed {
+		PublishDuration.WithLabelValues(resourceType).Observe(time.Since(pending.changedAt).Seconds())
+	}
+
+	pending.changedAt = time.Time{}
+	pendingGenerations.Add(obj.GetUID(), pending)
+}
+
+// RecordRollout observes the time from publishing the current revision of a Compiled* object
+// until the Deployment rendered from it is ready. The Deployment belongs to that revision if it
+// carries the same PublishedAtAnnotation. Every revision is observed once; revisions published
+// before the operator started are ignored, as a previous process might have observed them.
+func RecordRollout(resourceType string, compiled metav1.Object, deployment metav1.Object, ready bool) {
+	publishedAt := compiled.GetAnnotations()[deployv1alpha1.PublishedAtAnnotation]
+	if !ready || publishedAt == "" || deployment.GetAnnotations()[deployv1alpha1.PublishedAtAnnotation] != publishedAt {
+		return
+	}
+
+	published, err := time.Parse(time.RFC3339Nano, publishedAt)
+	if err != nil || published.Before(processStart) {
+		return
+	}
+
+	propagationLock.Lock()
+	defer propagationLock.Unlock()
+
+	if observed, ok := observedRollouts.Get(compiled.GetUID()); ok && observed == publishedAt {
+		return
+	}
+
+	observedRollouts.Add(compiled.GetUID(), publishedAt)
+	RolloutDuration.WithLabelValues(resourceType).Observe(time.Since(published).Seconds())
+}
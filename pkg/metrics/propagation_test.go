@@ -0,0 +1,112 @@
+/*
+Copyright 2026 The kcp Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package metrics
+
+import (
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	dto "github.com/prometheus/client_model/go"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	deployv1alpha1 "github.com/kcp-dev/kcp-operator/sdk/apis/deploy/v1alpha1"
+)
+
+func sampleCount(t *testing.T, vec *prometheus.HistogramVec, resourceType string) uint64 {
+	t.Helper()
+
+	var m dto.Metric
+	if err := vec.WithLabelValues(resourceType).(prometheus.Histogram).Write(&m); err != nil {
+		t.Fatalf("Failed to read histogram: %v", err)
+	}
+
+	return m.GetHistogram().GetSampleCount()
+}
+
+func TestRecordPublished(t *testing.T) {
+	const resourceType = "test-published"
+
+	obj := &metav1.ObjectMeta{UID: types.UID("published"), Generation: 1}
+
+	// the first generation is not a change
+	RecordGeneration(obj)
+	RecordPublished(resourceType, obj, true)
+	if count := sampleCount(t, PublishDuration, resourceType); count != 0 {
+		t.Fatalf("Expected no observation for the initial generation, got %d.", count)
+	}
+
+	// a change that did not need to be published is forgotten
+	obj.Generation = 2
+	RecordGeneration(obj)
+	RecordPublished(resourceType, obj, false)
+	RecordPublished(resourceType, obj, true)
+	if count := sampleCount(t, PublishDuration, resourceType); count != 0 {
+		t.Fatalf("Expected no observation for an unpublished change, got %d.", count)
+	}
+
+	// two changes that are published together are observed once
+	obj.Generation = 3
+	RecordGeneration(obj)
+	obj.Generation = 4
+	RecordGeneration(obj)
+	RecordPublished(resourceType, obj, true)
+	RecordPublished(resourceType, obj, true)
+	if count := sampleCount(t, PublishDuration, resourceType); count != 1 {
+		t.Fatalf("Expected 1 observation, got %d.", count)
+	}
+}
+
+func TestRecordRollout(t *testing.T) {
+	const resourceType = "test-rollout"
+
+	publishedAt := time.Now().UTC().Format(time.RFC3339Nano)
+	compiled := &metav1.ObjectMeta{
+		UID:         types.UID("rollout"),
+		Annotations: map[string]string{deployv1alpha1.PublishedAtAnnotation: publishedAt},
+	}
+	staleDeployment := &metav1.ObjectMeta{
+		Annotations: map[string]string{deployv1alpha1.PublishedAtAnnotation: processStart.Add(-time.Hour).Format(time.RFC3339Nano)},
+	}
+	deployment := &metav1.ObjectMeta{
+		Annotations: map[string]string{deployv1alpha1.PublishedAtAnnotation: publishedAt},
+	}
+
+	RecordRollout(resourceType, compiled, staleDeployment, true)
+	RecordRollout(resourceType, compiled, deployment, false)
+	if count := sampleCount(t, RolloutDuration, resourceType); count != 0 {
+		t.Fatalf("Expected no observation before the rollout is complete, got %d.", count)
+	}
+
+	RecordRollout(resourceType, compiled, deployment, true)
+	RecordRollout(resourceType, compiled, deployment, true)
+	if count := sampleCount(t, RolloutDuration, resourceType); count != 1 {
+		t.Fatalf("Expected 1 observation, got %d.", count)
+	}
+
+	// revisions published before the operator started are ignored
+	oldCompiled := &metav1.ObjectMeta{
+		UID:         types.UID("old-rollout"),
+		Annotations: staleDeployment.Annotations,
+	}
+	RecordRollout(resourceType, oldCompiled, staleDeployment, true)
+	if count := sampleCount(t, RolloutDuration, resourceType); count != 1 {
+		t.Fatalf("Expected revisions from before the start to be ignored, got %d observations.", count)
+	}
+}
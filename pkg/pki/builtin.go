@@ -0,0 +1,636 @@
+/*
+Copyright 2026 The kcp Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package pki
+
+import (
+	"context"
+	"crypto"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"time"
+
+	certmanagerv1 "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
+	certmanagermetav1 "github.com/cert-manager/cert-manager/pkg/apis/meta/v1"
+	cmpki "github.com/cert-manager/cert-manager/pkg/util/pki"
+	k8creconciling "k8c.io/reconciler/pkg/reconciling"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	ctrlruntimeclient "sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	"github.com/kcp-dev/kcp-operator/internal/resources"
+	"github.com/kcp-dev/kcp-operator/pkg/reconciling"
+	operatorv1alpha1 "github.com/kcp-dev/kcp-operator/sdk/apis/operator/v1alpha1"
+)
+
+const (
+	// CertificateNameLabel marks Secrets issued by the built-in backend and holds the name of
+	// the certificate they were issued for.
+	CertificateNameLabel = "pki.operator.kcp.io/certificate-name"
+
+	// IssuerLabel marks the ConfigMaps the built-in backend stores its issuers in.
+	IssuerLabel = "pki.operator.kcp.io/issuer"
+
+	// RevisionAnnotation counts how often a certificate has been issued into its Secret, like
+	// the revision in the status of a cert-manager Certificate.
+	RevisionAnnotation = "pki.operator.kcp.io/revision"
+
+	// SpecHashAnnotation identifies the certificate spec a Secret was issued for, so that
+	// changing the spec issues the certificate again.
+	SpecHashAnnotation = "pki.operator.kcp.io/spec-hash"
+
+	// RenewalTimeAnnotation holds the time at which the certificate in a Secret is renewed.
+	RenewalTimeAnnotation = "pki.operator.kcp.io/renewal-time"
+
+	// ReissueAnnotation asks for the certificate in a Secret to be issued again the next time
+	// it is reconciled, even if it is not due for renewal yet.
+	ReissueAnnotation = "pki.operator.kcp.io/reissue"
+
+	// issuerSecretNameKey holds the name of the CA Secret in an issuer's ConfigMap.
+	issuerSecretNameKey = "secretName"
+)
+
+// Builtin issues certificates within the operator, for clusters that cannot run cert-manager.
+// Certificates are not stored as objects of their own: each is issued straight into its
+// Secret, which carries everything needed to reconstruct the certificate's status. Issuers are
+// stored as ConfigMaps that point to their CA Secret.
+//
+// Only CA issuers are supported. Root CAs (see resources.CALabel) are self-signed, as the
+// external issuer they reference only exists with cert-manager.
+//
+// Unlike with cert-manager, Secrets are owned by the owner of their certificate and are
+// removed along with it. Certificates are renewed when their owner is reconciled, which
+// happens at least once per resync period of the manager.
+type Builtin struct {
+	// now returns the current time, it is only overridden in tests.
+	now func() time.Time
+}
+
+var _ Backend = Builtin{}
+
+// +kubebuilder:rbac:groups=core,resources=configmaps;secrets,verbs=get;list;watch;create;update;patch
+
+func (b Builtin) ReconcileCertificates(ctx context.Context, namedFactories []reconciling.NamedCertificateReconcilerFactory, namespace string, client ctrlruntimeclient.Client, objectModifiers ...k8creconciling.ObjectModifier) error {
+	for _, factory := range namedFactories {
+		name, reconciler := factory()
+		reconcileObject := reconciling.CertificateObjectWrapper(reconciler)
+		reconcileObject = k8creconciling.CreateWithNamespace(reconcileObject, namespace)
+		reconcileObject = k8creconciling.CreateWithName(reconcileObject, name)
+
+		for _, objectModifier := range objectModifiers {
+			reconcileObject = objectModifier(reconcileObject)
+		}
+
+		if err := b.ensureCertificate(ctx, client, reconcileObject); err != nil {
+			return fmt.Errorf("failed to ensure Certificate %s/%s: %w", namespace, name, err)
+		}
+	}
+
+	return nil
+}
+
+func (b Builtin) ensureCertificate(ctx context.Context, client ctrlruntimeclient.Client, reconcileObject k8creconciling.ObjectReconciler) error {
+	// Modifiers like modifier.Capture hold on to the object passed in, so its status has to be
+	// filled in once the certificate has been issued.
+	cert := &certmanagerv1.Certificate{}
+
+	obj, err := reconcileObject(cert)
+	if err != nil {
+		return err
+	}
+
+	desired, ok := obj.(*certmanagerv1.Certificate)
+	if !ok {
+		return fmt.Errorf("reconciler returned %T instead of a Certificate", obj)
+	}
+
+	status, err := b.issue(ctx, client, desired)
+	if err != nil {
+		return err
+	}
+
+	cert.Status = status
+	desired.Status = status
+
+	return nil
+}
+
+// issue ensures the certificate's Secret contains a valid certificate and returns the status
+// of the certificate.
+func (b Builtin) issue(ctx context.Context, client ctrlruntimeclient.Client, cert *certmanagerv1.Certificate) (certmanagerv1.CertificateStatus, error) {
+	now := b.clock()
+
+	secret := &corev1.Secret{}
+	if err := client.Get(ctx, types.NamespacedName{Namespace: cert.Namespace, Name: cert.Spec.SecretName}, secret); err != nil {
+		if !apierrors.IsNotFound(err) {
+			return certmanagerv1.CertificateStatus{}, fmt.Errorf("failed to get Secret: %w", err)
+		}
+		secret = nil
+	}
+
+	issuer, pending, err := getIssuer(ctx, client, cert)
+	if err != nil {
+		return certmanagerv1.CertificateStatus{}, err
+	}
+
+	// Like cert-manager, keep the current certificate until the issuer becomes available.
+	if issuer == nil {
+		if secret != nil {
+			return certificateStatus(secret, now), nil
+		}
+		return pendingStatus(pending, now), nil
+	}
+
+	hash, err := specHash(cert)
+	if err != nil {
+		return certmanagerv1.CertificateStatus{}, err
+	}
+
+	var (
+		revision int
+		data     map[string][]byte
+	)
+
+	if secret != nil {
+		revision, _ = strconv.Atoi(secret.Annotations[RevisionAnnotation])
+	}
+
+	if reason := needsIssuing(secret, cert, hash, issuer, now); reason != "" {
+		log.FromContext(ctx).V(2).Info("Issuing certificate", "certificate", cert.Name, "reason", reason)
+
+		data, err = issuer.sign(cert)
+		if err != nil {
+			return certmanagerv1.CertificateStatus{}, fmt.Errorf("failed to issue certificate: %w", err)
+		}
+
+		revision++
+	} else {
+		// Secrets issued by cert-manager are adopted as they are.
+		revision = max(revision, 1)
+	}
+
+	leaf, err := cmpki.DecodeX509CertificateBytes(secretData(secret, data)[corev1.TLSCertKey])
+	if err != nil {
+		return certmanagerv1.CertificateStatus{}, fmt.Errorf("failed to parse issued certificate: %w", err)
+	}
+	renewalTime := cmpki.RenewalTime(leaf.NotBefore, leaf.NotAfter, cert.Spec.RenewBefore, cert.Spec.RenewBeforePercentage)
+
+	// The issuer annotations are the same cert-manager puts on the Secrets it issues.
+	reconciler := certificateSecretReconciler(cert, data, map[string]string{
+		RevisionAnnotation:                     strconv.Itoa(revision),
+		SpecHashAnnotation:                     hash,
+		RenewalTimeAnnotation:                  renewalTime.UTC().Format(time.RFC3339),
+		certmanagerv1.IssuerNameAnnotationKey:  cert.Spec.IssuerRef.Name,
+		certmanagerv1.IssuerKindAnnotationKey:  cert.Spec.IssuerRef.Kind,
+		certmanagerv1.IssuerGroupAnnotationKey: cert.Spec.IssuerRef.Group,
+	})
+
+	if err := k8creconciling.ReconcileSecrets(ctx, []k8creconciling.NamedSecretReconcilerFactory{reconciler}, cert.Namespace, client); err != nil {
+		return certmanagerv1.CertificateStatus{}, err
+	}
+
+	if secret == nil {
+		secret = &corev1.Secret{}
+	}
+	_, reconcile := reconciler()
+	issued, err := reconcile(secret.DeepCopy())
+	if err != nil {
+		return certmanagerv1.CertificateStatus{}, err
+	}
+
+	return certificateStatus(issued, now), nil
+}
+
+func (b Builtin) clock() time.Time {
+	if b.now != nil {
+		return b.now()
+	}
+	return time.Now()
+}
+
+func secretData(secret *corev1.Secret, issued map[string][]byte) map[string][]byte {
+	if issued != nil {
+		return issued
+	}
+	return secret.Data
+}
+
+func certificateSecretReconciler(cert *certmanagerv1.Certificate, data map[string][]byte, annotations map[string]string) k8creconciling.NamedSecretReconcilerFactory {
+	return func() (string, k8creconciling.SecretReconciler) {
+		return cert.Spec.SecretName, func(secret *corev1.Secret) (*corev1.Secret, error) {
+			labels := map[string]string{}
+			if template := cert.Spec.SecretTemplate; template != nil {
+				secret.Annotations = mergeInto(secret.Annotations, template.Annotations)
+				labels = template.Labels
+			}
+
+			secret.Labels = mergeInto(secret.Labels, cert.Labels)
+			secret.Labels = mergeInto(secret.Labels, labels)
+			secret.Labels = mergeInto(secret.Labels, map[string]string{CertificateNameLabel: cert.Name})
+			secret.Annotations = mergeInto(secret.Annotations, annotations)
+			secret.OwnerReferences = cert.OwnerReferences
+
+			// The type of a Secret cannot be changed after it has been created.
+			if secret.Type == "" {
+				secret.Type = corev1.SecretTypeTLS
+			}
+
+			if data != nil {
+				secret.Data = mergeInto(secret.Data, data)
+				delete(secret.Annotations, ReissueAnnotation)
+			}
+
+			return secret, nil
+		}
+	}
+}
+
+func mergeInto[V any](dst, src map[string]V) map[string]V {
+	if len(src) == 0 {
+		return dst
+	}
+
+	if dst == nil {
+		dst = make(map[string]V, len(src))
+	}
+
+	for k, v := range src {
+		dst[k] = v
+	}
+
+	return dst
+}
+
+// specHash identifies the parts of a certificate spec that end up in the certificate.
+func specHash(cert *certmanagerv1.Certificate) (string, error) {
+	spec := cert.Spec.DeepCopy()
+	spec.SecretTemplate = nil
+
+	encoded, err := json.Marshal(spec)
+	if err != nil {
+		return "", fmt.Errorf("failed to encode certificate spec: %w", err)
+	}
+
+	sum := sha256.Sum256(encoded)
+
+	return hex.EncodeToString(sum[:8]), nil
+}
+
+// needsIssuing returns why the certificate has to be issued into the Secret, or an empty
+// string if the current one can be kept.
+func needsIssuing(secret *corev1.Secret, cert *certmanagerv1.Certificate, hash string, issuer *issuer, now time.Time) string {
+	if secret == nil {
+		return "Secret does not exist"
+	}
+
+	if previous := secret.Annotations[SpecHashAnnotation]; previous != "" && previous != hash {
+		return "certificate spec has changed"
+	}
+
+	if _, requested := secret.Annotations[ReissueAnnotation]; requested {
+		return "certificate was requested to be issued again"
+	}
+
+	leaf, err := cmpki.DecodeX509CertificateBytes(secret.Data[corev1.TLSCertKey])
+	if err != nil {
+		return "Secret contains no valid certificate"
+	}
+
+	key, err := cmpki.DecodePrivateKeyBytes(secret.Data[corev1.TLSPrivateKeyKey])
+	if err != nil {
+		return "Secret contains no valid private key"
+	}
+
+	if matches, err := cmpki.PublicKeyMatchesCertificate(key.Public(), leaf); err != nil || !matches {
+		return "private key does not match certificate"
+	}
+
+	if err := issuer.verify(leaf); err != nil {
+		return "certificate was not signed by the current issuer"
+	}
+
+	if renewalTime := cmpki.RenewalTime(leaf.NotBefore, leaf.NotAfter, cert.Spec.RenewBefore, cert.Spec.RenewBeforePercentage); !now.Before(renewalTime.Time) {
+		return "certificate is due for renewal"
+	}
+
+	return ""
+}
+
+// issuer signs certificates with a CA, or self-signs them if it has none.
+type issuer struct {
+	caCerts []*x509.Certificate
+	caKey   crypto.Signer
+}
+
+// getIssuer resolves the issuer of a certificate. If the issuer is not available yet, nil and
+// the reason are returned.
+func getIssuer(ctx context.Context, client ctrlruntimeclient.Client, cert *certmanagerv1.Certificate) (*issuer, string, error) {
+	if cert.Labels[resources.CALabel] == string(operatorv1alpha1.RootCA) {
+		return &issuer{}, "", nil
+	}
+
+	ref := cert.Spec.IssuerRef
+	if ref.Kind != "" && ref.Kind != certmanagerv1.IssuerKind {
+		return nil, "", fmt.Errorf("issuers of kind %s are not supported by the built-in PKI", ref.Kind)
+	}
+
+	configMap := &corev1.ConfigMap{}
+	if err := client.Get(ctx, types.NamespacedName{Namespace: cert.Namespace, Name: ref.Name}, configMap); err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil, fmt.Sprintf("Issuer %s does not exist yet.", ref.Name), nil
+		}
+		return nil, "", fmt.Errorf("failed to get Issuer %s: %w", ref.Name, err)
+	}
+
+	if _, ok := configMap.Labels[IssuerLabel]; !ok {
+		return nil, "", fmt.Errorf("ConfigMap %s is not an Issuer", ref.Name)
+	}
+
+	secretName := configMap.Data[issuerSecretNameKey]
+
+	caSecret := &corev1.Secret{}
+	if err := client.Get(ctx, types.NamespacedName{Namespace: cert.Namespace, Name: secretName}, caSecret); err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil, fmt.Sprintf("CA Secret %s of Issuer %s does not exist yet.", secretName, ref.Name), nil
+		}
+		return nil, "", fmt.Errorf("failed to get CA Secret %s: %w", secretName, err)
+	}
+
+	caCerts, err := cmpki.DecodeX509CertificateChainBytes(caSecret.Data[corev1.TLSCertKey])
+	if err != nil {
+		return nil, fmt.Sprintf("CA Secret %s contains no valid certificate.", secretName), nil
+	}
+
+	caKey, err := cmpki.DecodePrivateKeyBytes(caSecret.Data[corev1.TLSPrivateKeyKey])
+	if err != nil {
+		return nil, fmt.Sprintf("CA Secret %s contains no valid private key.", secretName), nil
+	}
+
+	return &issuer{caCerts: caCerts, caKey: caKey}, "", nil
+}
+
+func (i *issuer) verify(cert *x509.Certificate) error {
+	if i.caCerts == nil {
+		return cert.CheckSignatureFrom(cert)
+	}
+	return cert.CheckSignatureFrom(i.caCerts[0])
+}
+
+// sign issues a new certificate with a new private key. The Secret data is laid out the same
+// way cert-manager's CA and self-signed issuers do.
+func (i *issuer) sign(cert *certmanagerv1.Certificate) (map[string][]byte, error) {
+	template, err := cmpki.CertificateTemplateFromCertificate(cert)
+	if err != nil {
+		return nil, err
+	}
+
+	key, err := cmpki.GeneratePrivateKeyForCertificate(cert)
+	if err != nil {
+		return nil, err
+	}
+	template.PublicKey = key.Public()
+
+	var bundle cmpki.PEMBundle
+	if i.caCerts == nil {
+		certPEM, _, err := cmpki.SignCertificate(template, template, key.Public(), key)
+		if err != nil {
+			return nil, err
+		}
+		bundle = cmpki.PEMBundle{ChainPEM: certPEM, CAPEM: certPEM}
+	} else {
+		bundle, err = cmpki.SignCSRTemplate(i.caCerts, i.caKey, template)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	var encoding certmanagerv1.PrivateKeyEncoding
+	if cert.Spec.PrivateKey != nil {
+		encoding = cert.Spec.PrivateKey.Encoding
+	}
+
+	keyPEM, err := cmpki.EncodePrivateKey(key, encoding)
+	if err != nil {
+		return nil, err
+	}
+
+	return map[string][]byte{
+		corev1.TLSCertKey:       bundle.ChainPEM,
+		corev1.TLSPrivateKeyKey: keyPEM,
+		"ca.crt":                bundle.CAPEM,
+	}, nil
+}
+
+// certificateStatus reconstructs the status of a certificate from the Secret it was issued into.
+func certificateStatus(secret *corev1.Secret, now time.Time) certmanagerv1.CertificateStatus {
+	leaf, err := cmpki.DecodeX509CertificateBytes(secret.Data[corev1.TLSCertKey])
+	if err != nil {
+		return pendingStatus(fmt.Sprintf("Secret %s contains no valid certificate.", secret.Name), now)
+	}
+
+	status := certmanagerv1.CertificateStatus{
+		NotBefore: &metav1.Time{Time: leaf.NotBefore},
+		NotAfter:  &metav1.Time{Time: leaf.NotAfter},
+	}
+
+	if revision, err := strconv.Atoi(secret.Annotations[RevisionAnnotation]); err == nil {
+		status.Revision = &revision
+	}
+
+	if renewalTime, err := time.Parse(time.RFC3339, secret.Annotations[RenewalTimeAnnotation]); err == nil {
+		status.RenewalTime = &metav1.Time{Time: renewalTime}
+	}
+
+	if now.Before(leaf.NotAfter) {
+		status.Conditions = []certmanagerv1.CertificateCondition{{
+			Type:               certmanagerv1.CertificateConditionReady,
+			Status:             certmanagermetav1.ConditionTrue,
+			Reason:             "Ready",
+			Message:            "Certificate is up to date and has not expired",
+			LastTransitionTime: status.NotBefore,
+		}}
+	} else {
+		status.Conditions = []certmanagerv1.CertificateCondition{{
+			Type:               certmanagerv1.CertificateConditionReady,
+			Status:             certmanagermetav1.ConditionFalse,
+			Reason:             "Expired",
+			Message:            fmt.Sprintf("Certificate expired on %s", leaf.NotAfter.UTC().Format(time.RFC3339)),
+			LastTransitionTime: status.NotAfter,
+		}}
+	}
+
+	return status
+}
+
+func pendingStatus(message string, now time.Time) certmanagerv1.CertificateStatus {
+	return certmanagerv1.CertificateStatus{
+		Conditions: []certmanagerv1.CertificateCondition{{
+			Type:               certmanagerv1.CertificateConditionReady,
+			Status:             certmanagermetav1.ConditionFalse,
+			Reason:             "Pending",
+			Message:            message,
+			LastTransitionTime: &metav1.Time{Time: now},
+		}},
+	}
+}
+
+func (Builtin) ReconcileIssuers(ctx context.Context, namedFactories []reconciling.NamedIssuerReconcilerFactory, namespace string, client ctrlruntimeclient.Client, objectModifiers ...k8creconciling.ObjectModifier) error {
+	configMapReconcilers := make([]k8creconciling.NamedConfigMapReconcilerFactory, 0, len(namedFactories))
+
+	for _, factory := range namedFactories {
+		name, reconciler := factory()
+		reconcileObject := reconciling.IssuerObjectWrapper(reconciler)
+		reconcileObject = k8creconciling.CreateWithNamespace(reconcileObject, namespace)
+		reconcileObject = k8creconciling.CreateWithName(reconcileObject, name)
+
+		for _, objectModifier := range objectModifiers {
+			reconcileObject = objectModifier(reconcileObject)
+		}
+
+		obj, err := reconcileObject(&certmanagerv1.Issuer{})
+		if err != nil {
+			return fmt.Errorf("failed to ensure Issuer %s/%s: %w", namespace, name, err)
+		}
+
+		issuer, ok := obj.(*certmanagerv1.Issuer)
+		if !ok {
+			return fmt.Errorf("failed to ensure Issuer %s/%s: reconciler returned %T", namespace, name, obj)
+		}
+
+		if issuer.Spec.CA == nil {
+			return fmt.Errorf("failed to ensure Issuer %s/%s: only CA issuers are supported by the built-in PKI", namespace, name)
+		}
+
+		configMapReconcilers = append(configMapReconcilers, issuerConfigMapReconciler(issuer))
+	}
+
+	return k8creconciling.ReconcileConfigMaps(ctx, configMapReconcilers, namespace, client)
+}
+
+func issuerConfigMapReconciler(issuer *certmanagerv1.Issuer) k8creconciling.NamedConfigMapReconcilerFactory {
+	return func() (string, k8creconciling.ConfigMapReconciler) {
+		return issuer.Name, func(configMap *corev1.ConfigMap) (*corev1.ConfigMap, error) {
+			configMap.Labels = mergeInto(configMap.Labels, issuer.Labels)
+			configMap.Labels = mergeInto(configMap.Labels, map[string]string{IssuerLabel: "true"})
+			configMap.OwnerReferences = issuer.OwnerReferences
+			configMap.Data = map[string]string{
+				issuerSecretNameKey: issuer.Spec.CA.SecretName,
+			}
+
+			return configMap, nil
+		}
+	}
+}
+
+func (b Builtin) GetCertificate(ctx context.Context, client ctrlruntimeclient.Reader, key types.NamespacedName) (*certmanagerv1.Certificate, error) {
+	certs, err := b.ListCertificates(ctx, client, ctrlruntimeclient.InNamespace(key.Namespace), ctrlruntimeclient.MatchingLabels{CertificateNameLabel: key.Name})
+	if err != nil {
+		return nil, err
+	}
+
+	if len(certs) == 0 {
+		return nil, apierrors.NewNotFound(certmanagerv1.SchemeGroupVersion.WithResource("certificates").GroupResource(), key.Name)
+	}
+
+	return &certs[0], nil
+}
+
+func (b Builtin) ListCertificates(ctx context.Context, client ctrlruntimeclient.Reader, opts ...ctrlruntimeclient.ListOption) ([]certmanagerv1.Certificate, error) {
+	var secrets corev1.SecretList
+	if err := client.List(ctx, &secrets, append(opts, ctrlruntimeclient.HasLabels{CertificateNameLabel})...); err != nil {
+		return nil, err
+	}
+
+	now := b.clock()
+	certs := make([]certmanagerv1.Certificate, 0, len(secrets.Items))
+
+	for _, secret := range secrets.Items {
+		certs = append(certs, certmanagerv1.Certificate{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:              secret.Labels[CertificateNameLabel],
+				Namespace:         secret.Namespace,
+				Labels:            secret.Labels,
+				OwnerReferences:   secret.OwnerReferences,
+				CreationTimestamp: secret.CreationTimestamp,
+			},
+			Spec: certmanagerv1.CertificateSpec{
+				SecretName: secret.Name,
+				IssuerRef: certmanagermetav1.IssuerReference{
+					Name:  secret.Annotations[certmanagerv1.IssuerNameAnnotationKey],
+					Kind:  secret.Annotations[certmanagerv1.IssuerKindAnnotationKey],
+					Group: secret.Annotations[certmanagerv1.IssuerGroupAnnotationKey],
+				},
+			},
+			Status: certificateStatus(&secret, now),
+		})
+	}
+
+	return certs, nil
+}
+
+// RenewalTime returns the earliest renewal time of the certificates, as the built-in PKI only
+// renews certificates when their owners are reconciled.
+func (Builtin) RenewalTime(certs []certmanagerv1.Certificate) *time.Time {
+	var earliest *time.Time
+
+	for _, cert := range certs {
+		if cert.Status.RenewalTime == nil {
+			continue
+		}
+
+		if renewalTime := cert.Status.RenewalTime.Time; earliest == nil || renewalTime.Before(*earliest) {
+			earliest = &renewalTime
+		}
+	}
+
+	return earliest
+}
+
+// Reissue marks the certificate's Secret with the ReissueAnnotation. The certificate is issued
+// again once its owner is reconciled next, which the change to the Secret triggers.
+func (Builtin) Reissue(ctx context.Context, client ctrlruntimeclient.Client, cert *certmanagerv1.Certificate) error {
+	secret := &corev1.Secret{}
+	if err := client.Get(ctx, types.NamespacedName{Namespace: cert.Namespace, Name: cert.Spec.SecretName}, secret); err != nil {
+		return fmt.Errorf("failed to get Secret: %w", err)
+	}
+
+	if _, requested := secret.Annotations[ReissueAnnotation]; requested {
+		return nil
+	}
+
+	updated := secret.DeepCopy()
+	updated.Annotations = mergeInto(updated.Annotations, map[string]string{ReissueAnnotation: "true"})
+
+	return client.Patch(ctx, updated, ctrlruntimeclient.MergeFrom(secret))
+}
+
+// CertificateType returns Secrets, as certificates are issued straight into them. Controllers
+// usually own Secrets already; watching them twice is harmless.
+func (Builtin) CertificateType() ctrlruntimeclient.Object {
+	return &corev1.Secret{}
+}
+
+func (Builtin) IssuerType() ctrlruntimeclient.Object {
+	return &corev1.ConfigMap{}
+}
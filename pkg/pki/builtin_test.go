@@ -0,0 +1,313 @@
+/*
+Copyright 2026 The kcp Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package pki
+
+import (
+	"context"
+	"crypto/x509"
+	"testing"
+	"time"
+
+	certmanagerv1 "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
+	certmanagermetav1 "github.com/cert-manager/cert-manager/pkg/apis/meta/v1"
+	cmpki "github.com/cert-manager/cert-manager/pkg/util/pki"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	ctrlruntimeclient "sigs.k8s.io/controller-runtime/pkg/client"
+	ctrlruntimefakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/kcp-dev/kcp-operator/internal/resources"
+	"github.com/kcp-dev/kcp-operator/pkg/reconciling"
+	"github.com/kcp-dev/kcp-operator/pkg/reconciling/modifier"
+	operatorv1alpha1 "github.com/kcp-dev/kcp-operator/sdk/apis/operator/v1alpha1"
+)
+
+const testNamespace = "kcp"
+
+func testCertificate(name string, isCA bool, issuer string, dnsNames ...string) reconciling.NamedCertificateReconcilerFactory {
+	return func() (string, reconciling.CertificateReconciler) {
+		return name, func(cert *certmanagerv1.Certificate) (*certmanagerv1.Certificate, error) {
+			if issuer == "" {
+				cert.Labels = map[string]string{resources.CALabel: string(operatorv1alpha1.RootCA)}
+			}
+
+			cert.Spec = certmanagerv1.CertificateSpec{
+				IsCA:       isCA,
+				CommonName: name,
+				DNSNames:   dnsNames,
+				SecretName: name,
+				Duration:   &metav1.Duration{Duration: 24 * time.Hour},
+				PrivateKey: &certmanagerv1.CertificatePrivateKey{
+					Algorithm: certmanagerv1.ECDSAKeyAlgorithm,
+				},
+				IssuerRef: certmanagermetav1.IssuerReference{
+					Name: issuer,
+				},
+			}
+
+			return cert, nil
+		}
+	}
+}
+
+func testIssuer(name, secretName string) reconciling.NamedIssuerReconcilerFactory {
+	return func() (string, reconciling.IssuerReconciler) {
+		return name, func(issuer *certmanagerv1.Issuer) (*certmanagerv1.Issuer, error) {
+			issuer.Spec.CA = &certmanagerv1.CAIssuer{SecretName: secretName}
+			return issuer, nil
+		}
+	}
+}
+
+func getSecret(t *testing.T, client ctrlruntimeclient.Client, name string) *corev1.Secret {
+	t.Helper()
+
+	secret := &corev1.Secret{}
+	if err := client.Get(context.Background(), types.NamespacedName{Namespace: testNamespace, Name: name}, secret); err != nil {
+		t.Fatalf("failed to get Secret %s: %v", name, err)
+	}
+
+	return secret
+}
+
+func revision(cert *certmanagerv1.Certificate) int {
+	if cert.Status.Revision == nil {
+		return 0
+	}
+	return *cert.Status.Revision
+}
+
+func TestBuiltinIssuesCertificates(t *testing.T) {
+	ctx := context.Background()
+	client := ctrlruntimefakeclient.NewClientBuilder().Build()
+	backend := Builtin{}
+
+	// The leaf certificate stays pending until its issuer exists.
+	var certs []*certmanagerv1.Certificate
+	if err := backend.ReconcileCertificates(ctx, []reconciling.NamedCertificateReconcilerFactory{
+		testCertificate("server", false, "ca", "kcp.example.com"),
+	}, testNamespace, client, modifier.Capture(&certs)); err != nil {
+		t.Fatalf("failed to reconcile certificates: %v", err)
+	}
+
+	if len(certs) != 1 || certs[0].Status.Conditions[0].Reason != "Pending" {
+		t.Fatalf("expected pending certificate, got %+v", certs)
+	}
+
+	if err := backend.ReconcileCertificates(ctx, []reconciling.NamedCertificateReconcilerFactory{
+		testCertificate("ca", true, ""),
+	}, testNamespace, client); err != nil {
+		t.Fatalf("failed to reconcile CA: %v", err)
+	}
+
+	if err := backend.ReconcileIssuers(ctx, []reconciling.NamedIssuerReconcilerFactory{
+		testIssuer("ca", "ca"),
+	}, testNamespace, client); err != nil {
+		t.Fatalf("failed to reconcile issuers: %v", err)
+	}
+
+	certs = nil
+	if err := backend.ReconcileCertificates(ctx, []reconciling.NamedCertificateReconcilerFactory{
+		testCertificate("server", false, "ca", "kcp.example.com"),
+	}, testNamespace, client, modifier.Capture(&certs)); err != nil {
+		t.Fatalf("failed to reconcile certificates: %v", err)
+	}
+
+	if len(certs) != 1 || revision(certs[0]) != 1 {
+		t.Fatalf("expected certificate with revision 1, got %+v", certs)
+	}
+	if cond := certs[0].Status.Conditions[0]; cond.Status != certmanagermetav1.ConditionTrue {
+		t.Fatalf("expected certificate to be ready, got %+v", cond)
+	}
+
+	caSecret := getSecret(t, client, "ca")
+	secret := getSecret(t, client, "server")
+
+	if secret.Type != corev1.SecretTypeTLS {
+		t.Errorf("expected Secret of type %s, got %s", corev1.SecretTypeTLS, secret.Type)
+	}
+	if string(secret.Data["ca.crt"]) != string(caSecret.Data[corev1.TLSCertKey]) {
+		t.Error("expected ca.crt to contain the CA certificate")
+	}
+
+	roots := x509.NewCertPool()
+	roots.AppendCertsFromPEM(secret.Data["ca.crt"])
+
+	leaf, err := cmpki.DecodeX509CertificateBytes(secret.Data[corev1.TLSCertKey])
+	if err != nil {
+		t.Fatalf("failed to parse certificate: %v", err)
+	}
+	if _, err := leaf.Verify(x509.VerifyOptions{DNSName: "kcp.example.com", Roots: roots}); err != nil {
+		t.Errorf("failed to verify certificate: %v", err)
+	}
+
+	key, err := cmpki.DecodePrivateKeyBytes(secret.Data[corev1.TLSPrivateKeyKey])
+	if err != nil {
+		t.Fatalf("failed to parse private key: %v", err)
+	}
+	if matches, _ := cmpki.PublicKeyMatchesCertificate(key.Public(), leaf); !matches {
+		t.Error("expected private key to match certificate")
+	}
+
+	// Reconciling again must keep the certificate.
+	if err := backend.ReconcileCertificates(ctx, []reconciling.NamedCertificateReconcilerFactory{
+		testCertificate("server", false, "ca", "kcp.example.com"),
+	}, testNamespace, client); err != nil {
+		t.Fatalf("failed to reconcile certificates: %v", err)
+	}
+
+	if string(getSecret(t, client, "server").Data[corev1.TLSCertKey]) != string(secret.Data[corev1.TLSCertKey]) {
+		t.Error("expected certificate not to be issued again")
+	}
+
+	// Changing the spec issues the certificate again.
+	certs = nil
+	if err := backend.ReconcileCertificates(ctx, []reconciling.NamedCertificateReconcilerFactory{
+		testCertificate("server", false, "ca", "kcp.example.com", "kcp.example.org"),
+	}, testNamespace, client, modifier.Capture(&certs)); err != nil {
+		t.Fatalf("failed to reconcile certificates: %v", err)
+	}
+
+	if revision(certs[0]) != 2 {
+		t.Errorf("expected revision 2, got %d", revision(certs[0]))
+	}
+
+	cert, err := backend.GetCertificate(ctx, client, types.NamespacedName{Namespace: testNamespace, Name: "server"})
+	if err != nil {
+		t.Fatalf("failed to get certificate: %v", err)
+	}
+	if revision(cert) != 2 || cert.Spec.SecretName != "server" {
+		t.Errorf("expected certificate for Secret server with revision 2, got %+v", cert)
+	}
+
+	if _, err := backend.GetCertificate(ctx, client, types.NamespacedName{Namespace: testNamespace, Name: "missing"}); !apierrors.IsNotFound(err) {
+		t.Errorf("expected NotFound error, got %v", err)
+	}
+
+	list, err := backend.ListCertificates(ctx, client, ctrlruntimeclient.InNamespace(testNamespace))
+	if err != nil {
+		t.Fatalf("failed to list certificates: %v", err)
+	}
+	if len(list) != 2 {
+		t.Errorf("expected 2 certificates, got %d", len(list))
+	}
+}
+
+func TestBuiltinRenewsCertificates(t *testing.T) {
+	ctx := context.Background()
+	client := ctrlruntimefakeclient.NewClientBuilder().Build()
+
+	now := time.Now()
+	backend := Builtin{now: func() time.Time { return now }}
+
+	reconcile := func() {
+		t.Helper()
+		if err := backend.ReconcileCertificates(ctx, []reconciling.NamedCertificateReconcilerFactory{
+			testCertificate("ca", true, ""),
+		}, testNamespace, client); err != nil {
+			t.Fatalf("failed to reconcile certificates: %v", err)
+		}
+	}
+
+	reconcile()
+	issued := getSecret(t, client, "ca")
+
+	// The default renewal is at two thirds of the certificate's lifetime.
+	now = now.Add(12 * time.Hour)
+	reconcile()
+	if string(getSecret(t, client, "ca").Data[corev1.TLSCertKey]) != string(issued.Data[corev1.TLSCertKey]) {
+		t.Fatal("expected certificate not to be renewed yet")
+	}
+
+	now = now.Add(6 * time.Hour)
+	reconcile()
+	renewed := getSecret(t, client, "ca")
+	if string(renewed.Data[corev1.TLSCertKey]) == string(issued.Data[corev1.TLSCertKey]) {
+		t.Fatal("expected certificate to be renewed")
+	}
+	if renewed.Annotations[RevisionAnnotation] != "2" {
+		t.Errorf("expected revision 2, got %q", renewed.Annotations[RevisionAnnotation])
+	}
+}
+
+func TestBuiltinReissuesCertificates(t *testing.T) {
+	ctx := context.Background()
+	client := ctrlruntimefakeclient.NewClientBuilder().Build()
+	backend := Builtin{}
+
+	reconcile := func() {
+		t.Helper()
+		if err := backend.ReconcileCertificates(ctx, []reconciling.NamedCertificateReconcilerFactory{
+			testCertificate("ca", true, ""),
+		}, testNamespace, client); err != nil {
+			t.Fatalf("failed to reconcile certificates: %v", err)
+		}
+	}
+
+	reconcile()
+	issued := getSecret(t, client, "ca")
+
+	cert, err := backend.GetCertificate(ctx, client, types.NamespacedName{Namespace: testNamespace, Name: "ca"})
+	if err != nil {
+		t.Fatalf("failed to get certificate: %v", err)
+	}
+
+	if err := backend.Reissue(ctx, client, cert); err != nil {
+		t.Fatalf("failed to reissue certificate: %v", err)
+	}
+	if _, requested := getSecret(t, client, "ca").Annotations[ReissueAnnotation]; !requested {
+		t.Fatal("expected Secret to be marked for reissuing")
+	}
+
+	reconcile()
+	reissued := getSecret(t, client, "ca")
+	if string(reissued.Data[corev1.TLSCertKey]) == string(issued.Data[corev1.TLSCertKey]) {
+		t.Fatal("expected certificate to be issued again")
+	}
+	if _, requested := reissued.Annotations[ReissueAnnotation]; requested {
+		t.Error("expected reissue request to be removed")
+	}
+	if reissued.Annotations[RevisionAnnotation] != "2" {
+		t.Errorf("expected revision 2, got %q", reissued.Annotations[RevisionAnnotation])
+	}
+}
+
+func TestBuiltinRenewalTime(t *testing.T) {
+	now := time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)
+
+	certs := []certmanagerv1.Certificate{
+		{Status: certmanagerv1.CertificateStatus{RenewalTime: &metav1.Time{Time: now.Add(time.Hour)}}},
+		{Status: certmanagerv1.CertificateStatus{RenewalTime: &metav1.Time{Time: now.Add(time.Minute)}}},
+		// pending certificates have no renewal time yet
+		{},
+	}
+
+	if renewalTime := (Builtin{}).RenewalTime(certs); renewalTime == nil || !renewalTime.Equal(now.Add(time.Minute)) {
+		t.Errorf("expected earliest renewal time %v, got %v", now.Add(time.Minute), renewalTime)
+	}
+
+	if renewalTime := (Builtin{}).RenewalTime(nil); renewalTime != nil {
+		t.Errorf("expected no renewal time, got %v", renewalTime)
+	}
+
+	if renewalTime := (CertManager{}).RenewalTime(certs); renewalTime != nil {
+		t.Errorf("expected cert-manager to renew certificates by itself, got %v", renewalTime)
+	}
+}
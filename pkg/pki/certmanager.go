@@ -0,0 +1,93 @@
+/*
+Copyright 2026 The kcp Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package pki
+
+import (
+	"context"
+	"time"
+
+	apiutil "github.com/cert-manager/cert-manager/pkg/api/util"
+	certmanagerv1 "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
+	certmanagermetav1 "github.com/cert-manager/cert-manager/pkg/apis/meta/v1"
+	k8creconciling "k8c.io/reconciler/pkg/reconciling"
+
+	"k8s.io/apimachinery/pkg/types"
+	ctrlruntimeclient "sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/kcp-dev/kcp-operator/pkg/reconciling"
+)
+
+// CertManager hands all certificates and issuers to cert-manager.
+type CertManager struct{}
+
+var _ Backend = CertManager{}
+
+// +kubebuilder:rbac:groups=cert-manager.io,resources=certificates;issuers,verbs=get;list;watch;create;update;patch
+
+func (CertManager) ReconcileCertificates(ctx context.Context, namedFactories []reconciling.NamedCertificateReconcilerFactory, namespace string, client ctrlruntimeclient.Client, objectModifiers ...k8creconciling.ObjectModifier) error {
+	return reconciling.ReconcileCertificates(ctx, namedFactories, namespace, client, objectModifiers...)
+}
+
+func (CertManager) ReconcileIssuers(ctx context.Context, namedFactories []reconciling.NamedIssuerReconcilerFactory, namespace string, client ctrlruntimeclient.Client, objectModifiers ...k8creconciling.ObjectModifier) error {
+	return reconciling.ReconcileIssuers(ctx, namedFactories, namespace, client, objectModifiers...)
+}
+
+func (CertManager) GetCertificate(ctx context.Context, client ctrlruntimeclient.Reader, key types.NamespacedName) (*certmanagerv1.Certificate, error) {
+	cert := &certmanagerv1.Certificate{}
+	if err := client.Get(ctx, key, cert); err != nil {
+		return nil, err
+	}
+
+	return cert, nil
+}
+
+func (CertManager) ListCertificates(ctx context.Context, client ctrlruntimeclient.Reader, opts ...ctrlruntimeclient.ListOption) ([]certmanagerv1.Certificate, error) {
+	var certs certmanagerv1.CertificateList
+	if err := client.List(ctx, &certs, opts...); err != nil {
+		return nil, err
+	}
+
+	return certs.Items, nil
+}
+
+// +kubebuilder:rbac:groups=cert-manager.io,resources=certificates/status,verbs=update;patch
+
+// Reissue sets the Issuing condition on the Certificate, which is how `cmctl renew` asks
+// cert-manager to issue a certificate again.
+func (CertManager) Reissue(ctx context.Context, client ctrlruntimeclient.Client, cert *certmanagerv1.Certificate) error {
+	if apiutil.CertificateHasCondition(cert, certmanagerv1.CertificateCondition{Type: certmanagerv1.CertificateConditionIssuing, Status: certmanagermetav1.ConditionTrue}) {
+		return nil
+	}
+
+	updated := cert.DeepCopy()
+	apiutil.SetCertificateCondition(updated, updated.Generation, certmanagerv1.CertificateConditionIssuing, certmanagermetav1.ConditionTrue, "ManuallyTriggered", "Certificate re-issuance manually triggered")
+
+	return client.Status().Patch(ctx, updated, ctrlruntimeclient.MergeFrom(cert))
+}
+
+// RenewalTime always returns nil, as cert-manager renews certificates by itself.
+func (CertManager) RenewalTime(certs []certmanagerv1.Certificate) *time.Time {
+	return nil
+}
+
+func (CertManager) CertificateType() ctrlruntimeclient.Object {
+	return &certmanagerv1.Certificate{}
+}
+
+func (CertManager) IssuerType() ctrlruntimeclient.Object {
+	return &certmanagerv1.Issuer{}
+}
@@ -0,0 +1,67 @@
+/*
+Copyright 2026 The kcp Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package pki abstracts how the certificates of kcp components are issued. Certificates and
+// issuers are always described using cert-manager's types, so the resources packages do not
+// need to know which backend is in use; the backend decides whether they are handed to
+// cert-manager or issued by the operator itself.
+package pki
+
+import (
+	"context"
+	"time"
+
+	certmanagerv1 "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
+	k8creconciling "k8c.io/reconciler/pkg/reconciling"
+
+	"k8s.io/apimachinery/pkg/types"
+	ctrlruntimeclient "sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/kcp-dev/kcp-operator/pkg/reconciling"
+)
+
+// Backend issues and renews certificates into Secrets with the usual tls.crt, tls.key and
+// ca.crt keys.
+type Backend interface {
+	// ReconcileCertificates ensures the given certificates are issued. The object modifiers are
+	// applied to the Certificates like for reconciling.ReconcileCertificates, and observe their
+	// status once the backend has processed them.
+	ReconcileCertificates(ctx context.Context, namedFactories []reconciling.NamedCertificateReconcilerFactory, namespace string, client ctrlruntimeclient.Client, objectModifiers ...k8creconciling.ObjectModifier) error
+
+	// ReconcileIssuers ensures the given issuers exist.
+	ReconcileIssuers(ctx context.Context, namedFactories []reconciling.NamedIssuerReconcilerFactory, namespace string, client ctrlruntimeclient.Client, objectModifiers ...k8creconciling.ObjectModifier) error
+
+	// GetCertificate returns a single certificate. A NotFound error is returned if the
+	// certificate has not been issued yet.
+	GetCertificate(ctx context.Context, client ctrlruntimeclient.Reader, key types.NamespacedName) (*certmanagerv1.Certificate, error)
+
+	// ListCertificates returns all certificates matching the options.
+	ListCertificates(ctx context.Context, client ctrlruntimeclient.Reader, opts ...ctrlruntimeclient.ListOption) ([]certmanagerv1.Certificate, error)
+
+	// Reissue issues the certificate again, even if it is not due for renewal yet. Like any
+	// other renewal, the new certificate is observed through the certificate's status.
+	Reissue(ctx context.Context, client ctrlruntimeclient.Client, cert *certmanagerv1.Certificate) error
+
+	// RenewalTime returns the earliest time at which one of the certificates has to be renewed
+	// by reconciling its owner, or nil if the backend renews certificates by itself.
+	RenewalTime(certs []certmanagerv1.Certificate) *time.Time
+
+	// CertificateType is the object controllers own to be notified about their certificates.
+	CertificateType() ctrlruntimeclient.Object
+
+	// IssuerType is the object controllers watch to be notified about issuers.
+	IssuerType() ctrlruntimeclient.Object
+}
@@ -0,0 +1,69 @@
+/*
+Copyright 2026 The kcp Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package modifier
+
+import (
+	"k8c.io/reconciler/pkg/compare"
+	"k8c.io/reconciler/pkg/reconciling"
+
+	ctrlruntimeclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// annotateChanges sets the annotation key to value on every object that is about to be created
+// or changed and calls onChange for it. Unchanged objects keep their previous value, so that
+// reconciling them does not cause an update. An empty value keeps the previous value as well.
+func annotateChanges(key, value string, onChange func()) reconciling.ObjectModifier {
+	return func(reconciler reconciling.ObjectReconciler) reconciling.ObjectReconciler {
+		return func(existing ctrlruntimeclient.Object) (ctrlruntimeclient.Object, error) {
+			previous := existing.DeepCopyObject().(ctrlruntimeclient.Object)
+
+			obj, err := reconciler(existing)
+			if err != nil {
+				return obj, err
+			}
+
+			setAnnotation(obj, key, previous.GetAnnotations()[key])
+
+			if previous.GetResourceVersion() == "" || !compare.DeepEqual(previous, obj) {
+				if value != "" {
+					setAnnotation(obj, key, value)
+				}
+
+				if onChange != nil {
+					onChange()
+				}
+			}
+
+			return obj, nil
+		}
+	}
+}
+
+func setAnnotation(obj ctrlruntimeclient.Object, key, value string) {
+	annotations := obj.GetAnnotations()
+
+	if value == "" {
+		delete(annotations, key)
+		return
+	}
+
+	if annotations == nil {
+		annotations = map[string]string{}
+	}
+	annotations[key] = value
+	obj.SetAnnotations(annotations)
+}
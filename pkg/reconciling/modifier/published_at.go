@@ -0,0 +1,53 @@
+/*
+Copyright 2026 The kcp Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package modifier
+
+import (
+	"time"
+
+	"k8c.io/reconciler/pkg/reconciling"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	ctrlruntimeclient "sigs.k8s.io/controller-runtime/pkg/client"
+
+	deployv1alpha1 "github.com/kcp-dev/kcp-operator/sdk/apis/deploy/v1alpha1"
+)
+
+// PublishedAt stores now in the PublishedAtAnnotation of every object that is about to be
+// created or changed, and sets changed to true if there was such an object. Like TraceParent,
+// it has to come after all modifiers that change the object's content.
+func PublishedAt(now time.Time, changed *bool) reconciling.ObjectModifier {
+	return annotateChanges(deployv1alpha1.PublishedAtAnnotation, now.UTC().Format(time.RFC3339Nano), func() {
+		*changed = true
+	})
+}
+
+// CopyPublishedAt copies the PublishedAtAnnotation of source onto every reconciled object.
+func CopyPublishedAt(source metav1.Object) reconciling.ObjectModifier {
+	return func(reconciler reconciling.ObjectReconciler) reconciling.ObjectReconciler {
+		return func(existing ctrlruntimeclient.Object) (ctrlruntimeclient.Object, error) {
+			obj, err := reconciler(existing)
+			if err != nil {
+				return obj, err
+			}
+
+			setAnnotation(obj, deployv1alpha1.PublishedAtAnnotation, source.GetAnnotations()[deployv1alpha1.PublishedAtAnnotation])
+
+			return obj, nil
+		}
+	}
+}
@@ -0,0 +1,110 @@
+/*
+Copyright 2026 The kcp Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package modifier
+
+import (
+	"testing"
+	"time"
+
+	appsv1 "k8s.io/api/apps/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	ctrlruntimeclient "sigs.k8s.io/controller-runtime/pkg/client"
+
+	deployv1alpha1 "github.com/kcp-dev/kcp-operator/sdk/apis/deploy/v1alpha1"
+)
+
+func TestPublishedAt(t *testing.T) {
+	const oldPublishedAt = "2026-01-01T00:00:00Z"
+
+	now := time.Date(2026, 2, 1, 0, 0, 0, 0, time.UTC)
+
+	testcases := []struct {
+		name            string
+		replicas        int32
+		expected        string
+		expectedChanged bool
+	}{
+		{
+			name:     "unchanged object keeps its time",
+			expected: oldPublishedAt,
+		},
+		{
+			name:            "changed object gets the new time",
+			replicas:        3,
+			expected:        "2026-02-01T00:00:00Z",
+			expectedChanged: true,
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			existing := &deployv1alpha1.CompiledShard{
+				ObjectMeta: metav1.ObjectMeta{
+					ResourceVersion: "1",
+					Annotations:     map[string]string{deployv1alpha1.PublishedAtAnnotation: oldPublishedAt},
+				},
+			}
+
+			// like the Compiled* factories, the reconciler replaces all annotations
+			reconciler := func(existing ctrlruntimeclient.Object) (ctrlruntimeclient.Object, error) {
+				compiled := existing.(*deployv1alpha1.CompiledShard)
+				compiled.Annotations = nil
+				if tc.replicas > 0 {
+					compiled.Spec.Shard.Replicas = &tc.replicas
+				}
+				return compiled, nil
+			}
+
+			var changed bool
+			obj, err := PublishedAt(now, &changed)(reconciler)(existing)
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+
+			if publishedAt := obj.GetAnnotations()[deployv1alpha1.PublishedAtAnnotation]; publishedAt != tc.expected {
+				t.Errorf("Expected published-at %q, got %q.", tc.expected, publishedAt)
+			}
+
+			if changed != tc.expectedChanged {
+				t.Errorf("Expected changed to be %v.", tc.expectedChanged)
+			}
+		})
+	}
+}
+
+func TestCopyPublishedAt(t *testing.T) {
+	const publishedAt = "2026-01-01T00:00:00Z"
+
+	compiled := &deployv1alpha1.CompiledShard{
+		ObjectMeta: metav1.ObjectMeta{
+			Annotations: map[string]string{deployv1alpha1.PublishedAtAnnotation: publishedAt},
+		},
+	}
+
+	reconciler := func(existing ctrlruntimeclient.Object) (ctrlruntimeclient.Object, error) {
+		return existing, nil
+	}
+
+	obj, err := CopyPublishedAt(compiled)(reconciler)(&appsv1.Deployment{})
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	if value := obj.GetAnnotations()[deployv1alpha1.PublishedAtAnnotation]; value != publishedAt {
+		t.Errorf("Expected published-at %q, got %q.", publishedAt, value)
+	}
+}
@@ -0,0 +1,34 @@
+/*
+Copyright 2026 The kcp Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package modifier
+
+import (
+	"context"
+
+	"k8c.io/reconciler/pkg/reconciling"
+
+	"github.com/kcp-dev/kcp-operator/pkg/tracing"
+	deployv1alpha1 "github.com/kcp-dev/kcp-operator/sdk/apis/deploy/v1alpha1"
+)
+
+// TraceParent stores the trace context of ctx in the TraceParentAnnotation of every object
+// that is about to be created or changed. Unchanged objects keep their previous trace context,
+// so that reconciling them does not cause an update. It has to come after all modifiers that
+// change the object's content.
+func TraceParent(ctx context.Context) reconciling.ObjectModifier {
+	return annotateChanges(deployv1alpha1.TraceParentAnnotation, tracing.TraceParent(ctx), nil)
+}
@@ -0,0 +1,107 @@
+/*
+Copyright 2026 The kcp Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package modifier
+
+import (
+	"context"
+	"testing"
+
+	"go.opentelemetry.io/otel/trace"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	ctrlruntimeclient "sigs.k8s.io/controller-runtime/pkg/client"
+
+	deployv1alpha1 "github.com/kcp-dev/kcp-operator/sdk/apis/deploy/v1alpha1"
+)
+
+func traceContext(spanID byte) context.Context {
+	return trace.ContextWithSpanContext(context.Background(), trace.NewSpanContext(trace.SpanContextConfig{
+		TraceID:    trace.TraceID{1},
+		SpanID:     trace.SpanID{spanID},
+		TraceFlags: trace.FlagsSampled,
+	}))
+}
+
+func TestTraceParent(t *testing.T) {
+	const (
+		oldTraceParent = "00-01000000000000000000000000000000-0100000000000000-01"
+		newTraceParent = "00-01000000000000000000000000000000-0200000000000000-01"
+	)
+
+	testcases := []struct {
+		name     string
+		existing *deployv1alpha1.CompiledShard
+		replicas int32
+		expected string
+	}{
+		{
+			name:     "new object",
+			existing: &deployv1alpha1.CompiledShard{},
+			replicas: 1,
+			expected: newTraceParent,
+		},
+		{
+			name: "unchanged object keeps its trace context",
+			existing: &deployv1alpha1.CompiledShard{
+				ObjectMeta: metav1.ObjectMeta{
+					ResourceVersion: "1",
+					Annotations:     map[string]string{deployv1alpha1.TraceParentAnnotation: oldTraceParent},
+				},
+			},
+			expected: oldTraceParent,
+		},
+		{
+			name: "changed object gets the new trace context",
+			existing: &deployv1alpha1.CompiledShard{
+				ObjectMeta: metav1.ObjectMeta{
+					ResourceVersion: "1",
+					Annotations:     map[string]string{deployv1alpha1.TraceParentAnnotation: oldTraceParent},
+				},
+			},
+			replicas: 3,
+			expected: newTraceParent,
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			// like the Compiled* factories, the reconciler replaces all annotations
+			reconciler := func(existing ctrlruntimeclient.Object) (ctrlruntimeclient.Object, error) {
+				compiled := existing.(*deployv1alpha1.CompiledShard)
+				compiled.Annotations = map[string]string{"foo": "bar"}
+				if tc.replicas > 0 {
+					compiled.Spec.Shard.Replicas = &tc.replicas
+				}
+				return compiled, nil
+			}
+
+			if tc.existing.Annotations == nil {
+				tc.existing.Annotations = map[string]string{}
+			}
+			tc.existing.Annotations["foo"] = "bar"
+
+			obj, err := TraceParent(traceContext(2))(reconciler)(tc.existing.DeepCopy())
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+
+			if traceParent := obj.GetAnnotations()[deployv1alpha1.TraceParentAnnotation]; traceParent != tc.expected {
+				t.Errorf("Expected traceparent %q, got %q.", tc.expected, traceParent)
+			}
+		})
+	}
+}
@@ -23,6 +23,7 @@ import (
 	certmanagerv1 "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
 	"k8c.io/reconciler/pkg/reconciling"
 
+	autoscalingv2 "k8s.io/api/autoscaling/v2"
 	"k8s.io/apimachinery/pkg/types"
 	ctrlruntimeclient "sigs.k8s.io/controller-runtime/pkg/client"
 
@@ -473,3 +474,40 @@ func ReconcileIssuers(ctx context.Context, namedFactories []NamedIssuerReconcile
 
 	return nil
 }
+
+// HorizontalPodAutoscalerReconciler defines an interface to create/update HorizontalPodAutoscalers.
+type HorizontalPodAutoscalerReconciler = func(existing *autoscalingv2.HorizontalPodAutoscaler) (*autoscalingv2.HorizontalPodAutoscaler, error)
+
+// NamedHorizontalPodAutoscalerReconcilerFactory returns the name of the resource and the corresponding Reconciler function.
+type NamedHorizontalPodAutoscalerReconcilerFactory = func() (name string, reconciler HorizontalPodAutoscalerReconciler)
+
+// HorizontalPodAutoscalerObjectWrapper adds a wrapper so the HorizontalPodAutoscalerReconciler matches ObjectReconciler.
+// This is needed as Go does not support function interface matching.
+func HorizontalPodAutoscalerObjectWrapper(reconciler HorizontalPodAutoscalerReconciler) reconciling.ObjectReconciler {
+	return func(existing ctrlruntimeclient.Object) (ctrlruntimeclient.Object, error) {
+		if existing != nil {
+			return reconciler(existing.(*autoscalingv2.HorizontalPodAutoscaler))
+		}
+		return reconciler(&autoscalingv2.HorizontalPodAutoscaler{})
+	}
+}
+
+// ReconcileHorizontalPodAutoscalers will create and update the HorizontalPodAutoscalers coming from the passed HorizontalPodAutoscalerReconciler slice.
+func ReconcileHorizontalPodAutoscalers(ctx context.Context, namedFactories []NamedHorizontalPodAutoscalerReconcilerFactory, namespace string, client ctrlruntimeclient.Client, objectModifiers ...reconciling.ObjectModifier) error {
+	for _, factory := range namedFactories {
+		name, reconciler := factory()
+		reconcileObject := HorizontalPodAutoscalerObjectWrapper(reconciler)
+		reconcileObject = reconciling.CreateWithNamespace(reconcileObject, namespace)
+		reconcileObject = reconciling.CreateWithName(reconcileObject, name)
+
+		for _, objectModifier := range objectModifiers {
+			reconcileObject = objectModifier(reconcileObject)
+		}
+
+		if err := reconciling.EnsureNamedObject(ctx, types.NamespacedName{Namespace: namespace, Name: name}, reconcileObject, client, &autoscalingv2.HorizontalPodAutoscaler{}, false); err != nil {
+			return fmt.Errorf("failed to ensure HorizontalPodAutoscaler %s/%s: %w", namespace, name, err)
+		}
+	}
+
+	return nil
+}
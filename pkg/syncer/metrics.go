@@ -0,0 +1,52 @@
+/*
+Copyright 2026 The kcp Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package syncer
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+
+	ctrlruntimemetrics "sigs.k8s.io/controller-runtime/pkg/metrics"
+)
+
+const (
+	actionCreate = "create"
+	actionUpdate = "update"
+	actionDelete = "delete"
+	actionStatus = "status"
+)
+
+var (
+	// SyncOperations counts the changes the syncer made, by the kind of object that was changed.
+	// Labels: kind (CompiledShard|...|Secret|ConfigMap), action (create|update|delete|status)
+	SyncOperations = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "kcp_operator_syncer_operations_total",
+			Help: "Total number of objects created, updated or deleted by the syncer",
+		},
+		[]string{"kind", "action"},
+	)
+)
+
+func RegisterMetrics() {
+	ctrlruntimemetrics.Registry.MustRegister(
+		SyncOperations,
+	)
+}
+
+func recordSync(kind, action string) {
+	SyncOperations.WithLabelValues(kind, action).Inc()
+}
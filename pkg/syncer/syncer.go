@@ -0,0 +1,685 @@
+/*
+Copyright 2026 The kcp Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package syncer copies the Compiled* resources and the Secrets and ConfigMaps they mount from a
+// config cluster, where the config controllers run, into a workload cluster, where the workload
+// controllers run. The status of the Compiled* resources is copied back into the config cluster.
+package syncer
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/selection"
+	"k8s.io/apimachinery/pkg/types"
+	kerrors "k8s.io/apimachinery/pkg/util/errors"
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/client-go/rest"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/cache"
+	ctrlruntimeclient "sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/cluster"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+	ctrlruntimesource "sigs.k8s.io/controller-runtime/pkg/source"
+
+	"github.com/kcp-dev/kcp-operator/internal/resources"
+	deployv1alpha1 "github.com/kcp-dev/kcp-operator/sdk/apis/deploy/v1alpha1"
+)
+
+// SyncedLabel is placed on every object that the syncer creates in the workload cluster. Only
+// objects carrying it are ever updated or deleted by the syncer.
+const SyncedLabel = "operator.kcp.io/synced"
+
+// Kind is a Compiled* resource type that is synced, together with the label that selects the
+// Secrets and ConfigMaps its workloads mount.
+type Kind struct {
+	Name  string
+	Label string
+}
+
+// Kinds lists all Compiled* resource types.
+var Kinds = []Kind{
+	{Name: "CompiledCacheServer", Label: resources.CacheServerLabel},
+	{Name: "CompiledFrontProxy", Label: resources.FrontProxyLabel},
+	{Name: "CompiledRootShard", Label: resources.RootShardLabel},
+	{Name: "CompiledShard", Label: resources.ShardLabel},
+	{Name: "CompiledVirtualWorkspace", Label: resources.VirtualWorkspaceLabel},
+}
+
+// Reconciler syncs a single Compiled* resource type.
+type Reconciler struct {
+	Kind Kind
+
+	// Config is the client for the cluster the config controllers run in.
+	Config ctrlruntimeclient.Client
+	// Workload is the client for the cluster the workload controllers run in.
+	Workload ctrlruntimeclient.Client
+
+	// NamespacePrefix restricts syncing to namespaces with this prefix.
+	NamespacePrefix string
+
+	// ClusterName is the name of the workload cluster. Only Compiled* objects placed into this
+	// cluster (see operatorv1alpha1.Placement) are synced. If empty, only objects without a
+	// placement are synced.
+	ClusterName string
+}
+
+func (r *Reconciler) gvk() schema.GroupVersionKind {
+	return deployv1alpha1.SchemeGroupVersion.WithKind(r.Kind.Name)
+}
+
+func (r *Reconciler) newObject() *unstructured.Unstructured {
+	obj := &unstructured.Unstructured{}
+	obj.SetGroupVersionKind(r.gvk())
+	return obj
+}
+
+func (r *Reconciler) inScope(obj ctrlruntimeclient.Object) bool {
+	return strings.HasPrefix(obj.GetNamespace(), r.NamespacePrefix)
+}
+
+func (r *Reconciler) placedHere(obj ctrlruntimeclient.Object) bool {
+	return resources.GetPlacementCluster(obj) == r.ClusterName
+}
+
+// selectors returns the label selectors for the Secrets and ConfigMaps that the workloads of a
+// Compiled* object mount. Besides its own, these are the ones of the components it belongs to,
+// for example the CA Secrets of the RootShard a Shard belongs to, which have to be present when
+// both are placed into different clusters.
+func selectors(labels map[string]string) []ctrlruntimeclient.MatchingLabels {
+	var result []ctrlruntimeclient.MatchingLabels
+	for _, kind := range Kinds {
+		if value := labels[kind.Label]; value != "" {
+			result = append(result, ctrlruntimeclient.MatchingLabels{kind.Label: value})
+		}
+	}
+
+	return result
+}
+
+// NewSourceCaches returns one cache per Kind for the Secrets and ConfigMaps in the config cluster
+// that carry its label, which together hold everything selectors can ever select. No single label
+// is shared by all of them, so this keeps the syncer from caching every Secret of the config
+// cluster. The caches have to be added to the manager to be started.
+func NewSourceCaches(config *rest.Config, scheme *runtime.Scheme) ([]cache.Cache, error) {
+	var caches []cache.Cache
+
+	for _, kind := range Kinds {
+		requirement, err := labels.NewRequirement(kind.Label, selection.Exists, nil)
+		if err != nil {
+			return nil, err
+		}
+
+		selector := labels.NewSelector().Add(*requirement)
+
+		c, err := cache.New(config, cache.Options{
+			Scheme: scheme,
+			ByObject: map[ctrlruntimeclient.Object]cache.ByObject{
+				&corev1.Secret{}:    {Label: selector},
+				&corev1.ConfigMap{}: {Label: selector},
+			},
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to create cache for %s: %w", kind.Label, err)
+		}
+
+		caches = append(caches, c)
+	}
+
+	return caches, nil
+}
+
+// SetupWithManager sets up the controller with the Manager, which must be connected to the config
+// cluster. Secrets and ConfigMaps in the config cluster are watched in the given sources (see
+// NewSourceCaches), changes in the workload cluster are picked up from the given workload cluster.
+func (r *Reconciler) SetupWithManager(mgr ctrl.Manager, workload cluster.Cluster, sources []cache.Cache) error {
+	scope := predicate.NewPredicateFuncs(r.inScope)
+
+	// Secrets and ConfigMaps are mapped to the Compiled* object named in their label, and to
+	// all Compiled* objects that belong to the component named in their other labels.
+	mapByLabel := handler.EnqueueRequestsFromMapFunc(func(ctx context.Context, obj ctrlruntimeclient.Object) []reconcile.Request {
+		var requests []reconcile.Request
+
+		for _, kind := range Kinds {
+			value := obj.GetLabels()[kind.Label]
+			if value == "" {
+				continue
+			}
+
+			if kind == r.Kind {
+				requests = append(requests, reconcile.Request{NamespacedName: types.NamespacedName{Namespace: obj.GetNamespace(), Name: value}})
+				continue
+			}
+
+			objects := &unstructured.UnstructuredList{}
+			objects.SetGroupVersionKind(deployv1alpha1.SchemeGroupVersion.WithKind(r.Kind.Name + "List"))
+			if err := mgr.GetClient().List(ctx, objects, ctrlruntimeclient.InNamespace(obj.GetNamespace()), ctrlruntimeclient.MatchingLabels{kind.Label: value}); err != nil {
+				utilruntime.HandleError(err)
+				continue
+			}
+
+			for _, item := range objects.Items {
+				requests = append(requests, reconcile.Request{NamespacedName: ctrlruntimeclient.ObjectKeyFromObject(&item)})
+			}
+		}
+
+		return requests
+	})
+
+	synced := predicate.NewPredicateFuncs(func(obj ctrlruntimeclient.Object) bool {
+		return r.inScope(obj) && obj.GetLabels()[SyncedLabel] == "true"
+	})
+
+	bldr := ctrl.NewControllerManagedBy(mgr).
+		Named("syncer-"+strings.ToLower(r.Kind.Name)).
+		For(r.newObject(), builder.WithPredicates(scope)).
+		// Status updates, drift and orphans in the workload cluster.
+		WatchesRawSource(ctrlruntimesource.Kind(workload.GetCache(), ctrlruntimeclient.Object(r.newObject()), &handler.EnqueueRequestForObject{}, synced)).
+		WatchesRawSource(ctrlruntimesource.Kind(workload.GetCache(), ctrlruntimeclient.Object(&corev1.Secret{}), mapByLabel, synced)).
+		WatchesRawSource(ctrlruntimesource.Kind(workload.GetCache(), ctrlruntimeclient.Object(&corev1.ConfigMap{}), mapByLabel, synced))
+
+	for _, source := range sources {
+		bldr = bldr.
+			WatchesRawSource(ctrlruntimesource.Kind(source, ctrlruntimeclient.Object(&corev1.Secret{}), mapByLabel, scope)).
+			WatchesRawSource(ctrlruntimesource.Kind(source, ctrlruntimeclient.Object(&corev1.ConfigMap{}), mapByLabel, scope))
+	}
+
+	return bldr.Complete(r)
+}
+
+func (r *Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	logger := log.FromContext(ctx)
+	logger.V(4).Info("Syncing")
+
+	source := r.newObject()
+	if err := r.Config.Get(ctx, req.NamespacedName, source); ctrlruntimeclient.IgnoreNotFound(err) != nil {
+		return ctrl.Result{}, fmt.Errorf("failed to get %s: %w", r.Kind.Name, err)
+	} else if apierrors.IsNotFound(err) || source.GetDeletionTimestamp() != nil {
+		return ctrl.Result{}, r.cleanup(ctx, req.NamespacedName, source.GetLabels())
+	}
+
+	// Objects placed into other clusters are removed, for example when the placement of a
+	// component has been changed.
+	if !r.placedHere(source) {
+		return ctrl.Result{}, r.cleanup(ctx, req.NamespacedName, source.GetLabels())
+	}
+
+	if err := r.ensureNamespace(ctx, req.Namespace); err != nil {
+		return ctrl.Result{}, fmt.Errorf("failed to ensure namespace: %w", err)
+	}
+
+	var errs []error
+	for _, selector := range selectors(source.GetLabels()) {
+		if err := r.syncSecrets(ctx, req.Namespace, selector); err != nil {
+			errs = append(errs, fmt.Errorf("failed to sync Secrets: %w", err))
+		}
+		if err := r.syncConfigMaps(ctx, req.Namespace, selector); err != nil {
+			errs = append(errs, fmt.Errorf("failed to sync ConfigMaps: %w", err))
+		}
+	}
+
+	// The workloads must not start before the Secrets they mount exist.
+	if len(errs) > 0 {
+		return ctrl.Result{}, kerrors.NewAggregate(errs)
+	}
+
+	target, err := r.syncObject(ctx, source)
+	if err != nil {
+		return ctrl.Result{}, fmt.Errorf("failed to sync %s: %w", r.Kind.Name, err)
+	}
+
+	if err := r.syncStatus(ctx, source, target); err != nil {
+		return ctrl.Result{}, fmt.Errorf("failed to sync status: %w", err)
+	}
+
+	return ctrl.Result{}, nil
+}
+
+// syncObject creates or updates the Compiled* object in the workload cluster and returns it.
+func (r *Reconciler) syncObject(ctx context.Context, source *unstructured.Unstructured) (*unstructured.Unstructured, error) {
+	target := r.newObject()
+	if err := r.Workload.Get(ctx, ctrlruntimeclient.ObjectKeyFromObject(source), target); err != nil {
+		if !apierrors.IsNotFound(err) {
+			return nil, err
+		}
+
+		target = r.newObject()
+		target.SetName(source.GetName())
+		target.SetNamespace(source.GetNamespace())
+		target.SetLabels(syncedLabels(source.GetLabels()))
+		target.SetAnnotations(source.GetAnnotations())
+		target.Object["spec"] = source.Object["spec"]
+
+		log.FromContext(ctx).V(2).Info("Creating object in workload cluster")
+		recordSync(r.Kind.Name, actionCreate)
+
+		return target, r.Workload.Create(ctx, target)
+	}
+
+	if target.GetLabels()[SyncedLabel] != "true" {
+		return nil, fmt.Errorf("%s already exists in the workload cluster and is not managed by the syncer", r.Kind.Name)
+	}
+
+	updated := target.DeepCopy()
+	updated.SetLabels(syncedLabels(source.GetLabels()))
+	updated.SetAnnotations(source.GetAnnotations())
+	updated.Object["spec"] = source.Object["spec"]
+
+	if equality.Semantic.DeepEqual(target, updated) {
+		return target, nil
+	}
+
+	recordSync(r.Kind.Name, actionUpdate)
+
+	return updated, r.Workload.Update(ctx, updated)
+}
+
+// syncStatus copies the status of the workload object back into the config cluster.
+func (r *Reconciler) syncStatus(ctx context.Context, source, target *unstructured.Unstructured) error {
+	status, ok := target.Object["status"]
+	if !ok || equality.Semantic.DeepEqual(source.Object["status"], status) {
+		return nil
+	}
+
+	updated := source.DeepCopy()
+	updated.Object["status"] = translateGenerations(status, target.GetGeneration(), source.GetGeneration())
+
+	if r.ClusterName != "" {
+		if err := unstructured.SetNestedField(updated.Object, r.ClusterName, "status", "placement", "cluster"); err != nil {
+			return err
+		}
+	}
+
+	if equality.Semantic.DeepEqual(source.Object["status"], updated.Object["status"]) {
+		return nil
+	}
+
+	recordSync(r.Kind.Name, actionStatus)
+
+	return r.Config.Status().Patch(ctx, updated, ctrlruntimeclient.MergeFrom(source))
+}
+
+// cleanup removes the Compiled* object and the Secrets and ConfigMaps that were synced for it
+// from the workload cluster. It never creates anything, so that the Secrets of components placed
+// into other clusters cannot leak into this one. The labels of the object are used to find the
+// Secrets and ConfigMaps of the components it belongs to; they are empty if it is already gone.
+func (r *Reconciler) cleanup(ctx context.Context, key types.NamespacedName, labels map[string]string) error {
+	var errs []error
+
+	candidates := []ctrlruntimeclient.MatchingLabels{{r.Kind.Label: key.Name}}
+	candidates = append(candidates, selectors(labels)...)
+
+	target := r.newObject()
+	if err := r.Workload.Get(ctx, key, target); ctrlruntimeclient.IgnoreNotFound(err) != nil {
+		errs = append(errs, err)
+	} else if err == nil && target.GetLabels()[SyncedLabel] == "true" {
+		candidates = append(candidates, selectors(target.GetLabels())...)
+
+		log.FromContext(ctx).V(2).Info("Deleting object from workload cluster")
+		recordSync(r.Kind.Name, actionDelete)
+
+		if err := r.Workload.Delete(ctx, target); ctrlruntimeclient.IgnoreNotFound(err) != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	// Secrets and ConfigMaps can still be mounted by other objects in this cluster, for example
+	// the CA Secrets of a RootShard by its Shards.
+	wanted, err := r.wantedSelectors(ctx, key.Namespace)
+	if err != nil {
+		return kerrors.NewAggregate(append(errs, fmt.Errorf("failed to determine Secrets in use: %w", err)))
+	}
+
+	deleted := sets.New[string]()
+
+	for _, selector := range candidates {
+		secrets := &corev1.SecretList{}
+		if err := r.Workload.List(ctx, secrets, ctrlruntimeclient.InNamespace(key.Namespace), selector, ctrlruntimeclient.MatchingLabels{SyncedLabel: "true"}); err != nil {
+			errs = append(errs, err)
+		}
+
+		for i := range secrets.Items {
+			secret := &secrets.Items[i]
+			if matchesAny(wanted, secret.Labels) || deleted.Has("Secret/"+secret.Name) {
+				continue
+			}
+			deleted.Insert("Secret/" + secret.Name)
+
+			recordSync("Secret", actionDelete)
+			if err := r.Workload.Delete(ctx, secret); ctrlruntimeclient.IgnoreNotFound(err) != nil {
+				errs = append(errs, fmt.Errorf("Secret %s: %w", secret.Name, err))
+			}
+		}
+
+		configMaps := &corev1.ConfigMapList{}
+		if err := r.Workload.List(ctx, configMaps, ctrlruntimeclient.InNamespace(key.Namespace), selector, ctrlruntimeclient.MatchingLabels{SyncedLabel: "true"}); err != nil {
+			errs = append(errs, err)
+		}
+
+		for i := range configMaps.Items {
+			configMap := &configMaps.Items[i]
+			if matchesAny(wanted, configMap.Labels) || deleted.Has("ConfigMap/"+configMap.Name) {
+				continue
+			}
+			deleted.Insert("ConfigMap/" + configMap.Name)
+
+			recordSync("ConfigMap", actionDelete)
+			if err := r.Workload.Delete(ctx, configMap); ctrlruntimeclient.IgnoreNotFound(err) != nil {
+				errs = append(errs, fmt.Errorf("ConfigMap %s: %w", configMap.Name, err))
+			}
+		}
+	}
+
+	return kerrors.NewAggregate(errs)
+}
+
+// wantedSelectors returns the selectors of all Compiled* objects in the namespace that are synced
+// into this workload cluster.
+func (r *Reconciler) wantedSelectors(ctx context.Context, namespace string) ([]ctrlruntimeclient.MatchingLabels, error) {
+	var result []ctrlruntimeclient.MatchingLabels
+
+	for _, kind := range Kinds {
+		objects := &unstructured.UnstructuredList{}
+		objects.SetGroupVersionKind(deployv1alpha1.SchemeGroupVersion.WithKind(kind.Name + "List"))
+		if err := r.Config.List(ctx, objects, ctrlruntimeclient.InNamespace(namespace)); err != nil {
+			return nil, err
+		}
+
+		for i := range objects.Items {
+			item := &objects.Items[i]
+			if item.GetDeletionTimestamp() == nil && r.placedHere(item) {
+				result = append(result, selectors(item.GetLabels())...)
+			}
+		}
+	}
+
+	return result, nil
+}
+
+func matchesAny(selectors []ctrlruntimeclient.MatchingLabels, labels map[string]string) bool {
+	for _, selector := range selectors {
+		matches := true
+		for k, v := range selector {
+			if labels[k] != v {
+				matches = false
+				break
+			}
+		}
+
+		if matches {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (r *Reconciler) ensureNamespace(ctx context.Context, name string) error {
+	err := r.Workload.Get(ctx, types.NamespacedName{Name: name}, &corev1.Namespace{})
+	if !apierrors.IsNotFound(err) {
+		return err
+	}
+
+	return r.Workload.Create(ctx, &corev1.Namespace{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:   name,
+			Labels: map[string]string{SyncedLabel: "true"},
+		},
+	})
+}
+
+// syncSecrets copies all Secrets matching the selector into the workload cluster and
+// garbage collects previously synced Secrets that are gone from the config cluster.
+func (r *Reconciler) syncSecrets(ctx context.Context, namespace string, selector ctrlruntimeclient.MatchingLabels) error {
+	sources := &corev1.SecretList{}
+	if err := r.Config.List(ctx, sources, ctrlruntimeclient.InNamespace(namespace), selector); err != nil {
+		return err
+	}
+
+	wanted := map[string]struct{}{}
+	var errs []error
+
+	for _, source := range sources.Items {
+		if source.DeletionTimestamp != nil {
+			continue
+		}
+		wanted[source.Name] = struct{}{}
+
+		if err := r.syncSecret(ctx, &source); err != nil {
+			errs = append(errs, fmt.Errorf("Secret %s: %w", source.Name, err))
+		}
+	}
+
+	targets := &corev1.SecretList{}
+	if err := r.Workload.List(ctx, targets, ctrlruntimeclient.InNamespace(namespace), selector, ctrlruntimeclient.MatchingLabels{SyncedLabel: "true"}); err != nil {
+		return err
+	}
+
+	for _, target := range targets.Items {
+		if _, ok := wanted[target.Name]; ok {
+			continue
+		}
+
+		// The Secret might only be gone from the cache so far, or still be selected by another kind.
+		if err := r.Config.Get(ctx, ctrlruntimeclient.ObjectKeyFromObject(&target), &corev1.Secret{}); !apierrors.IsNotFound(err) {
+			continue
+		}
+
+		recordSync("Secret", actionDelete)
+		if err := r.Workload.Delete(ctx, &target); ctrlruntimeclient.IgnoreNotFound(err) != nil {
+			errs = append(errs, fmt.Errorf("Secret %s: %w", target.Name, err))
+		}
+	}
+
+	return kerrors.NewAggregate(errs)
+}
+
+func (r *Reconciler) syncSecret(ctx context.Context, source *corev1.Secret) error {
+	target := &corev1.Secret{}
+	if err := r.Workload.Get(ctx, ctrlruntimeclient.ObjectKeyFromObject(source), target); err != nil {
+		if !apierrors.IsNotFound(err) {
+			return err
+		}
+
+		return r.createSecret(ctx, source)
+	}
+
+	if target.Labels[SyncedLabel] != "true" {
+		return fmt.Errorf("already exists in the workload cluster and is not managed by the syncer")
+	}
+
+	// The type of a Secret is immutable.
+	if target.Type != source.Type {
+		recordSync("Secret", actionDelete)
+		if err := r.Workload.Delete(ctx, target); ctrlruntimeclient.IgnoreNotFound(err) != nil {
+			return err
+		}
+
+		return r.createSecret(ctx, source)
+	}
+
+	updated := target.DeepCopy()
+	updated.Labels = syncedLabels(source.Labels)
+	updated.Annotations = source.Annotations
+	updated.Data = source.Data
+
+	if equality.Semantic.DeepEqual(target, updated) {
+		return nil
+	}
+
+	recordSync("Secret", actionUpdate)
+
+	return r.Workload.Update(ctx, updated)
+}
+
+func (r *Reconciler) createSecret(ctx context.Context, source *corev1.Secret) error {
+	recordSync("Secret", actionCreate)
+
+	return r.Workload.Create(ctx, &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        source.Name,
+			Namespace:   source.Namespace,
+			Labels:      syncedLabels(source.Labels),
+			Annotations: source.Annotations,
+		},
+		Type: source.Type,
+		Data: source.Data,
+	})
+}
+
+// syncConfigMaps copies all ConfigMaps matching the selector into the workload cluster
+// and garbage collects previously synced ConfigMaps that are gone from the config cluster.
+func (r *Reconciler) syncConfigMaps(ctx context.Context, namespace string, selector ctrlruntimeclient.MatchingLabels) error {
+	sources := &corev1.ConfigMapList{}
+	if err := r.Config.List(ctx, sources, ctrlruntimeclient.InNamespace(namespace), selector); err != nil {
+		return err
+	}
+
+	wanted := map[string]struct{}{}
+	var errs []error
+
+	for _, source := range sources.Items {
+		if source.DeletionTimestamp != nil {
+			continue
+		}
+		wanted[source.Name] = struct{}{}
+
+		if err := r.syncConfigMap(ctx, &source); err != nil {
+			errs = append(errs, fmt.Errorf("ConfigMap %s: %w", source.Name, err))
+		}
+	}
+
+	targets := &corev1.ConfigMapList{}
+	if err := r.Workload.List(ctx, targets, ctrlruntimeclient.InNamespace(namespace), selector, ctrlruntimeclient.MatchingLabels{SyncedLabel: "true"}); err != nil {
+		return err
+	}
+
+	for _, target := range targets.Items {
+		if _, ok := wanted[target.Name]; ok {
+			continue
+		}
+
+		if err := r.Config.Get(ctx, ctrlruntimeclient.ObjectKeyFromObject(&target), &corev1.ConfigMap{}); !apierrors.IsNotFound(err) {
+			continue
+		}
+
+		recordSync("ConfigMap", actionDelete)
+		if err := r.Workload.Delete(ctx, &target); ctrlruntimeclient.IgnoreNotFound(err) != nil {
+			errs = append(errs, fmt.Errorf("ConfigMap %s: %w", target.Name, err))
+		}
+	}
+
+	return kerrors.NewAggregate(errs)
+}
+
+func (r *Reconciler) syncConfigMap(ctx context.Context, source *corev1.ConfigMap) error {
+	target := &corev1.ConfigMap{}
+	if err := r.Workload.Get(ctx, ctrlruntimeclient.ObjectKeyFromObject(source), target); err != nil {
+		if !apierrors.IsNotFound(err) {
+			return err
+		}
+
+		recordSync("ConfigMap", actionCreate)
+
+		return r.Workload.Create(ctx, &corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:        source.Name,
+				Namespace:   source.Namespace,
+				Labels:      syncedLabels(source.Labels),
+				Annotations: source.Annotations,
+			},
+			Data:       source.Data,
+			BinaryData: source.BinaryData,
+		})
+	}
+
+	if target.Labels[SyncedLabel] != "true" {
+		return fmt.Errorf("already exists in the workload cluster and is not managed by the syncer")
+	}
+
+	updated := target.DeepCopy()
+	updated.Labels = syncedLabels(source.Labels)
+	updated.Annotations = source.Annotations
+	updated.Data = source.Data
+	updated.BinaryData = source.BinaryData
+
+	if equality.Semantic.DeepEqual(target, updated) {
+		return nil
+	}
+
+	recordSync("ConfigMap", actionUpdate)
+
+	return r.Workload.Update(ctx, updated)
+}
+
+// translateGenerations rewrites the observed generations in a status from the workload cluster so
+// that they refer to the generation of the object in the config cluster. Since the spec has just
+// been synced, observing the current workload generation means observing the current config
+// generation; older generations are left alone.
+func translateGenerations(status any, workloadGeneration, configGeneration int64) any {
+	statusMap, ok := status.(map[string]any)
+	if !ok {
+		return status
+	}
+
+	statusMap = runtime.DeepCopyJSON(statusMap)
+
+	translate := func(obj map[string]any) {
+		if generation, ok := obj["observedGeneration"].(int64); ok && generation == workloadGeneration {
+			obj["observedGeneration"] = configGeneration
+		}
+	}
+
+	translate(statusMap)
+
+	if conditions, ok := statusMap["conditions"].([]any); ok {
+		for _, condition := range conditions {
+			if conditionMap, ok := condition.(map[string]any); ok {
+				translate(conditionMap)
+			}
+		}
+	}
+
+	return statusMap
+}
+
+func syncedLabels(labels map[string]string) map[string]string {
+	result := make(map[string]string, len(labels)+1)
+	for k, v := range labels {
+		result[k] = v
+	}
+	result[SyncedLabel] = "true"
+
+	// In the workload cluster the object is not placed anywhere else, so that the workload
+	// controllers there reconcile it.
+	delete(result, resources.PlacementLabel)
+
+	return result
+}
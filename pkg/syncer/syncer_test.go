@@ -0,0 +1,314 @@
+/*
+Copyright 2026 The kcp Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package syncer
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	ctrlruntimeclient "sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/kcp-dev/kcp-operator/internal/resources"
+	"github.com/kcp-dev/kcp-operator/pkg/controller/util"
+	deployv1alpha1 "github.com/kcp-dev/kcp-operator/sdk/apis/deploy/v1alpha1"
+	operatorv1alpha1 "github.com/kcp-dev/kcp-operator/sdk/apis/operator/v1alpha1"
+)
+
+const namespace = "kcp"
+
+var shardKind = Kind{Name: "CompiledShard", Label: resources.ShardLabel}
+
+func newCompiledShard() *deployv1alpha1.CompiledShard {
+	return &deployv1alpha1.CompiledShard{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "shardy",
+			Namespace: namespace,
+			Labels:    map[string]string{resources.ShardLabel: "shardy"},
+			// UIDs and owner references of the config cluster must not leak into the workload cluster.
+			OwnerReferences: []metav1.OwnerReference{{APIVersion: "operator.kcp.io/v1alpha1", Kind: "Shard", Name: "shardy", UID: "1234"}},
+		},
+		Spec: deployv1alpha1.CompiledShardSpec{
+			Shard: operatorv1alpha1.ShardSpec{
+				CommonShardSpec: operatorv1alpha1.CommonShardSpec{
+					Etcd: operatorv1alpha1.EtcdConfig{Endpoints: []string{"https://localhost:2379"}},
+				},
+			},
+		},
+	}
+}
+
+func newSecret(name string, labels map[string]string) *corev1.Secret {
+	return &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: namespace,
+			Labels:    labels,
+		},
+		Data: map[string][]byte{"tls.crt": []byte(name)},
+	}
+}
+
+func newClient(objects ...ctrlruntimeclient.Object) ctrlruntimeclient.Client {
+	return fake.NewClientBuilder().
+		WithScheme(util.GetTestScheme()).
+		WithStatusSubresource(&deployv1alpha1.CompiledShard{}).
+		WithObjects(objects...).
+		Build()
+}
+
+func reconcileShard(t *testing.T, r *Reconciler) {
+	t.Helper()
+
+	_, err := r.Reconcile(context.Background(), ctrl.Request{NamespacedName: types.NamespacedName{Namespace: namespace, Name: "shardy"}})
+	require.NoError(t, err)
+}
+
+func TestSyncToWorkloadCluster(t *testing.T) {
+	ctx := context.Background()
+
+	config := newClient(
+		newCompiledShard(),
+		newSecret("shardy-server", map[string]string{resources.ShardLabel: "shardy"}),
+		newSecret("unrelated", map[string]string{resources.ShardLabel: "other"}),
+	)
+	workload := newClient()
+
+	r := &Reconciler{Kind: shardKind, Config: config, Workload: workload}
+	reconcileShard(t, r)
+
+	require.NoError(t, workload.Get(ctx, types.NamespacedName{Name: namespace}, &corev1.Namespace{}))
+
+	secret := &corev1.Secret{}
+	require.NoError(t, workload.Get(ctx, types.NamespacedName{Namespace: namespace, Name: "shardy-server"}, secret))
+	require.Equal(t, "true", secret.Labels[SyncedLabel])
+	require.Equal(t, []byte("shardy-server"), secret.Data["tls.crt"])
+
+	err := workload.Get(ctx, types.NamespacedName{Namespace: namespace, Name: "unrelated"}, &corev1.Secret{})
+	require.True(t, apierrors.IsNotFound(err), "unrelated Secret should not have been synced")
+
+	compiled := &deployv1alpha1.CompiledShard{}
+	require.NoError(t, workload.Get(ctx, types.NamespacedName{Namespace: namespace, Name: "shardy"}, compiled))
+	require.Equal(t, "true", compiled.Labels[SyncedLabel])
+	require.Empty(t, compiled.OwnerReferences)
+	require.Equal(t, []string{"https://localhost:2379"}, compiled.Spec.Shard.Etcd.Endpoints)
+
+	// spec changes are propagated
+	source := &deployv1alpha1.CompiledShard{}
+	require.NoError(t, config.Get(ctx, types.NamespacedName{Namespace: namespace, Name: "shardy"}, source))
+	source.Spec.Shard.Etcd.Endpoints = []string{"https://etcd:2379"}
+	require.NoError(t, config.Update(ctx, source))
+
+	reconcileShard(t, r)
+
+	require.NoError(t, workload.Get(ctx, types.NamespacedName{Namespace: namespace, Name: "shardy"}, compiled))
+	require.Equal(t, []string{"https://etcd:2379"}, compiled.Spec.Shard.Etcd.Endpoints)
+}
+
+func TestSyncStatusToConfigCluster(t *testing.T) {
+	ctx := context.Background()
+
+	config := newClient(newCompiledShard())
+	workload := newClient()
+
+	r := &Reconciler{Kind: shardKind, Config: config, Workload: workload}
+	reconcileShard(t, r)
+
+	compiled := &deployv1alpha1.CompiledShard{}
+	require.NoError(t, workload.Get(ctx, types.NamespacedName{Namespace: namespace, Name: "shardy"}, compiled))
+	compiled.Status.Phase = operatorv1alpha1.ShardPhaseRunning
+	require.NoError(t, workload.Status().Update(ctx, compiled))
+
+	reconcileShard(t, r)
+
+	source := &deployv1alpha1.CompiledShard{}
+	require.NoError(t, config.Get(ctx, types.NamespacedName{Namespace: namespace, Name: "shardy"}, source))
+	require.Equal(t, operatorv1alpha1.ShardPhaseRunning, source.Status.Phase)
+}
+
+func TestGarbageCollection(t *testing.T) {
+	ctx := context.Background()
+
+	serverSecret := newSecret("shardy-server", map[string]string{resources.ShardLabel: "shardy"})
+	config := newClient(
+		newCompiledShard(),
+		serverSecret,
+		newSecret("shardy-kubeconfig", map[string]string{resources.ShardLabel: "shardy"}),
+	)
+	workload := newClient(
+		// created by someone else, must be left alone
+		newSecret("shardy-manual", map[string]string{resources.ShardLabel: "shardy"}),
+	)
+
+	r := &Reconciler{Kind: shardKind, Config: config, Workload: workload}
+	reconcileShard(t, r)
+
+	// Secrets that are removed from the config cluster are removed from the workload cluster.
+	require.NoError(t, config.Delete(ctx, serverSecret))
+	reconcileShard(t, r)
+
+	err := workload.Get(ctx, types.NamespacedName{Namespace: namespace, Name: "shardy-server"}, &corev1.Secret{})
+	require.True(t, apierrors.IsNotFound(err), "stale Secret should have been deleted")
+
+	// Deleting the compiled object removes it from the workload cluster.
+	require.NoError(t, config.Delete(ctx, newCompiledShard()))
+	reconcileShard(t, r)
+
+	err = workload.Get(ctx, types.NamespacedName{Namespace: namespace, Name: "shardy"}, &deployv1alpha1.CompiledShard{})
+	require.True(t, apierrors.IsNotFound(err), "CompiledShard should have been deleted")
+
+	// Its synced Secrets are removed along with it, even if they still exist in the config cluster.
+	err = workload.Get(ctx, types.NamespacedName{Namespace: namespace, Name: "shardy-kubeconfig"}, &corev1.Secret{})
+	require.True(t, apierrors.IsNotFound(err), "synced Secret should have been deleted")
+
+	require.NoError(t, workload.Get(ctx, types.NamespacedName{Namespace: namespace, Name: "shardy-manual"}, &corev1.Secret{}))
+}
+
+func TestCleanupDoesNotCreateObjects(t *testing.T) {
+	ctx := context.Background()
+
+	config := newClient(newSecret("shardy-server", map[string]string{resources.ShardLabel: "shardy"}))
+	workload := newClient()
+
+	// The CompiledShard does not exist (anymore), so nothing must be copied.
+	reconcileShard(t, &Reconciler{Kind: shardKind, Config: config, Workload: workload})
+
+	err := workload.Get(ctx, types.NamespacedName{Namespace: namespace, Name: "shardy-server"}, &corev1.Secret{})
+	require.True(t, apierrors.IsNotFound(err), "Secret should not have been synced during cleanup")
+}
+
+func TestPlacement(t *testing.T) {
+	ctx := context.Background()
+
+	placed := newCompiledShard()
+	placed.Labels[resources.RootShardLabel] = "rooty"
+	placed.Labels[resources.PlacementLabel] = "eu-1"
+
+	config := newClient(
+		placed,
+		newSecret("shardy-server", map[string]string{resources.ShardLabel: "shardy"}),
+		// mounted by the shard, but belongs to its RootShard
+		newSecret("rooty-ca", map[string]string{resources.RootShardLabel: "rooty"}),
+	)
+
+	// Syncers for other clusters leave the object alone.
+	other := newClient()
+	reconcileShard(t, &Reconciler{Kind: shardKind, Config: config, Workload: other})
+
+	err := other.Get(ctx, types.NamespacedName{Namespace: namespace, Name: "shardy"}, &deployv1alpha1.CompiledShard{})
+	require.True(t, apierrors.IsNotFound(err), "CompiledShard should not have been synced into an unrelated cluster")
+
+	secrets := &corev1.SecretList{}
+	require.NoError(t, other.List(ctx, secrets))
+	require.Empty(t, secrets.Items, "Secrets should not have been synced into an unrelated cluster")
+
+	workload := newClient()
+	r := &Reconciler{Kind: shardKind, Config: config, Workload: workload, ClusterName: "eu-1"}
+	reconcileShard(t, r)
+
+	compiled := &deployv1alpha1.CompiledShard{}
+	require.NoError(t, workload.Get(ctx, types.NamespacedName{Namespace: namespace, Name: "shardy"}, compiled))
+	require.NotContains(t, compiled.Labels, resources.PlacementLabel)
+	require.NoError(t, workload.Get(ctx, types.NamespacedName{Namespace: namespace, Name: "shardy-server"}, &corev1.Secret{}))
+	require.NoError(t, workload.Get(ctx, types.NamespacedName{Namespace: namespace, Name: "rooty-ca"}, &corev1.Secret{}))
+
+	// The cluster is reported with the status.
+	compiled.Status.Phase = operatorv1alpha1.ShardPhaseRunning
+	require.NoError(t, workload.Status().Update(ctx, compiled))
+	reconcileShard(t, r)
+
+	source := &deployv1alpha1.CompiledShard{}
+	require.NoError(t, config.Get(ctx, types.NamespacedName{Namespace: namespace, Name: "shardy"}, source))
+	require.Equal(t, operatorv1alpha1.ShardPhaseRunning, source.Status.Phase)
+	require.Equal(t, &operatorv1alpha1.PlacementStatus{Cluster: "eu-1"}, source.Status.Placement)
+
+	// Moving the object to another cluster removes it.
+	source.Labels[resources.PlacementLabel] = "us-2"
+	require.NoError(t, config.Update(ctx, source))
+	reconcileShard(t, r)
+
+	err = workload.Get(ctx, types.NamespacedName{Namespace: namespace, Name: "shardy"}, &deployv1alpha1.CompiledShard{})
+	require.True(t, apierrors.IsNotFound(err), "CompiledShard should have been removed after changing its placement")
+
+	for _, name := range []string{"shardy-server", "rooty-ca"} {
+		err = workload.Get(ctx, types.NamespacedName{Namespace: namespace, Name: name}, &corev1.Secret{})
+		require.True(t, apierrors.IsNotFound(err), "Secret %s should have been removed after changing its placement", name)
+	}
+}
+
+func TestUnmanagedObjectsAreNotOverwritten(t *testing.T) {
+	config := newClient(newCompiledShard())
+	workload := newClient(&corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: namespace}}, newCompiledShard())
+
+	r := &Reconciler{Kind: shardKind, Config: config, Workload: workload}
+
+	_, err := r.Reconcile(context.Background(), ctrl.Request{NamespacedName: types.NamespacedName{Namespace: namespace, Name: "shardy"}})
+	require.Error(t, err)
+}
+
+func TestTranslateGenerations(t *testing.T) {
+	status := map[string]any{
+		"observedGeneration": int64(3),
+		"conditions": []any{
+			map[string]any{"type": "Available", "observedGeneration": int64(3)},
+			map[string]any{"type": "EtcdHealthy", "observedGeneration": int64(2)},
+		},
+	}
+
+	translated := translateGenerations(status, 3, 7).(map[string]any)
+
+	require.Equal(t, int64(7), translated["observedGeneration"])
+	conditions := translated["conditions"].([]any)
+	require.Equal(t, int64(7), conditions[0].(map[string]any)["observedGeneration"])
+	require.Equal(t, int64(2), conditions[1].(map[string]any)["observedGeneration"], "stale generations must not be translated")
+
+	// the input is left untouched
+	require.Equal(t, int64(3), status["observedGeneration"])
+}
+
+func TestCleanupKeepsSecretsInUse(t *testing.T) {
+	ctx := context.Background()
+
+	moved := newCompiledShard()
+	moved.Labels[resources.RootShardLabel] = "rooty"
+	moved.Labels[resources.PlacementLabel] = "us-2"
+
+	rootShard := &deployv1alpha1.CompiledRootShard{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "rooty",
+			Namespace: namespace,
+			Labels:    map[string]string{resources.RootShardLabel: "rooty"},
+		},
+	}
+
+	synced := map[string]string{resources.RootShardLabel: "rooty", SyncedLabel: "true"}
+
+	config := newClient(moved, rootShard, newSecret("rooty-ca", map[string]string{resources.RootShardLabel: "rooty"}))
+	workload := newClient(newSecret("rooty-ca", synced))
+
+	// The RootShard is still synced into this cluster and mounts its CA Secret.
+	reconcileShard(t, &Reconciler{Kind: shardKind, Config: config, Workload: workload})
+
+	require.NoError(t, workload.Get(ctx, types.NamespacedName{Namespace: namespace, Name: "rooty-ca"}, &corev1.Secret{}))
+}
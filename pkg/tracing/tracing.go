@@ -0,0 +1,113 @@
+/*
+Copyright 2026 The kcp Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package tracing contains the OpenTelemetry instrumentation of the operator's controllers.
+// Spans are recorded using the global tracer provider, so unless tracing has been configured,
+// all of this is a no-op.
+package tracing
+
+import (
+	"context"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/trace"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	ctrl "sigs.k8s.io/controller-runtime"
+	mcreconcile "sigs.k8s.io/multicluster-runtime/pkg/reconcile"
+
+	deployv1alpha1 "github.com/kcp-dev/kcp-operator/sdk/apis/deploy/v1alpha1"
+)
+
+// TracerName is the name of the tracer used for all spans recorded by the operator.
+const TracerName = "github.com/kcp-dev/kcp-operator"
+
+const traceParentKey = "traceparent"
+
+var propagator = propagation.TraceContext{}
+
+// Start starts a new span as a child of the span in ctx, if any.
+func Start(ctx context.Context, name string, attrs ...attribute.KeyValue) (context.Context, trace.Span) {
+	return otel.Tracer(TracerName).Start(ctx, name, trace.WithAttributes(attrs...))
+}
+
+// End marks the span as failed if err is not nil and ends it.
+func End(span trace.Span, err error) {
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+
+	span.End()
+}
+
+type reconciler struct {
+	name       string
+	reconciler mcreconcile.Reconciler
+}
+
+// Reconciler wraps a reconciler so that every reconcile is recorded as its own span.
+func Reconciler(name string, r mcreconcile.Reconciler) mcreconcile.Reconciler {
+	return &reconciler{name: name, reconciler: r}
+}
+
+func (r *reconciler) Reconcile(ctx context.Context, req mcreconcile.Request) (res ctrl.Result, err error) {
+	ctx, span := Start(ctx, "Reconcile "+r.name,
+		attribute.String("kcp-operator.controller", r.name),
+		attribute.String("kcp-operator.cluster", req.ClusterName.String()),
+		attribute.String("kcp-operator.namespace", req.Namespace),
+		attribute.String("kcp-operator.name", req.Name),
+	)
+	defer func() { End(span, err) }()
+
+	return r.reconciler.Reconcile(ctx, req)
+}
+
+// TraceParent returns the W3C traceparent of the span in ctx, or an empty string if ctx
+// does not contain a span.
+func TraceParent(ctx context.Context) string {
+	carrier := propagation.MapCarrier{}
+	propagator.Inject(ctx, carrier)
+
+	return carrier.Get(traceParentKey)
+}
+
+// LinkCompiled links the span in ctx to the span that last changed the given Compiled* object.
+func LinkCompiled(ctx context.Context, obj metav1.Object) {
+	traceParent := obj.GetAnnotations()[deployv1alpha1.TraceParentAnnotation]
+	if traceParent == "" {
+		return
+	}
+
+	remote := trace.SpanContextFromContext(propagator.Extract(context.Background(), propagation.MapCarrier{traceParentKey: traceParent}))
+	if !remote.IsValid() {
+		return
+	}
+
+	trace.SpanFromContext(ctx).AddLink(trace.Link{SpanContext: remote})
+}
+
+// Trace runs fn in a new span that is a child of the span in ctx.
+func Trace(ctx context.Context, name string, fn func(ctx context.Context) error) error {
+	ctx, span := Start(ctx, name)
+	err := fn(ctx)
+	End(span, err)
+
+	return err
+}
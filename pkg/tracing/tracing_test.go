@@ -0,0 +1,121 @@
+/*
+Copyright 2026 The kcp Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tracing
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	deployv1alpha1 "github.com/kcp-dev/kcp-operator/sdk/apis/deploy/v1alpha1"
+)
+
+func setupRecorder(t *testing.T) *tracetest.SpanRecorder {
+	t.Helper()
+
+	recorder := tracetest.NewSpanRecorder()
+	provider := sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder))
+
+	previous := otel.GetTracerProvider()
+	otel.SetTracerProvider(provider)
+	t.Cleanup(func() { otel.SetTracerProvider(previous) })
+
+	return recorder
+}
+
+func TestTraceParentIsEmptyWithoutSpan(t *testing.T) {
+	if traceParent := TraceParent(context.Background()); traceParent != "" {
+		t.Fatalf("Expected no traceparent, got %q.", traceParent)
+	}
+}
+
+func TestLinkCompiled(t *testing.T) {
+	recorder := setupRecorder(t)
+
+	ctx, configSpan := Start(context.Background(), "config")
+	traceParent := TraceParent(ctx)
+	configSpan.End()
+
+	if traceParent == "" {
+		t.Fatal("Expected a traceparent for a recorded span.")
+	}
+
+	compiled := &deployv1alpha1.CompiledShard{
+		ObjectMeta: metav1.ObjectMeta{
+			Annotations: map[string]string{deployv1alpha1.TraceParentAnnotation: traceParent},
+		},
+	}
+
+	ctx, workloadSpan := Start(context.Background(), "workload")
+	LinkCompiled(ctx, compiled)
+	workloadSpan.End()
+
+	spans := recorder.Ended()
+	if len(spans) != 2 {
+		t.Fatalf("Expected 2 spans, got %d.", len(spans))
+	}
+
+	links := spans[1].Links()
+	if len(links) != 1 {
+		t.Fatalf("Expected 1 link, got %d.", len(links))
+	}
+
+	if links[0].SpanContext.SpanID() != spans[0].SpanContext().SpanID() {
+		t.Errorf("Expected link to span %s, got %s.", spans[0].SpanContext().SpanID(), links[0].SpanContext.SpanID())
+	}
+}
+
+func TestLinkCompiledIgnoresInvalidAnnotation(t *testing.T) {
+	recorder := setupRecorder(t)
+
+	compiled := &deployv1alpha1.CompiledShard{
+		ObjectMeta: metav1.ObjectMeta{
+			Annotations: map[string]string{deployv1alpha1.TraceParentAnnotation: "garbage"},
+		},
+	}
+
+	ctx, span := Start(context.Background(), "workload")
+	LinkCompiled(ctx, compiled)
+	span.End()
+
+	if links := recorder.Ended()[0].Links(); len(links) != 0 {
+		t.Fatalf("Expected no links, got %v.", links)
+	}
+}
+
+func TestTrace(t *testing.T) {
+	recorder := setupRecorder(t)
+
+	err := Trace(context.Background(), "failing", func(ctx context.Context) error {
+		return errors.New("boom")
+	})
+	if err == nil {
+		t.Fatal("Expected error to be returned.")
+	}
+
+	span := recorder.Ended()[0]
+	if span.Name() != "failing" || span.Status().Code != codes.Error {
+		t.Fatalf("Expected failed span, got %q with status %v.", span.Name(), span.Status())
+	}
+}
@@ -0,0 +1,89 @@
+/*
+Copyright 2026 The kcp Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package webhook
+
+import (
+	"context"
+
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	operatorv1alpha1 "github.com/kcp-dev/kcp-operator/sdk/apis/operator/v1alpha1"
+)
+
+// +kubebuilder:webhook:path=/validate-operator-kcp-io-v1alpha1-cacheserver,mutating=false,failurePolicy=fail,sideEffects=None,groups=operator.kcp.io,resources=cacheservers,verbs=create;update,versions=v1alpha1,name=vcacheserver-v1alpha1.operator.kcp.io,admissionReviewVersions=v1
+
+// CacheServerValidator validates CacheServer objects.
+type CacheServerValidator struct{}
+
+var _ admission.Validator[*operatorv1alpha1.CacheServer] = &CacheServerValidator{}
+
+func (v *CacheServerValidator) ValidateCreate(_ context.Context, server *operatorv1alpha1.CacheServer) (admission.Warnings, error) {
+	return nil, toError("CacheServer", server.Name, validateCacheServer(server))
+}
+
+func (v *CacheServerValidator) ValidateUpdate(_ context.Context, oldServer, newServer *operatorv1alpha1.CacheServer) (admission.Warnings, error) {
+	if beingDeleted(newServer) {
+		return nil, nil
+	}
+
+	allErrs := newErrors(validateCacheServer(newServer), validateCacheServer(oldServer))
+	allErrs = append(allErrs, validateImmutable(field.NewPath("spec", "certificates"), newServer.Spec.Certificates, oldServer.Spec.Certificates)...)
+
+	return nil, toError("CacheServer", newServer.Name, allErrs)
+}
+
+func (v *CacheServerValidator) ValidateDelete(_ context.Context, _ *operatorv1alpha1.CacheServer) (admission.Warnings, error) {
+	return nil, nil
+}
+
+func validateCacheServer(server *operatorv1alpha1.CacheServer) field.ErrorList {
+	specPath := field.NewPath("spec")
+	spec := server.Spec
+
+	certsPath := specPath.Child("certificates")
+	allErrs := validateCertificates(certsPath, spec.Certificates)
+
+	// The cache server's CA is always acquired from cert-manager.
+	if spec.Certificates.CASecretRef != nil {
+		allErrs = append(allErrs, field.Forbidden(certsPath.Child("caSecretRef"), "cache servers only support issuerRef"))
+	}
+
+	if spec.Replicas != nil && *spec.Replicas < 0 {
+		allErrs = append(allErrs, field.Invalid(specPath.Child("replicas"), *spec.Replicas, "must not be negative"))
+	}
+
+	if etcd := spec.Etcd; etcd != nil {
+		etcdPath := specPath.Child("etcd")
+
+		if etcd.Managed != nil {
+			allErrs = append(allErrs, field.Forbidden(etcdPath.Child("managed"), "operator-managed etcd is not supported for cache servers"))
+		}
+
+		if len(etcd.Endpoints) == 0 {
+			allErrs = append(allErrs, field.Required(etcdPath.Child("endpoints"), "at least one etcd endpoint is required"))
+		}
+
+		if etcd.TLSConfig != nil && etcd.TLSConfig.SecretRef.Name == "" {
+			allErrs = append(allErrs, field.Required(etcdPath.Child("tlsConfig", "secretRef", "name"), ""))
+		}
+	}
+
+	allErrs = append(allErrs, validateExtraArgs(specPath.Child("extraArgs"), spec.ExtraArgs, cacheServerManagedFlags)...)
+
+	return allErrs
+}
@@ -0,0 +1,100 @@
+/*
+Copyright 2026 The kcp Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package webhook
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	operatorv1alpha1 "github.com/kcp-dev/kcp-operator/sdk/apis/operator/v1alpha1"
+)
+
+func TestCacheServerValidator(t *testing.T) {
+	testcases := []struct {
+		name    string
+		mutate  func(*operatorv1alpha1.CacheServer)
+		invalid bool
+	}{
+		{
+			name:   "valid",
+			mutate: func(*operatorv1alpha1.CacheServer) {},
+		},
+		{
+			name: "caSecretRef is not supported",
+			mutate: func(s *operatorv1alpha1.CacheServer) {
+				s.Spec.Certificates = operatorv1alpha1.Certificates{
+					CASecretRef: &corev1.LocalObjectReference{Name: "ca"},
+				}
+			},
+			invalid: true,
+		},
+		{
+			name: "etcd without endpoints",
+			mutate: func(s *operatorv1alpha1.CacheServer) {
+				s.Spec.Etcd = &operatorv1alpha1.EtcdConfig{}
+			},
+			invalid: true,
+		},
+		{
+			name: "managed etcd is not supported",
+			mutate: func(s *operatorv1alpha1.CacheServer) {
+				s.Spec.Etcd = &operatorv1alpha1.EtcdConfig{
+					Managed: &operatorv1alpha1.ManagedEtcdSpec{},
+				}
+			},
+			invalid: true,
+		},
+		{
+			name: "overriding a managed flag",
+			mutate: func(s *operatorv1alpha1.CacheServer) {
+				s.Spec.ExtraArgs = []string{"--embedded-etcd-directory=/tmp"}
+			},
+			invalid: true,
+		},
+	}
+
+	validator := &CacheServerValidator{}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			server := &operatorv1alpha1.CacheServer{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "cache",
+					Namespace: "default",
+				},
+				Spec: operatorv1alpha1.CacheServerSpec{
+					Certificates: operatorv1alpha1.Certificates{
+						IssuerRef: &operatorv1alpha1.ObjectReference{Name: "issuer"},
+					},
+				},
+			}
+			tc.mutate(server)
+
+			_, err := validator.ValidateCreate(context.Background(), server)
+			if tc.invalid {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
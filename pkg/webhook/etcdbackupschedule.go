@@ -0,0 +1,66 @@
+/*
+Copyright 2026 The kcp Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package webhook
+
+import (
+	"context"
+
+	"github.com/robfig/cron/v3"
+
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	operatorv1alpha1 "github.com/kcp-dev/kcp-operator/sdk/apis/operator/v1alpha1"
+)
+
+// +kubebuilder:webhook:path=/validate-operator-kcp-io-v1alpha1-etcdbackupschedule,mutating=false,failurePolicy=fail,sideEffects=None,groups=operator.kcp.io,resources=etcdbackupschedules,verbs=create;update,versions=v1alpha1,name=vetcdbackupschedule-v1alpha1.operator.kcp.io,admissionReviewVersions=v1
+
+// EtcdBackupScheduleValidator validates EtcdBackupSchedule objects.
+type EtcdBackupScheduleValidator struct{}
+
+var _ admission.Validator[*operatorv1alpha1.EtcdBackupSchedule] = &EtcdBackupScheduleValidator{}
+
+func (v *EtcdBackupScheduleValidator) ValidateCreate(_ context.Context, schedule *operatorv1alpha1.EtcdBackupSchedule) (admission.Warnings, error) {
+	return nil, toError("EtcdBackupSchedule", schedule.Name, validateEtcdBackupSchedule(schedule))
+}
+
+func (v *EtcdBackupScheduleValidator) ValidateUpdate(_ context.Context, oldSchedule, newSchedule *operatorv1alpha1.EtcdBackupSchedule) (admission.Warnings, error) {
+	if beingDeleted(newSchedule) {
+		return nil, nil
+	}
+
+	return nil, toError("EtcdBackupSchedule", newSchedule.Name, newErrors(validateEtcdBackupSchedule(newSchedule), validateEtcdBackupSchedule(oldSchedule)))
+}
+
+func (v *EtcdBackupScheduleValidator) ValidateDelete(_ context.Context, _ *operatorv1alpha1.EtcdBackupSchedule) (admission.Warnings, error) {
+	return nil, nil
+}
+
+func validateEtcdBackupSchedule(schedule *operatorv1alpha1.EtcdBackupSchedule) field.ErrorList {
+	specPath := field.NewPath("spec")
+
+	var allErrs field.ErrorList
+
+	if _, err := cron.ParseStandard(schedule.Spec.Schedule); err != nil {
+		allErrs = append(allErrs, field.Invalid(specPath.Child("schedule"), schedule.Spec.Schedule, err.Error()))
+	}
+
+	allErrs = append(allErrs, validateEtcdSnapshotTarget(specPath.Child("target"), schedule.Spec.Target)...)
+	allErrs = append(allErrs, validateEtcdSnapshotStorage(specPath.Child("storage"), schedule.Spec.Storage)...)
+
+	return allErrs
+}
@@ -0,0 +1,135 @@
+/*
+Copyright 2026 The kcp Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package webhook
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	operatorv1alpha1 "github.com/kcp-dev/kcp-operator/sdk/apis/operator/v1alpha1"
+)
+
+func newEtcdBackupSchedule() *operatorv1alpha1.EtcdBackupSchedule {
+	return &operatorv1alpha1.EtcdBackupSchedule{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "nightly",
+			Namespace: "default",
+		},
+		Spec: operatorv1alpha1.EtcdBackupScheduleSpec{
+			Schedule: "0 3 * * *",
+			Target: operatorv1alpha1.EtcdSnapshotTarget{
+				RootShardRef: &corev1.LocalObjectReference{Name: "rooty"},
+			},
+			Storage: operatorv1alpha1.EtcdSnapshotStorage{
+				S3: &operatorv1alpha1.S3SnapshotStorage{
+					Endpoint:             "https://s3.example.com",
+					Bucket:               "backups",
+					CredentialsSecretRef: corev1.LocalObjectReference{Name: "s3-credentials"},
+				},
+			},
+		},
+	}
+}
+
+func TestEtcdBackupScheduleValidator(t *testing.T) {
+	testcases := []struct {
+		name    string
+		mutate  func(*operatorv1alpha1.EtcdBackupSchedule)
+		invalid bool
+	}{
+		{
+			name:   "valid",
+			mutate: func(*operatorv1alpha1.EtcdBackupSchedule) {},
+		},
+		{
+			name: "descriptor schedule",
+			mutate: func(s *operatorv1alpha1.EtcdBackupSchedule) {
+				s.Spec.Schedule = "@hourly"
+			},
+		},
+		{
+			name: "invalid schedule",
+			mutate: func(s *operatorv1alpha1.EtcdBackupSchedule) {
+				s.Spec.Schedule = "every night"
+			},
+			invalid: true,
+		},
+		{
+			name: "schedule with seconds",
+			mutate: func(s *operatorv1alpha1.EtcdBackupSchedule) {
+				s.Spec.Schedule = "0 0 3 * * *"
+			},
+			invalid: true,
+		},
+		{
+			name: "both targets",
+			mutate: func(s *operatorv1alpha1.EtcdBackupSchedule) {
+				s.Spec.Target.ShardRef = &corev1.LocalObjectReference{Name: "shardy"}
+			},
+			invalid: true,
+		},
+		{
+			name: "no storage",
+			mutate: func(s *operatorv1alpha1.EtcdBackupSchedule) {
+				s.Spec.Storage = operatorv1alpha1.EtcdSnapshotStorage{}
+			},
+			invalid: true,
+		},
+		{
+			name: "S3 endpoint without scheme",
+			mutate: func(s *operatorv1alpha1.EtcdBackupSchedule) {
+				s.Spec.Storage.S3.Endpoint = "s3.example.com"
+			},
+			invalid: true,
+		},
+		{
+			name: "S3 endpoint with path",
+			mutate: func(s *operatorv1alpha1.EtcdBackupSchedule) {
+				s.Spec.Storage.S3.Endpoint = "https://gateway.example.com/s3"
+			},
+			invalid: true,
+		},
+		{
+			name: "missing S3 credentials",
+			mutate: func(s *operatorv1alpha1.EtcdBackupSchedule) {
+				s.Spec.Storage.S3.CredentialsSecretRef.Name = ""
+			},
+			invalid: true,
+		},
+	}
+
+	validator := &EtcdBackupScheduleValidator{}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			schedule := newEtcdBackupSchedule()
+			tc.mutate(schedule)
+
+			_, err := validator.ValidateCreate(context.Background(), schedule)
+			if tc.invalid {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
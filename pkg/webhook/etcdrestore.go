@@ -0,0 +1,99 @@
+/*
+Copyright 2026 The kcp Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package webhook
+
+import (
+	"context"
+	"fmt"
+
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	ctrlruntimeclient "sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	"github.com/kcp-dev/kcp-operator/pkg/controller/util"
+	operatorv1alpha1 "github.com/kcp-dev/kcp-operator/sdk/apis/operator/v1alpha1"
+)
+
+// +kubebuilder:webhook:path=/validate-operator-kcp-io-v1alpha1-etcdrestore,mutating=false,failurePolicy=fail,sideEffects=None,groups=operator.kcp.io,resources=etcdrestores,verbs=create;update,versions=v1alpha1,name=vetcdrestore-v1alpha1.operator.kcp.io,admissionReviewVersions=v1
+
+// EtcdRestoreValidator validates EtcdRestore objects.
+type EtcdRestoreValidator struct {
+	// Client is used to look up the snapshot and the shard it is restored into.
+	Client ctrlruntimeclient.Client
+}
+
+var _ admission.Validator[*operatorv1alpha1.EtcdRestore] = &EtcdRestoreValidator{}
+
+func (v *EtcdRestoreValidator) ValidateCreate(ctx context.Context, restore *operatorv1alpha1.EtcdRestore) (admission.Warnings, error) {
+	allErrs := validateEtcdRestore(restore)
+
+	// The spec is immutable, so whether the target can be restored at all only needs to be
+	// checked when the restore is created.
+	if len(allErrs) == 0 {
+		errs, err := v.validateTarget(ctx, restore)
+		if err != nil {
+			return nil, err
+		}
+		allErrs = append(allErrs, errs...)
+	}
+
+	return nil, toError("EtcdRestore", restore.Name, allErrs)
+}
+
+func (v *EtcdRestoreValidator) ValidateUpdate(_ context.Context, oldRestore, newRestore *operatorv1alpha1.EtcdRestore) (admission.Warnings, error) {
+	if beingDeleted(newRestore) {
+		return nil, nil
+	}
+
+	allErrs := newErrors(validateEtcdRestore(newRestore), validateEtcdRestore(oldRestore))
+
+	// Switching snapshots halfway through a restore would leave the members inconsistent.
+	allErrs = append(allErrs, validateImmutable(field.NewPath("spec"), newRestore.Spec, oldRestore.Spec)...)
+
+	return nil, toError("EtcdRestore", newRestore.Name, allErrs)
+}
+
+func (v *EtcdRestoreValidator) ValidateDelete(_ context.Context, _ *operatorv1alpha1.EtcdRestore) (admission.Warnings, error) {
+	return nil, nil
+}
+
+func validateEtcdRestore(restore *operatorv1alpha1.EtcdRestore) field.ErrorList {
+	return validateRequiredReference(field.NewPath("spec", "snapshotRef"), &restore.Spec.SnapshotRef)
+}
+
+// validateTarget rejects restores into etcd clusters that are not managed by the operator. Snapshots
+// and targets that do not exist (yet) are left for the controller to report on.
+func (v *EtcdRestoreValidator) validateTarget(ctx context.Context, restore *operatorv1alpha1.EtcdRestore) (field.ErrorList, error) {
+	snapshot := &operatorv1alpha1.EtcdSnapshot{}
+	key := types.NamespacedName{Namespace: restore.Namespace, Name: restore.Spec.SnapshotRef.Name}
+	if err := v.Client.Get(ctx, key, snapshot); err != nil {
+		if ctrlruntimeclient.IgnoreNotFound(err) != nil {
+			return nil, fmt.Errorf("failed to get EtcdSnapshot %s: %w", key.Name, err)
+		}
+		return nil, nil
+	}
+
+	_, target, etcdConfig := util.FetchEtcdSnapshotTarget(ctx, v.Client, restore.Namespace, snapshot.Spec.Target)
+	if target == nil || etcdConfig.Managed != nil {
+		return nil, nil
+	}
+
+	return field.ErrorList{
+		field.Forbidden(field.NewPath("spec", "snapshotRef"), fmt.Sprintf("the etcd of %s, which EtcdSnapshot %s was taken from, is not managed by the operator; only operator-managed etcd clusters can be restored", target.GetName(), snapshot.Name)),
+	}, nil
+}
@@ -0,0 +1,103 @@
+/*
+Copyright 2026 The kcp Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package webhook
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	ctrlruntimefakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	operatorv1alpha1 "github.com/kcp-dev/kcp-operator/sdk/apis/operator/v1alpha1"
+)
+
+func TestEtcdRestoreValidator(t *testing.T) {
+	newSnapshot := func(name, shardName string) *operatorv1alpha1.EtcdSnapshot {
+		return &operatorv1alpha1.EtcdSnapshot{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "default"},
+			Spec: operatorv1alpha1.EtcdSnapshotSpec{
+				Target: operatorv1alpha1.EtcdSnapshotTarget{
+					ShardRef: &corev1.LocalObjectReference{Name: shardName},
+				},
+			},
+		}
+	}
+
+	newShard := func(name string, managed bool) *operatorv1alpha1.Shard {
+		shard := &operatorv1alpha1.Shard{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "default"},
+		}
+		if managed {
+			shard.Spec.Etcd.Managed = &operatorv1alpha1.ManagedEtcdSpec{}
+		} else {
+			shard.Spec.Etcd.Endpoints = []string{"https://etcd.example.com:2379"}
+		}
+		return shard
+	}
+
+	scheme := runtime.NewScheme()
+	require.Nil(t, operatorv1alpha1.AddToScheme(scheme))
+
+	client := ctrlruntimefakeclient.
+		NewClientBuilder().
+		WithScheme(scheme).
+		WithObjects(
+			newSnapshot("managed", "managed-shard"),
+			newShard("managed-shard", true),
+			newSnapshot("external", "external-shard"),
+			newShard("external-shard", false),
+			newSnapshot("orphaned", "missing-shard"),
+		).
+		Build()
+
+	testcases := []struct {
+		snapshot  string
+		expectErr bool
+	}{
+		{snapshot: "managed"},
+		{snapshot: "external", expectErr: true},
+		// missing objects are reported by the controller
+		{snapshot: "orphaned"},
+		{snapshot: "missing"},
+		{snapshot: "", expectErr: true},
+	}
+
+	validator := &EtcdRestoreValidator{Client: client}
+
+	for _, tc := range testcases {
+		t.Run(tc.snapshot, func(t *testing.T) {
+			restore := &operatorv1alpha1.EtcdRestore{
+				ObjectMeta: metav1.ObjectMeta{Name: "restore", Namespace: "default"},
+				Spec: operatorv1alpha1.EtcdRestoreSpec{
+					SnapshotRef: corev1.LocalObjectReference{Name: tc.snapshot},
+				},
+			}
+
+			_, err := validator.ValidateCreate(context.Background(), restore)
+			if tc.expectErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
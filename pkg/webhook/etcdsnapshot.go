@@ -0,0 +1,116 @@
+/*
+Copyright 2026 The kcp Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package webhook
+
+import (
+	"context"
+	"net/url"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	operatorv1alpha1 "github.com/kcp-dev/kcp-operator/sdk/apis/operator/v1alpha1"
+)
+
+// +kubebuilder:webhook:path=/validate-operator-kcp-io-v1alpha1-etcdsnapshot,mutating=false,failurePolicy=fail,sideEffects=None,groups=operator.kcp.io,resources=etcdsnapshots,verbs=create;update,versions=v1alpha1,name=vetcdsnapshot-v1alpha1.operator.kcp.io,admissionReviewVersions=v1
+
+// EtcdSnapshotValidator validates EtcdSnapshot objects.
+type EtcdSnapshotValidator struct{}
+
+var _ admission.Validator[*operatorv1alpha1.EtcdSnapshot] = &EtcdSnapshotValidator{}
+
+func (v *EtcdSnapshotValidator) ValidateCreate(_ context.Context, snapshot *operatorv1alpha1.EtcdSnapshot) (admission.Warnings, error) {
+	return nil, toError("EtcdSnapshot", snapshot.Name, validateEtcdSnapshot(snapshot))
+}
+
+func (v *EtcdSnapshotValidator) ValidateUpdate(_ context.Context, oldSnapshot, newSnapshot *operatorv1alpha1.EtcdSnapshot) (admission.Warnings, error) {
+	if beingDeleted(newSnapshot) {
+		return nil, nil
+	}
+
+	allErrs := newErrors(validateEtcdSnapshot(newSnapshot), validateEtcdSnapshot(oldSnapshot))
+
+	// A snapshot is taken exactly once.
+	allErrs = append(allErrs, validateImmutable(field.NewPath("spec"), newSnapshot.Spec, oldSnapshot.Spec)...)
+
+	return nil, toError("EtcdSnapshot", newSnapshot.Name, allErrs)
+}
+
+func (v *EtcdSnapshotValidator) ValidateDelete(_ context.Context, _ *operatorv1alpha1.EtcdSnapshot) (admission.Warnings, error) {
+	return nil, nil
+}
+
+func validateEtcdSnapshot(snapshot *operatorv1alpha1.EtcdSnapshot) field.ErrorList {
+	specPath := field.NewPath("spec")
+
+	allErrs := validateEtcdSnapshotTarget(specPath.Child("target"), snapshot.Spec.Target)
+	allErrs = append(allErrs, validateEtcdSnapshotStorage(specPath.Child("storage"), snapshot.Spec.Storage)...)
+
+	return allErrs
+}
+
+func validateEtcdSnapshotTarget(fldPath *field.Path, target operatorv1alpha1.EtcdSnapshotTarget) field.ErrorList {
+	var allErrs field.ErrorList
+
+	switch {
+	case target.RootShardRef != nil && target.ShardRef != nil:
+		allErrs = append(allErrs, field.Invalid(fldPath, target, "rootShardRef and shardRef are mutually exclusive"))
+	case target.RootShardRef == nil && target.ShardRef == nil:
+		allErrs = append(allErrs, field.Required(fldPath, "one of rootShardRef or shardRef must be set"))
+	}
+
+	allErrs = append(allErrs, validateOptionalReference(fldPath.Child("rootShardRef"), target.RootShardRef)...)
+	allErrs = append(allErrs, validateOptionalReference(fldPath.Child("shardRef"), target.ShardRef)...)
+
+	return allErrs
+}
+
+func validateEtcdSnapshotStorage(fldPath *field.Path, storage operatorv1alpha1.EtcdSnapshotStorage) field.ErrorList {
+	var allErrs field.ErrorList
+
+	switch {
+	case storage.PersistentVolumeClaim != nil && storage.S3 != nil:
+		allErrs = append(allErrs, field.Invalid(fldPath, storage, "persistentVolumeClaim and s3 are mutually exclusive"))
+	case storage.PersistentVolumeClaim == nil && storage.S3 == nil:
+		allErrs = append(allErrs, field.Required(fldPath, "one of persistentVolumeClaim or s3 must be set"))
+	}
+
+	if pvc := storage.PersistentVolumeClaim; pvc != nil && pvc.ClaimName == "" {
+		allErrs = append(allErrs, field.Required(fldPath.Child("persistentVolumeClaim", "claimName"), ""))
+	}
+
+	if s3 := storage.S3; s3 != nil {
+		s3Path := fldPath.Child("s3")
+
+		if u, err := url.Parse(s3.Endpoint); err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+			allErrs = append(allErrs, field.Invalid(s3Path.Child("endpoint"), s3.Endpoint, "must be an http or https URL"))
+		} else if strings.Trim(u.Path, "/") != "" || u.RawQuery != "" || u.User != nil {
+			allErrs = append(allErrs, field.Invalid(s3Path.Child("endpoint"), s3.Endpoint, "must not contain a path, query or credentials"))
+		}
+
+		if s3.Bucket == "" {
+			allErrs = append(allErrs, field.Required(s3Path.Child("bucket"), ""))
+		}
+
+		if s3.CredentialsSecretRef.Name == "" {
+			allErrs = append(allErrs, field.Required(s3Path.Child("credentialsSecretRef", "name"), ""))
+		}
+	}
+
+	return allErrs
+}
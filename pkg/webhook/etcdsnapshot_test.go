@@ -0,0 +1,63 @@
+/*
+Copyright 2026 The kcp Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package webhook
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	operatorv1alpha1 "github.com/kcp-dev/kcp-operator/sdk/apis/operator/v1alpha1"
+)
+
+func TestEtcdSnapshotValidator(t *testing.T) {
+	snapshot := &operatorv1alpha1.EtcdSnapshot{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "snappy",
+			Namespace: "default",
+		},
+		Spec: operatorv1alpha1.EtcdSnapshotSpec{
+			Target: operatorv1alpha1.EtcdSnapshotTarget{
+				ShardRef: &corev1.LocalObjectReference{Name: "shardy"},
+			},
+			Storage: operatorv1alpha1.EtcdSnapshotStorage{
+				PersistentVolumeClaim: &operatorv1alpha1.PersistentVolumeClaimSnapshotStorage{ClaimName: "backups"},
+			},
+		},
+	}
+
+	validator := &EtcdSnapshotValidator{}
+
+	_, err := validator.ValidateCreate(context.Background(), snapshot)
+	require.NoError(t, err)
+
+	updated := snapshot.DeepCopy()
+	updated.Spec.Storage.PersistentVolumeClaim.ClaimName = "other"
+
+	_, err = validator.ValidateUpdate(context.Background(), snapshot, updated)
+	require.Error(t, err)
+
+	invalid := snapshot.DeepCopy()
+	invalid.Spec.Storage.PersistentVolumeClaim.ClaimName = ""
+
+	_, err = validator.ValidateCreate(context.Background(), invalid)
+	require.Error(t, err)
+}
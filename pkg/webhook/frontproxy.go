@@ -0,0 +1,69 @@
+/*
+Copyright 2026 The kcp Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package webhook
+
+import (
+	"context"
+
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	operatorv1alpha1 "github.com/kcp-dev/kcp-operator/sdk/apis/operator/v1alpha1"
+)
+
+// +kubebuilder:webhook:path=/validate-operator-kcp-io-v1alpha1-frontproxy,mutating=false,failurePolicy=fail,sideEffects=None,groups=operator.kcp.io,resources=frontproxies,verbs=create;update,versions=v1alpha1,name=vfrontproxy-v1alpha1.operator.kcp.io,admissionReviewVersions=v1
+
+// FrontProxyValidator validates FrontProxy objects.
+type FrontProxyValidator struct{}
+
+var _ admission.Validator[*operatorv1alpha1.FrontProxy] = &FrontProxyValidator{}
+
+func (v *FrontProxyValidator) ValidateCreate(_ context.Context, frontProxy *operatorv1alpha1.FrontProxy) (admission.Warnings, error) {
+	return nil, toError("FrontProxy", frontProxy.Name, validateFrontProxy(frontProxy))
+}
+
+func (v *FrontProxyValidator) ValidateUpdate(_ context.Context, oldFrontProxy, newFrontProxy *operatorv1alpha1.FrontProxy) (admission.Warnings, error) {
+	if beingDeleted(newFrontProxy) {
+		return nil, nil
+	}
+
+	allErrs := newErrors(validateFrontProxy(newFrontProxy), validateFrontProxy(oldFrontProxy))
+	allErrs = append(allErrs, validateImmutable(field.NewPath("spec", "rootShard"), newFrontProxy.Spec.RootShard, oldFrontProxy.Spec.RootShard)...)
+
+	return nil, toError("FrontProxy", newFrontProxy.Name, allErrs)
+}
+
+func (v *FrontProxyValidator) ValidateDelete(_ context.Context, _ *operatorv1alpha1.FrontProxy) (admission.Warnings, error) {
+	return nil, nil
+}
+
+func validateFrontProxy(frontProxy *operatorv1alpha1.FrontProxy) field.ErrorList {
+	specPath := field.NewPath("spec")
+	spec := frontProxy.Spec
+
+	allErrs := validateRequiredReference(specPath.Child("rootShard", "ref"), spec.RootShard.Reference)
+	allErrs = append(allErrs, validateExternal(specPath.Child("external"), spec.External, false)...)
+	allErrs = append(allErrs, validateCABundle(specPath, spec.CABundleSecretRef, spec.CABundleConfigMapRef)...)
+	allErrs = append(allErrs, validateOptionalReference(specPath.Child("clientCABundleRef"), spec.ClientCABundleRef)...)
+	allErrs = append(allErrs, validateExtraArgs(specPath.Child("extraArgs"), spec.ExtraArgs, frontProxyManagedFlags)...)
+
+	if spec.Replicas != nil && *spec.Replicas < 0 {
+		allErrs = append(allErrs, field.Invalid(specPath.Child("replicas"), *spec.Replicas, "must not be negative"))
+	}
+
+	return allErrs
+}
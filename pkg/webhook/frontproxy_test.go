@@ -0,0 +1,95 @@
+/*
+Copyright 2026 The kcp Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package webhook
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	operatorv1alpha1 "github.com/kcp-dev/kcp-operator/sdk/apis/operator/v1alpha1"
+)
+
+func newFrontProxy() *operatorv1alpha1.FrontProxy {
+	return &operatorv1alpha1.FrontProxy{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "proxy",
+			Namespace: "default",
+		},
+		Spec: operatorv1alpha1.FrontProxySpec{
+			RootShard: operatorv1alpha1.RootShardConfig{
+				Reference: &corev1.LocalObjectReference{Name: "rooty"},
+			},
+		},
+	}
+}
+
+func TestFrontProxyValidator(t *testing.T) {
+	testcases := []struct {
+		name    string
+		mutate  func(*operatorv1alpha1.FrontProxy)
+		invalid bool
+	}{
+		{
+			name:   "valid",
+			mutate: func(*operatorv1alpha1.FrontProxy) {},
+		},
+		{
+			name: "missing rootShard.ref",
+			mutate: func(fp *operatorv1alpha1.FrontProxy) {
+				fp.Spec.RootShard.Reference = nil
+			},
+			invalid: true,
+		},
+		{
+			name: "overriding a managed flag",
+			mutate: func(fp *operatorv1alpha1.FrontProxy) {
+				fp.Spec.ExtraArgs = []string{"--shards-kubeconfig=/tmp/kubeconfig"}
+			},
+			invalid: true,
+		},
+	}
+
+	validator := &FrontProxyValidator{}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			frontProxy := newFrontProxy()
+			tc.mutate(frontProxy)
+
+			_, err := validator.ValidateCreate(context.Background(), frontProxy)
+			if tc.invalid {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestFrontProxyValidatorImmutableRootShard(t *testing.T) {
+	oldFrontProxy := newFrontProxy()
+	newFrontProxy := oldFrontProxy.DeepCopy()
+	newFrontProxy.Spec.RootShard.Reference.Name = "other"
+
+	_, err := (&FrontProxyValidator{}).ValidateUpdate(context.Background(), oldFrontProxy, newFrontProxy)
+	require.Error(t, err)
+}
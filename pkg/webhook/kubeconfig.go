@@ -0,0 +1,96 @@
+/*
+Copyright 2026 The kcp Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package webhook
+
+import (
+	"context"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	operatorv1alpha1 "github.com/kcp-dev/kcp-operator/sdk/apis/operator/v1alpha1"
+)
+
+// +kubebuilder:webhook:path=/validate-operator-kcp-io-v1alpha1-kubeconfig,mutating=false,failurePolicy=fail,sideEffects=None,groups=operator.kcp.io,resources=kubeconfigs,verbs=create;update,versions=v1alpha1,name=vkubeconfig-v1alpha1.operator.kcp.io,admissionReviewVersions=v1
+
+// KubeconfigValidator validates Kubeconfig objects.
+type KubeconfigValidator struct{}
+
+var _ admission.Validator[*operatorv1alpha1.Kubeconfig] = &KubeconfigValidator{}
+
+func (v *KubeconfigValidator) ValidateCreate(_ context.Context, kc *operatorv1alpha1.Kubeconfig) (admission.Warnings, error) {
+	return nil, toError("Kubeconfig", kc.Name, validateKubeconfig(kc))
+}
+
+func (v *KubeconfigValidator) ValidateUpdate(_ context.Context, oldKC, newKC *operatorv1alpha1.Kubeconfig) (admission.Warnings, error) {
+	if beingDeleted(newKC) {
+		return nil, nil
+	}
+
+	allErrs := newErrors(validateKubeconfig(newKC), validateKubeconfig(oldKC))
+
+	// The client certificate is issued by the target's CA, so retargeting would leave
+	// the existing Secret with a certificate that is not trusted anymore.
+	allErrs = append(allErrs, validateImmutable(field.NewPath("spec", "target"), newKC.Spec.Target, oldKC.Spec.Target)...)
+
+	return nil, toError("Kubeconfig", newKC.Name, allErrs)
+}
+
+func (v *KubeconfigValidator) ValidateDelete(_ context.Context, _ *operatorv1alpha1.Kubeconfig) (admission.Warnings, error) {
+	return nil, nil
+}
+
+func validateKubeconfig(kc *operatorv1alpha1.Kubeconfig) field.ErrorList {
+	specPath := field.NewPath("spec")
+	spec := kc.Spec
+
+	var allErrs field.ErrorList
+
+	targetPath := specPath.Child("target")
+	targets := 0
+	for _, ref := range []*corev1.LocalObjectReference{spec.Target.RootShardRef, spec.Target.ShardRef, spec.Target.FrontProxyRef} {
+		if ref != nil {
+			targets++
+		}
+	}
+
+	switch {
+	case targets > 1:
+		allErrs = append(allErrs, field.Invalid(targetPath, spec.Target, "rootShardRef, shardRef and frontProxyRef are mutually exclusive"))
+	case targets == 0:
+		allErrs = append(allErrs, field.Required(targetPath, "one of rootShardRef, shardRef or frontProxyRef must be set"))
+	}
+
+	allErrs = append(allErrs, validateOptionalReference(targetPath.Child("rootShardRef"), spec.Target.RootShardRef)...)
+	allErrs = append(allErrs, validateOptionalReference(targetPath.Child("shardRef"), spec.Target.ShardRef)...)
+	allErrs = append(allErrs, validateOptionalReference(targetPath.Child("frontProxyRef"), spec.Target.FrontProxyRef)...)
+
+	if spec.Username == "" {
+		allErrs = append(allErrs, field.Required(specPath.Child("username"), ""))
+	}
+
+	if spec.Validity.Duration <= 0 {
+		allErrs = append(allErrs, field.Invalid(specPath.Child("validity"), spec.Validity.Duration.String(), "must be a positive duration"))
+	}
+
+	if spec.SecretRef.Name == "" {
+		allErrs = append(allErrs, field.Required(specPath.Child("secretRef", "name"), ""))
+	}
+
+	return allErrs
+}
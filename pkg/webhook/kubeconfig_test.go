@@ -0,0 +1,130 @@
+/*
+Copyright 2026 The kcp Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package webhook
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	operatorv1alpha1 "github.com/kcp-dev/kcp-operator/sdk/apis/operator/v1alpha1"
+)
+
+func newKubeconfig() *operatorv1alpha1.Kubeconfig {
+	return &operatorv1alpha1.Kubeconfig{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "confy",
+			Namespace: "default",
+		},
+		Spec: operatorv1alpha1.KubeconfigSpec{
+			Username: "admin",
+			Validity: metav1.Duration{Duration: 24 * time.Hour},
+			SecretRef: corev1.LocalObjectReference{
+				Name: "confy-secret",
+			},
+			Target: operatorv1alpha1.KubeconfigTarget{
+				RootShardRef: &corev1.LocalObjectReference{Name: "rooty"},
+			},
+		},
+	}
+}
+
+func TestKubeconfigValidator(t *testing.T) {
+	testcases := []struct {
+		name    string
+		mutate  func(*operatorv1alpha1.Kubeconfig)
+		invalid bool
+	}{
+		{
+			name:   "valid",
+			mutate: func(*operatorv1alpha1.Kubeconfig) {},
+		},
+		{
+			name: "both rootShardRef and frontProxyRef",
+			mutate: func(kc *operatorv1alpha1.Kubeconfig) {
+				kc.Spec.Target.FrontProxyRef = &corev1.LocalObjectReference{Name: "proxy"}
+			},
+			invalid: true,
+		},
+		{
+			name: "no target",
+			mutate: func(kc *operatorv1alpha1.Kubeconfig) {
+				kc.Spec.Target.RootShardRef = nil
+			},
+			invalid: true,
+		},
+		{
+			name: "missing username",
+			mutate: func(kc *operatorv1alpha1.Kubeconfig) {
+				kc.Spec.Username = ""
+			},
+			invalid: true,
+		},
+		{
+			name: "zero validity",
+			mutate: func(kc *operatorv1alpha1.Kubeconfig) {
+				kc.Spec.Validity = metav1.Duration{}
+			},
+			invalid: true,
+		},
+		{
+			name: "missing secret name",
+			mutate: func(kc *operatorv1alpha1.Kubeconfig) {
+				kc.Spec.SecretRef.Name = ""
+			},
+			invalid: true,
+		},
+	}
+
+	validator := &KubeconfigValidator{}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			kc := newKubeconfig()
+			tc.mutate(kc)
+
+			_, err := validator.ValidateCreate(context.Background(), kc)
+			if tc.invalid {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestKubeconfigValidatorImmutableTarget(t *testing.T) {
+	oldKC := newKubeconfig()
+	newKC := oldKC.DeepCopy()
+	newKC.Spec.Target = operatorv1alpha1.KubeconfigTarget{
+		ShardRef: &corev1.LocalObjectReference{Name: "shardy"},
+	}
+
+	_, err := (&KubeconfigValidator{}).ValidateUpdate(context.Background(), oldKC, newKC)
+	require.Error(t, err)
+
+	newKC = oldKC.DeepCopy()
+	newKC.Spec.Validity = metav1.Duration{Duration: time.Hour}
+
+	_, err = (&KubeconfigValidator{}).ValidateUpdate(context.Background(), oldKC, newKC)
+	require.NoError(t, err)
+}
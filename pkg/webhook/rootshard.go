@@ -0,0 +1,112 @@
+/*
+Copyright 2026 The kcp Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package webhook
+
+import (
+	"context"
+
+	metav1validation "k8s.io/apimachinery/pkg/apis/meta/v1/validation"
+	"k8s.io/apimachinery/pkg/util/validation"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	operatorv1alpha1 "github.com/kcp-dev/kcp-operator/sdk/apis/operator/v1alpha1"
+)
+
+// +kubebuilder:webhook:path=/validate-operator-kcp-io-v1alpha1-rootshard,mutating=false,failurePolicy=fail,sideEffects=None,groups=operator.kcp.io,resources=rootshards,verbs=create;update,versions=v1alpha1,name=vrootshard-v1alpha1.operator.kcp.io,admissionReviewVersions=v1
+
+// RootShardValidator validates RootShard objects.
+type RootShardValidator struct{}
+
+var _ admission.Validator[*operatorv1alpha1.RootShard] = &RootShardValidator{}
+
+func (v *RootShardValidator) ValidateCreate(_ context.Context, rootShard *operatorv1alpha1.RootShard) (admission.Warnings, error) {
+	return nil, toError("RootShard", rootShard.Name, validateRootShard(rootShard))
+}
+
+func (v *RootShardValidator) ValidateUpdate(_ context.Context, oldRootShard, newRootShard *operatorv1alpha1.RootShard) (admission.Warnings, error) {
+	if beingDeleted(newRootShard) {
+		return nil, nil
+	}
+
+	allErrs := newErrors(validateRootShard(newRootShard), validateRootShard(oldRootShard))
+
+	// Switching the CA would invalidate every certificate issued for the shards, proxies
+	// and kubeconfigs belonging to this RootShard.
+	allErrs = append(allErrs, validateImmutable(field.NewPath("spec", "certificates"), newRootShard.Spec.Certificates, oldRootShard.Spec.Certificates)...)
+	allErrs = append(allErrs, validateEtcdUpdate(field.NewPath("spec", "etcd"), newRootShard.Spec.Etcd, oldRootShard.Spec.Etcd)...)
+
+	return nil, toError("RootShard", newRootShard.Name, allErrs)
+}
+
+func (v *RootShardValidator) ValidateDelete(_ context.Context, _ *operatorv1alpha1.RootShard) (admission.Warnings, error) {
+	return nil, nil
+}
+
+func validateRootShard(rootShard *operatorv1alpha1.RootShard) field.ErrorList {
+	specPath := field.NewPath("spec")
+	spec := rootShard.Spec
+
+	allErrs := validateCommonShardSpec(specPath, &spec.CommonShardSpec)
+	allErrs = append(allErrs, validateExternal(specPath.Child("external"), spec.External, true)...)
+	allErrs = append(allErrs, validateCertificates(specPath.Child("certificates"), spec.Certificates)...)
+	allErrs = append(allErrs, validateTrustBundle(specPath.Child("trustBundle"), spec.TrustBundle)...)
+
+	cachePath := specPath.Child("cache")
+	if spec.Cache.Embedded != nil && spec.Cache.Embedded.Enabled && spec.Cache.Reference != nil {
+		allErrs = append(allErrs, field.Invalid(cachePath, spec.Cache, "embedded and ref are mutually exclusive"))
+	}
+	allErrs = append(allErrs, validateOptionalReference(cachePath.Child("ref"), spec.Cache.Reference)...)
+
+	if proxy := spec.Proxy; proxy != nil {
+		proxyPath := specPath.Child("proxy")
+
+		if proxy.Replicas != nil && *proxy.Replicas < 0 {
+			allErrs = append(allErrs, field.Invalid(proxyPath.Child("replicas"), *proxy.Replicas, "must not be negative"))
+		}
+
+		allErrs = append(allErrs, validateExtraArgs(proxyPath.Child("extraArgs"), proxy.ExtraArgs, frontProxyManagedFlags)...)
+	}
+
+	return allErrs
+}
+
+func validateTrustBundle(fldPath *field.Path, trustBundle *operatorv1alpha1.TrustBundleSpec) field.ErrorList {
+	if trustBundle == nil {
+		return nil
+	}
+
+	var allErrs field.ErrorList
+
+	if trustBundle.Name != "" {
+		for _, msg := range validation.IsDNS1123Subdomain(trustBundle.Name) {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("name"), trustBundle.Name, msg))
+		}
+	}
+
+	if trustBundle.Key != "" {
+		for _, msg := range validation.IsConfigMapKey(trustBundle.Key) {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("key"), trustBundle.Key, msg))
+		}
+	}
+
+	if trustBundle.NamespaceSelector != nil {
+		allErrs = append(allErrs, metav1validation.ValidateLabelSelector(trustBundle.NamespaceSelector, metav1validation.LabelSelectorValidationOptions{}, fldPath.Child("namespaceSelector"))...)
+	}
+
+	return allErrs
+}
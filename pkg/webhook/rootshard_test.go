@@ -0,0 +1,184 @@
+/*
+Copyright 2026 The kcp Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package webhook
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	operatorv1alpha1 "github.com/kcp-dev/kcp-operator/sdk/apis/operator/v1alpha1"
+)
+
+func newRootShard() *operatorv1alpha1.RootShard {
+	return &operatorv1alpha1.RootShard{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "rooty",
+			Namespace: "default",
+		},
+		Spec: operatorv1alpha1.RootShardSpec{
+			External: operatorv1alpha1.ExternalConfig{
+				Hostname: "example.kcp.io",
+				Port:     6443,
+			},
+			CommonShardSpec: operatorv1alpha1.CommonShardSpec{
+				Etcd: operatorv1alpha1.EtcdConfig{
+					Endpoints: []string{"https://localhost:2379"},
+				},
+			},
+			Certificates: operatorv1alpha1.Certificates{
+				IssuerRef: &operatorv1alpha1.ObjectReference{
+					Name: "issuer",
+				},
+			},
+		},
+	}
+}
+
+func TestRootShardValidator(t *testing.T) {
+	testcases := []struct {
+		name    string
+		mutate  func(*operatorv1alpha1.RootShard)
+		invalid bool
+	}{
+		{
+			name:   "valid",
+			mutate: func(*operatorv1alpha1.RootShard) {},
+		},
+		{
+			name: "both issuerRef and caSecretRef",
+			mutate: func(rs *operatorv1alpha1.RootShard) {
+				rs.Spec.Certificates.CASecretRef = &corev1.LocalObjectReference{Name: "ca"}
+			},
+			invalid: true,
+		},
+		{
+			name: "neither issuerRef nor caSecretRef",
+			mutate: func(rs *operatorv1alpha1.RootShard) {
+				rs.Spec.Certificates.IssuerRef = nil
+			},
+			invalid: true,
+		},
+		{
+			name: "missing etcd endpoints",
+			mutate: func(rs *operatorv1alpha1.RootShard) {
+				rs.Spec.Etcd.Endpoints = nil
+			},
+			invalid: true,
+		},
+		{
+			name: "missing external hostname",
+			mutate: func(rs *operatorv1alpha1.RootShard) {
+				rs.Spec.External.Hostname = ""
+			},
+			invalid: true,
+		},
+		{
+			name: "embedded and external cache",
+			mutate: func(rs *operatorv1alpha1.RootShard) {
+				rs.Spec.Cache.Embedded = &operatorv1alpha1.EmbeddedCacheConfiguration{Enabled: true}
+				rs.Spec.Cache.Reference = &corev1.LocalObjectReference{Name: "cache"}
+			},
+			invalid: true,
+		},
+		{
+			name: "overriding a managed flag",
+			mutate: func(rs *operatorv1alpha1.RootShard) {
+				rs.Spec.ExtraArgs = []string{"--root-ca-file=/tmp/ca.crt"}
+			},
+			invalid: true,
+		},
+		{
+			name: "CA bundle from a ConfigMap",
+			mutate: func(rs *operatorv1alpha1.RootShard) {
+				rs.Spec.CABundleConfigMapRef = &operatorv1alpha1.LocalDataKeyReference{Name: "kcp-ca", Key: "ca.crt"}
+			},
+		},
+		{
+			name: "CA bundle from both a Secret and a ConfigMap",
+			mutate: func(rs *operatorv1alpha1.RootShard) {
+				rs.Spec.CABundleSecretRef = &corev1.LocalObjectReference{Name: "kcp-ca"}
+				rs.Spec.CABundleConfigMapRef = &operatorv1alpha1.LocalDataKeyReference{Name: "kcp-ca", Key: "ca.crt"}
+			},
+			invalid: true,
+		},
+		{
+			name: "CA bundle ConfigMap without key",
+			mutate: func(rs *operatorv1alpha1.RootShard) {
+				rs.Spec.CABundleConfigMapRef = &operatorv1alpha1.LocalDataKeyReference{Name: "kcp-ca"}
+			},
+			invalid: true,
+		},
+		{
+			name: "trust bundle",
+			mutate: func(rs *operatorv1alpha1.RootShard) {
+				rs.Spec.TrustBundle = &operatorv1alpha1.TrustBundleSpec{
+					Name: "kcp-ca",
+					Key:  "ca.crt",
+					NamespaceSelector: &metav1.LabelSelector{
+						MatchLabels: map[string]string{"kcp.io/trust": "true"},
+					},
+				}
+			},
+		},
+		{
+			name: "trust bundle with invalid key",
+			mutate: func(rs *operatorv1alpha1.RootShard) {
+				rs.Spec.TrustBundle = &operatorv1alpha1.TrustBundleSpec{Key: "ca/crt"}
+			},
+			invalid: true,
+		},
+		{
+			name: "overriding a managed proxy flag",
+			mutate: func(rs *operatorv1alpha1.RootShard) {
+				rs.Spec.Proxy = &operatorv1alpha1.RootShardProxySpec{
+					ExtraArgs: []string{"--mapping-file=/tmp/mapping.yaml"},
+				}
+			},
+			invalid: true,
+		},
+	}
+
+	validator := &RootShardValidator{}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			rootShard := newRootShard()
+			tc.mutate(rootShard)
+
+			_, err := validator.ValidateCreate(context.Background(), rootShard)
+			if tc.invalid {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestRootShardValidatorImmutableCertificates(t *testing.T) {
+	oldRootShard := newRootShard()
+	newRootShard := oldRootShard.DeepCopy()
+	newRootShard.Spec.Certificates.IssuerRef.Name = "other-issuer"
+
+	_, err := (&RootShardValidator{}).ValidateUpdate(context.Background(), oldRootShard, newRootShard)
+	require.Error(t, err)
+}
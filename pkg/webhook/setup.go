@@ -0,0 +1,68 @@
+/*
+Copyright 2026 The kcp Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package webhook
+
+import (
+	"fmt"
+
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+
+	operatorv1alpha1 "github.com/kcp-dev/kcp-operator/sdk/apis/operator/v1alpha1"
+)
+
+// AddWebhooks registers the validating admission webhooks for all operator.kcp.io kinds
+// with the webhook server of the given manager.
+func AddWebhooks(mgr manager.Manager) error {
+	if err := ctrl.NewWebhookManagedBy(mgr, &operatorv1alpha1.RootShard{}).WithValidator(&RootShardValidator{}).Complete(); err != nil {
+		return fmt.Errorf("unable to create RootShard webhook: %w", err)
+	}
+
+	if err := ctrl.NewWebhookManagedBy(mgr, &operatorv1alpha1.Shard{}).WithValidator(&ShardValidator{}).Complete(); err != nil {
+		return fmt.Errorf("unable to create Shard webhook: %w", err)
+	}
+
+	if err := ctrl.NewWebhookManagedBy(mgr, &operatorv1alpha1.FrontProxy{}).WithValidator(&FrontProxyValidator{}).Complete(); err != nil {
+		return fmt.Errorf("unable to create FrontProxy webhook: %w", err)
+	}
+
+	if err := ctrl.NewWebhookManagedBy(mgr, &operatorv1alpha1.CacheServer{}).WithValidator(&CacheServerValidator{}).Complete(); err != nil {
+		return fmt.Errorf("unable to create CacheServer webhook: %w", err)
+	}
+
+	if err := ctrl.NewWebhookManagedBy(mgr, &operatorv1alpha1.VirtualWorkspace{}).WithValidator(&VirtualWorkspaceValidator{}).Complete(); err != nil {
+		return fmt.Errorf("unable to create VirtualWorkspace webhook: %w", err)
+	}
+
+	if err := ctrl.NewWebhookManagedBy(mgr, &operatorv1alpha1.Kubeconfig{}).WithValidator(&KubeconfigValidator{}).Complete(); err != nil {
+		return fmt.Errorf("unable to create Kubeconfig webhook: %w", err)
+	}
+
+	if err := ctrl.NewWebhookManagedBy(mgr, &operatorv1alpha1.EtcdSnapshot{}).WithValidator(&EtcdSnapshotValidator{}).Complete(); err != nil {
+		return fmt.Errorf("unable to create EtcdSnapshot webhook: %w", err)
+	}
+
+	if err := ctrl.NewWebhookManagedBy(mgr, &operatorv1alpha1.EtcdBackupSchedule{}).WithValidator(&EtcdBackupScheduleValidator{}).Complete(); err != nil {
+		return fmt.Errorf("unable to create EtcdBackupSchedule webhook: %w", err)
+	}
+
+	if err := ctrl.NewWebhookManagedBy(mgr, &operatorv1alpha1.EtcdRestore{}).WithValidator(&EtcdRestoreValidator{Client: mgr.GetClient()}).Complete(); err != nil {
+		return fmt.Errorf("unable to create EtcdRestore webhook: %w", err)
+	}
+
+	return nil
+}
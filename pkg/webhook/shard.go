@@ -0,0 +1,68 @@
+/*
+Copyright 2026 The kcp Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package webhook
+
+import (
+	"context"
+
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	operatorv1alpha1 "github.com/kcp-dev/kcp-operator/sdk/apis/operator/v1alpha1"
+)
+
+// +kubebuilder:webhook:path=/validate-operator-kcp-io-v1alpha1-shard,mutating=false,failurePolicy=fail,sideEffects=None,groups=operator.kcp.io,resources=shards,verbs=create;update,versions=v1alpha1,name=vshard-v1alpha1.operator.kcp.io,admissionReviewVersions=v1
+
+// ShardValidator validates Shard objects.
+type ShardValidator struct{}
+
+var _ admission.Validator[*operatorv1alpha1.Shard] = &ShardValidator{}
+
+func (v *ShardValidator) ValidateCreate(_ context.Context, shard *operatorv1alpha1.Shard) (admission.Warnings, error) {
+	return nil, toError("Shard", shard.Name, validateShard(shard))
+}
+
+func (v *ShardValidator) ValidateUpdate(_ context.Context, oldShard, newShard *operatorv1alpha1.Shard) (admission.Warnings, error) {
+	if beingDeleted(newShard) {
+		return nil, nil
+	}
+
+	allErrs := newErrors(validateShard(newShard), validateShard(oldShard))
+
+	// A shard cannot be moved to another kcp installation.
+	allErrs = append(allErrs, validateImmutable(field.NewPath("spec", "rootShard"), newShard.Spec.RootShard, oldShard.Spec.RootShard)...)
+	allErrs = append(allErrs, validateEtcdUpdate(field.NewPath("spec", "etcd"), newShard.Spec.Etcd, oldShard.Spec.Etcd)...)
+
+	return nil, toError("Shard", newShard.Name, allErrs)
+}
+
+func (v *ShardValidator) ValidateDelete(_ context.Context, _ *operatorv1alpha1.Shard) (admission.Warnings, error) {
+	return nil, nil
+}
+
+func validateShard(shard *operatorv1alpha1.Shard) field.ErrorList {
+	specPath := field.NewPath("spec")
+
+	allErrs := validateCommonShardSpec(specPath, &shard.Spec.CommonShardSpec)
+	allErrs = append(allErrs, validateRequiredReference(specPath.Child("rootShard", "ref"), shard.Spec.RootShard.Reference)...)
+
+	if shard.Spec.Cache != nil {
+		allErrs = append(allErrs, validateOptionalReference(specPath.Child("cache", "ref"), shard.Spec.Cache.Reference)...)
+	}
+
+	return allErrs
+}
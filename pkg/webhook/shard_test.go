@@ -0,0 +1,209 @@
+/*
+Copyright 2026 The kcp Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package webhook
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+
+	operatorv1alpha1 "github.com/kcp-dev/kcp-operator/sdk/apis/operator/v1alpha1"
+)
+
+func newShard() *operatorv1alpha1.Shard {
+	return &operatorv1alpha1.Shard{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "shardy",
+			Namespace: "default",
+		},
+		Spec: operatorv1alpha1.ShardSpec{
+			RootShard: operatorv1alpha1.RootShardConfig{
+				Reference: &corev1.LocalObjectReference{Name: "rooty"},
+			},
+			CommonShardSpec: operatorv1alpha1.CommonShardSpec{
+				Etcd: operatorv1alpha1.EtcdConfig{
+					Endpoints: []string{"https://localhost:2379"},
+				},
+			},
+		},
+	}
+}
+
+func TestShardValidator(t *testing.T) {
+	testcases := []struct {
+		name    string
+		mutate  func(*operatorv1alpha1.Shard)
+		invalid bool
+	}{
+		{
+			name:   "valid",
+			mutate: func(*operatorv1alpha1.Shard) {},
+		},
+		{
+			name: "missing rootShard.ref",
+			mutate: func(s *operatorv1alpha1.Shard) {
+				s.Spec.RootShard.Reference = nil
+			},
+			invalid: true,
+		},
+		{
+			name: "empty rootShard.ref",
+			mutate: func(s *operatorv1alpha1.Shard) {
+				s.Spec.RootShard.Reference.Name = ""
+			},
+			invalid: true,
+		},
+		{
+			name: "overriding a managed flag",
+			mutate: func(s *operatorv1alpha1.Shard) {
+				s.Spec.ExtraArgs = []string{"--v=4", "--shard-base-url=https://example.com"}
+			},
+			invalid: true,
+		},
+		{
+			name: "extra args",
+			mutate: func(s *operatorv1alpha1.Shard) {
+				s.Spec.ExtraArgs = []string{"--v=4", "--feature-gates=WorkspaceMounts=true"}
+			},
+		},
+		{
+			name: "managed etcd",
+			mutate: func(s *operatorv1alpha1.Shard) {
+				s.Spec.Etcd = operatorv1alpha1.EtcdConfig{
+					Managed: &operatorv1alpha1.ManagedEtcdSpec{Replicas: ptr.To[int32](3)},
+				}
+			},
+		},
+		{
+			name: "managed etcd combined with endpoints",
+			mutate: func(s *operatorv1alpha1.Shard) {
+				s.Spec.Etcd.Managed = &operatorv1alpha1.ManagedEtcdSpec{}
+			},
+			invalid: true,
+		},
+		{
+			name: "managed etcd with an even number of members",
+			mutate: func(s *operatorv1alpha1.Shard) {
+				s.Spec.Etcd = operatorv1alpha1.EtcdConfig{
+					Managed: &operatorv1alpha1.ManagedEtcdSpec{Replicas: ptr.To[int32](2)},
+				}
+			},
+			invalid: true,
+		},
+	}
+
+	validator := &ShardValidator{}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			shard := newShard()
+			tc.mutate(shard)
+
+			_, err := validator.ValidateCreate(context.Background(), shard)
+			if tc.invalid {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestShardValidatorImmutableRootShard(t *testing.T) {
+	oldShard := newShard()
+	newShard := oldShard.DeepCopy()
+	newShard.Spec.RootShard.Reference.Name = "other"
+
+	_, err := (&ShardValidator{}).ValidateUpdate(context.Background(), oldShard, newShard)
+	require.Error(t, err)
+
+	newShard = oldShard.DeepCopy()
+	newShard.Spec.ExtraArgs = []string{"--v=6"}
+
+	_, err = (&ShardValidator{}).ValidateUpdate(context.Background(), oldShard, newShard)
+	require.NoError(t, err)
+}
+
+func TestShardValidatorUpdateExistingInvalidShard(t *testing.T) {
+	// the shard predates the webhook and overrides a flag managed by the operator
+	oldShard := newShard()
+	oldShard.Spec.ExtraArgs = []string{"--shard-name=legacy"}
+
+	// unrelated changes are still possible
+	newShard := oldShard.DeepCopy()
+	newShard.Spec.Replicas = ptr.To[int32](2)
+
+	_, err := (&ShardValidator{}).ValidateUpdate(context.Background(), oldShard, newShard)
+	require.NoError(t, err)
+
+	// so is fixing it
+	newShard = oldShard.DeepCopy()
+	newShard.Spec.ExtraArgs = nil
+
+	_, err = (&ShardValidator{}).ValidateUpdate(context.Background(), oldShard, newShard)
+	require.NoError(t, err)
+
+	// but changed fields are validated
+	newShard = oldShard.DeepCopy()
+	newShard.Spec.ExtraArgs = []string{"--shard-name=legacy", "--etcd-servers=https://etcd:2379"}
+
+	_, err = (&ShardValidator{}).ValidateUpdate(context.Background(), oldShard, newShard)
+	require.Error(t, err)
+}
+
+func TestShardValidatorUpdateDeletingShard(t *testing.T) {
+	oldShard := newShard()
+	oldShard.Spec.RootShard.Reference = nil
+	oldShard.DeletionTimestamp = ptr.To(metav1.Now())
+	oldShard.Finalizers = []string{"operator.kcp.io/cleanup-shard"}
+
+	newShard := oldShard.DeepCopy()
+	newShard.Finalizers = nil
+
+	_, err := (&ShardValidator{}).ValidateUpdate(context.Background(), oldShard, newShard)
+	require.NoError(t, err)
+}
+
+func TestShardValidatorManagedEtcdUpdate(t *testing.T) {
+	oldShard := newShard()
+	oldShard.Spec.Etcd = operatorv1alpha1.EtcdConfig{
+		Managed: &operatorv1alpha1.ManagedEtcdSpec{Replicas: ptr.To[int32](3)},
+	}
+
+	newShard := oldShard.DeepCopy()
+	newShard.Spec.Etcd.Managed.Replicas = ptr.To[int32](5)
+
+	_, err := (&ShardValidator{}).ValidateUpdate(context.Background(), oldShard, newShard)
+	require.Error(t, err)
+
+	newShard = oldShard.DeepCopy()
+	newShard.Spec.Etcd = operatorv1alpha1.EtcdConfig{Endpoints: []string{"https://localhost:2379"}}
+
+	_, err = (&ShardValidator{}).ValidateUpdate(context.Background(), oldShard, newShard)
+	require.Error(t, err)
+
+	newShard = oldShard.DeepCopy()
+	newShard.Spec.Etcd.Managed.Resources = &corev1.ResourceRequirements{}
+
+	_, err = (&ShardValidator{}).ValidateUpdate(context.Background(), oldShard, newShard)
+	require.NoError(t, err)
+}
@@ -0,0 +1,329 @@
+/*
+Copyright 2026 The kcp Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package webhook
+
+import (
+	"fmt"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	apimachineryvalidation "k8s.io/apimachinery/pkg/api/validation"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	operatorv1alpha1 "github.com/kcp-dev/kcp-operator/sdk/apis/operator/v1alpha1"
+)
+
+var (
+	// shardManagedFlags are the kcp server flags the operator points at the certificates,
+	// kubeconfigs and etcd settings it mounts. Overriding them via ExtraArgs would detach the
+	// shard from the PKI the operator maintains, so they are rejected up front.
+	shardManagedFlags = sets.New(
+		"root-ca-file",
+		"client-ca-file",
+		"requestheader-client-ca-file",
+		"requestheader-allowed-names",
+		"tls-cert-file",
+		"tls-private-key-file",
+		"service-account-key-file",
+		"service-account-private-key-file",
+		"shard-client-cert-file",
+		"shard-client-key-file",
+		"shard-name",
+		"shard-base-url",
+		"shard-external-url",
+		"cache-kubeconfig",
+		"root-shard-kubeconfig-file",
+		"logical-cluster-admin-kubeconfig",
+		"external-logical-cluster-admin-kubeconfig",
+		"mount-proxy-client-cert-file",
+		"mount-proxy-client-key-file",
+		"etcd-servers",
+		"etcd-prefix",
+		"etcd-cafile",
+		"etcd-certfile",
+		"etcd-keyfile",
+	)
+
+	// validManagedEtcdReplicas are the supported sizes of an operator-managed etcd cluster.
+	validManagedEtcdReplicas = sets.New[int32](1, 3, 5)
+
+	// frontProxyManagedFlags are the kcp-front-proxy flags referencing operator-managed mounts.
+	frontProxyManagedFlags = sets.New(
+		"secure-port",
+		"root-kubeconfig",
+		"shards-kubeconfig",
+		"tls-cert-file",
+		"tls-private-key-file",
+		"client-ca-file",
+		"requestheader-client-ca-file",
+		"requestheader-allowed-names",
+		"mapping-file",
+	)
+
+	// cacheServerManagedFlags are the cache-server flags referencing operator-managed mounts.
+	cacheServerManagedFlags = sets.New(
+		"tls-cert-file",
+		"tls-private-key-file",
+		"client-ca-file",
+		"embedded-etcd-directory",
+		"etcd-servers",
+		"etcd-prefix",
+		"etcd-cafile",
+		"etcd-certfile",
+		"etcd-keyfile",
+	)
+
+	// virtualWorkspaceManagedFlags are the virtual-workspace server flags referencing
+	// operator-managed mounts.
+	virtualWorkspaceManagedFlags = sets.New(
+		"client-ca-file",
+		"tls-cert-file",
+		"tls-private-key-file",
+		"bind-address",
+		"secure-port",
+		"requestheader-client-ca-file",
+		"requestheader-allowed-names",
+		"kubeconfig",
+		"cache-kubeconfig",
+	)
+)
+
+// flagName returns the name of a command line flag without its leading dashes and value,
+// or an empty string if the argument is not a flag.
+func flagName(arg string) string {
+	if !strings.HasPrefix(arg, "-") {
+		return ""
+	}
+
+	name := strings.TrimLeft(arg, "-")
+	if idx := strings.Index(name, "="); idx >= 0 {
+		name = name[:idx]
+	}
+
+	return name
+}
+
+func validateExtraArgs(fldPath *field.Path, args []string, managed sets.Set[string]) field.ErrorList {
+	var allErrs field.ErrorList
+
+	for i, arg := range args {
+		if name := flagName(arg); managed.Has(name) {
+			allErrs = append(allErrs, field.Forbidden(fldPath.Index(i), fmt.Sprintf("flag --%s is managed by the kcp-operator and cannot be overridden", name)))
+		}
+	}
+
+	return allErrs
+}
+
+func validateCertificates(fldPath *field.Path, certs operatorv1alpha1.Certificates) field.ErrorList {
+	var allErrs field.ErrorList
+
+	switch {
+	case certs.IssuerRef != nil && certs.CASecretRef != nil:
+		allErrs = append(allErrs, field.Invalid(fldPath, certs, "issuerRef and caSecretRef are mutually exclusive"))
+	case certs.IssuerRef == nil && certs.CASecretRef == nil:
+		allErrs = append(allErrs, field.Required(fldPath, "one of issuerRef or caSecretRef must be set"))
+	}
+
+	if certs.IssuerRef != nil && certs.IssuerRef.Name == "" {
+		allErrs = append(allErrs, field.Required(fldPath.Child("issuerRef", "name"), ""))
+	}
+
+	if certs.CASecretRef != nil && certs.CASecretRef.Name == "" {
+		allErrs = append(allErrs, field.Required(fldPath.Child("caSecretRef", "name"), ""))
+	}
+
+	return allErrs
+}
+
+func validateRequiredReference(fldPath *field.Path, ref *corev1.LocalObjectReference) field.ErrorList {
+	if ref == nil || ref.Name == "" {
+		return field.ErrorList{field.Required(fldPath, "a reference to an object in the same namespace is required")}
+	}
+
+	return nil
+}
+
+func validateOptionalReference(fldPath *field.Path, ref *corev1.LocalObjectReference) field.ErrorList {
+	if ref != nil && ref.Name == "" {
+		return field.ErrorList{field.Required(fldPath.Child("name"), "")}
+	}
+
+	return nil
+}
+
+// validateCABundle validates the two mutually exclusive ways to reference a CA bundle.
+func validateCABundle(fldPath *field.Path, secretRef *corev1.LocalObjectReference, configMapRef *operatorv1alpha1.LocalDataKeyReference) field.ErrorList {
+	allErrs := validateOptionalReference(fldPath.Child("caBundleSecretRef"), secretRef)
+
+	if configMapRef == nil {
+		return allErrs
+	}
+
+	configMapPath := fldPath.Child("caBundleConfigMapRef")
+
+	if secretRef != nil {
+		allErrs = append(allErrs, field.Forbidden(configMapPath, "caBundleSecretRef and caBundleConfigMapRef are mutually exclusive"))
+	}
+
+	if configMapRef.Name == "" {
+		allErrs = append(allErrs, field.Required(configMapPath.Child("name"), ""))
+	}
+
+	if configMapRef.Key == "" {
+		allErrs = append(allErrs, field.Required(configMapPath.Child("key"), ""))
+	}
+
+	return allErrs
+}
+
+func validateExternal(fldPath *field.Path, external operatorv1alpha1.ExternalConfig, required bool) field.ErrorList {
+	var allErrs field.ErrorList
+
+	if required && external.Hostname == "" {
+		allErrs = append(allErrs, field.Required(fldPath.Child("hostname"), ""))
+	}
+
+	if external.Port > 65535 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("port"), external.Port, "must be a valid port number"))
+	}
+
+	if external.PrivatePort != nil && *external.PrivatePort > 65535 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("privatePort"), *external.PrivatePort, "must be a valid port number"))
+	}
+
+	return allErrs
+}
+
+func validateCommonShardSpec(fldPath *field.Path, spec *operatorv1alpha1.CommonShardSpec) field.ErrorList {
+	var allErrs field.ErrorList
+
+	allErrs = append(allErrs, validateEtcd(fldPath.Child("etcd"), spec.Etcd)...)
+
+	if spec.Replicas != nil && *spec.Replicas < 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("replicas"), *spec.Replicas, "must not be negative"))
+	}
+
+	allErrs = append(allErrs, validateOptionalReference(fldPath.Child("kcpVirtualWorkspace"), spec.KCPVirtualWorkspace)...)
+	allErrs = append(allErrs, validateCABundle(fldPath, spec.CABundleSecretRef, spec.CABundleConfigMapRef)...)
+	allErrs = append(allErrs, validateOptionalReference(fldPath.Child("clientCABundleRef"), spec.ClientCABundleRef)...)
+	allErrs = append(allErrs, validateExtraArgs(fldPath.Child("extraArgs"), spec.ExtraArgs, shardManagedFlags)...)
+
+	return allErrs
+}
+
+func validateEtcd(fldPath *field.Path, etcd operatorv1alpha1.EtcdConfig) field.ErrorList {
+	var allErrs field.ErrorList
+
+	if etcd.TLSConfig != nil && etcd.TLSConfig.SecretRef.Name == "" {
+		allErrs = append(allErrs, field.Required(fldPath.Child("tlsConfig", "secretRef", "name"), ""))
+	}
+
+	managed := etcd.Managed
+	if managed == nil {
+		if len(etcd.Endpoints) == 0 {
+			allErrs = append(allErrs, field.Required(fldPath.Child("endpoints"), "at least one etcd endpoint is required"))
+		}
+
+		return allErrs
+	}
+
+	managedPath := fldPath.Child("managed")
+
+	if len(etcd.Endpoints) > 0 {
+		allErrs = append(allErrs, field.Forbidden(fldPath.Child("endpoints"), "endpoints cannot be combined with managed etcd"))
+	}
+
+	if etcd.TLSConfig != nil {
+		allErrs = append(allErrs, field.Forbidden(fldPath.Child("tlsConfig"), "tlsConfig cannot be combined with managed etcd"))
+	}
+
+	if managed.Replicas != nil && !validManagedEtcdReplicas.Has(*managed.Replicas) {
+		allErrs = append(allErrs, field.Invalid(managedPath.Child("replicas"), *managed.Replicas, "must be one of 1, 3 or 5"))
+	}
+
+	if managed.Storage != nil && managed.Storage.Size != nil && managed.Storage.Size.Sign() <= 0 {
+		allErrs = append(allErrs, field.Invalid(managedPath.Child("storage", "size"), managed.Storage.Size.String(), "must be greater than zero"))
+	}
+
+	return allErrs
+}
+
+// validateEtcdUpdate prevents changes that an operator-managed etcd cluster cannot follow:
+// switching between external and managed etcd would lose all data, members are bootstrapped
+// statically and volume claims of a StatefulSet cannot be changed.
+func validateEtcdUpdate(fldPath *field.Path, newEtcd, oldEtcd operatorv1alpha1.EtcdConfig) field.ErrorList {
+	managedPath := fldPath.Child("managed")
+
+	if (newEtcd.Managed == nil) != (oldEtcd.Managed == nil) {
+		return field.ErrorList{field.Forbidden(managedPath, "cannot switch between external and operator-managed etcd")}
+	}
+
+	if newEtcd.Managed == nil {
+		return nil
+	}
+
+	var allErrs field.ErrorList
+
+	allErrs = append(allErrs, validateImmutable(managedPath.Child("replicas"), newEtcd.Managed.Replicas, oldEtcd.Managed.Replicas)...)
+	allErrs = append(allErrs, validateImmutable(managedPath.Child("storage"), newEtcd.Managed.Storage, oldEtcd.Managed.Storage)...)
+
+	return allErrs
+}
+
+// beingDeleted returns true if the object is being deleted. Updates to such objects are not
+// validated at all, since they are usually only made to remove finalizers, which an object
+// that predates a validation rule must not be prevented from.
+func beingDeleted(obj metav1.Object) bool {
+	return obj.GetDeletionTimestamp() != nil
+}
+
+// newErrors returns the errors of the updated object that the old object did not have yet.
+// Existing objects that predate a validation rule can therefore still be updated, for
+// example to bring them out of their invalid state one field at a time, while changed fields
+// are validated as usual.
+func newErrors(newErrs, oldErrs field.ErrorList) field.ErrorList {
+	existing := sets.New[string]()
+	for _, err := range oldErrs {
+		existing.Insert(err.Error())
+	}
+
+	var allErrs field.ErrorList
+	for _, err := range newErrs {
+		if !existing.Has(err.Error()) {
+			allErrs = append(allErrs, err)
+		}
+	}
+
+	return allErrs
+}
+
+func validateImmutable(fldPath *field.Path, newVal, oldVal any) field.ErrorList {
+	return apimachineryvalidation.ValidateImmutableField(newVal, oldVal, fldPath)
+}
+
+// toError turns a list of field errors into the Invalid error the API server expects.
+func toError(kind, name string, allErrs field.ErrorList) error {
+	if len(allErrs) == 0 {
+		return nil
+	}
+
+	return apierrors.NewInvalid(operatorv1alpha1.SchemeGroupVersion.WithKind(kind).GroupKind(), name, allErrs)
+}
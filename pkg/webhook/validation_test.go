@@ -0,0 +1,100 @@
+/*
+Copyright 2026 The kcp Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package webhook
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+func TestFlagName(t *testing.T) {
+	testcases := map[string]string{
+		"--tls-cert-file=/etc/tls.crt": "tls-cert-file",
+		"-v=4":                         "v",
+		"--shard-name":                 "shard-name",
+		"/etc/tls.crt":                 "",
+		"":                             "",
+	}
+
+	for arg, expected := range testcases {
+		t.Run(arg, func(t *testing.T) {
+			require.Equal(t, expected, flagName(arg))
+		})
+	}
+}
+
+func TestValidateExtraArgs(t *testing.T) {
+	testcases := []struct {
+		name     string
+		args     []string
+		expected []string
+	}{
+		{
+			name: "no args",
+		},
+		{
+			name: "unmanaged flags",
+			args: []string{"--feature-gates=Foo=true", "--v=4"},
+		},
+		{
+			name:     "managed flag",
+			args:     []string{"--v=4", "--etcd-servers=https://etcd:2379"},
+			expected: []string{"spec.extraArgs[1]"},
+		},
+		{
+			name:     "managed flag without value",
+			args:     []string{"--shard-name", "foo"},
+			expected: []string{"spec.extraArgs[0]"},
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			errs := validateExtraArgs(field.NewPath("spec", "extraArgs"), tc.args, shardManagedFlags)
+			require.Equal(t, tc.expected, errorFields(errs))
+		})
+	}
+}
+
+func errorFields(errs field.ErrorList) []string {
+	var fields []string
+	for _, err := range errs {
+		fields = append(fields, err.Field)
+	}
+
+	return fields
+}
+
+func TestNewErrors(t *testing.T) {
+	fldPath := field.NewPath("spec", "extraArgs")
+
+	oldErrs := field.ErrorList{
+		field.Forbidden(fldPath.Index(0), "managed by the operator"),
+	}
+
+	newErrs := field.ErrorList{
+		field.Forbidden(fldPath.Index(0), "managed by the operator"),
+		field.Forbidden(fldPath.Index(1), "managed by the operator"),
+	}
+
+	require.Equal(t, field.ErrorList{newErrs[1]}, newErrors(newErrs, oldErrs))
+	require.Empty(t, newErrors(oldErrs, oldErrs))
+	require.Equal(t, newErrs, newErrors(newErrs, nil))
+}
@@ -0,0 +1,81 @@
+/*
+Copyright 2026 The kcp Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package webhook
+
+import (
+	"context"
+
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	operatorv1alpha1 "github.com/kcp-dev/kcp-operator/sdk/apis/operator/v1alpha1"
+)
+
+// +kubebuilder:webhook:path=/validate-operator-kcp-io-v1alpha1-virtualworkspace,mutating=false,failurePolicy=fail,sideEffects=None,groups=operator.kcp.io,resources=virtualworkspaces,verbs=create;update,versions=v1alpha1,name=vvirtualworkspace-v1alpha1.operator.kcp.io,admissionReviewVersions=v1
+
+// VirtualWorkspaceValidator validates VirtualWorkspace objects.
+type VirtualWorkspaceValidator struct{}
+
+var _ admission.Validator[*operatorv1alpha1.VirtualWorkspace] = &VirtualWorkspaceValidator{}
+
+func (v *VirtualWorkspaceValidator) ValidateCreate(_ context.Context, vw *operatorv1alpha1.VirtualWorkspace) (admission.Warnings, error) {
+	return nil, toError("VirtualWorkspace", vw.Name, validateVirtualWorkspace(vw))
+}
+
+func (v *VirtualWorkspaceValidator) ValidateUpdate(_ context.Context, oldVW, newVW *operatorv1alpha1.VirtualWorkspace) (admission.Warnings, error) {
+	if beingDeleted(newVW) {
+		return nil, nil
+	}
+
+	allErrs := newErrors(validateVirtualWorkspace(newVW), validateVirtualWorkspace(oldVW))
+	allErrs = append(allErrs, validateImmutable(field.NewPath("spec", "target"), newVW.Spec.Target, oldVW.Spec.Target)...)
+
+	return nil, toError("VirtualWorkspace", newVW.Name, allErrs)
+}
+
+func (v *VirtualWorkspaceValidator) ValidateDelete(_ context.Context, _ *operatorv1alpha1.VirtualWorkspace) (admission.Warnings, error) {
+	return nil, nil
+}
+
+func validateVirtualWorkspace(vw *operatorv1alpha1.VirtualWorkspace) field.ErrorList {
+	specPath := field.NewPath("spec")
+	spec := vw.Spec
+
+	var allErrs field.ErrorList
+
+	targetPath := specPath.Child("target")
+	switch {
+	case spec.Target.RootShardRef != nil && spec.Target.ShardRef != nil:
+		allErrs = append(allErrs, field.Invalid(targetPath, spec.Target, "rootShardRef and shardRef are mutually exclusive"))
+	case spec.Target.RootShardRef == nil && spec.Target.ShardRef == nil:
+		allErrs = append(allErrs, field.Required(targetPath, "one of rootShardRef or shardRef must be set"))
+	}
+
+	allErrs = append(allErrs, validateOptionalReference(targetPath.Child("rootShardRef"), spec.Target.RootShardRef)...)
+	allErrs = append(allErrs, validateOptionalReference(targetPath.Child("shardRef"), spec.Target.ShardRef)...)
+	allErrs = append(allErrs, validateExternal(specPath.Child("external"), spec.External, true)...)
+	allErrs = append(allErrs, validateOptionalReference(specPath.Child("caBundleSecretRef"), spec.CABundleSecretRef)...)
+	allErrs = append(allErrs, validateOptionalReference(specPath.Child("clientCABundleRef"), spec.ClientCABundleRef)...)
+	allErrs = append(allErrs, validateOptionalReference(specPath.Child("kubeconfigSecretRef"), spec.KubeconfigSecretRef)...)
+	allErrs = append(allErrs, validateExtraArgs(specPath.Child("extraArgs"), spec.ExtraArgs, virtualWorkspaceManagedFlags)...)
+
+	if spec.Replicas != nil && *spec.Replicas < 0 {
+		allErrs = append(allErrs, field.Invalid(specPath.Child("replicas"), *spec.Replicas, "must not be negative"))
+	}
+
+	return allErrs
+}
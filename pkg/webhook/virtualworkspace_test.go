@@ -0,0 +1,99 @@
+/*
+Copyright 2026 The kcp Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package webhook
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	operatorv1alpha1 "github.com/kcp-dev/kcp-operator/sdk/apis/operator/v1alpha1"
+)
+
+func newVirtualWorkspace() *operatorv1alpha1.VirtualWorkspace {
+	return &operatorv1alpha1.VirtualWorkspace{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "vw",
+			Namespace: "default",
+		},
+		Spec: operatorv1alpha1.VirtualWorkspaceSpec{
+			Target: operatorv1alpha1.VirtualWorkspaceTarget{
+				ShardRef: &corev1.LocalObjectReference{Name: "shardy"},
+			},
+			External: operatorv1alpha1.ExternalConfig{
+				Hostname: "vw.example.kcp.io",
+				Port:     6443,
+			},
+		},
+	}
+}
+
+func TestVirtualWorkspaceValidator(t *testing.T) {
+	testcases := []struct {
+		name    string
+		mutate  func(*operatorv1alpha1.VirtualWorkspace)
+		invalid bool
+	}{
+		{
+			name:   "valid",
+			mutate: func(*operatorv1alpha1.VirtualWorkspace) {},
+		},
+		{
+			name: "both targets",
+			mutate: func(vw *operatorv1alpha1.VirtualWorkspace) {
+				vw.Spec.Target.RootShardRef = &corev1.LocalObjectReference{Name: "rooty"}
+			},
+			invalid: true,
+		},
+		{
+			name: "overriding a managed flag",
+			mutate: func(vw *operatorv1alpha1.VirtualWorkspace) {
+				vw.Spec.ExtraArgs = []string{"--kubeconfig=/tmp/kubeconfig"}
+			},
+			invalid: true,
+		},
+	}
+
+	validator := &VirtualWorkspaceValidator{}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			vw := newVirtualWorkspace()
+			tc.mutate(vw)
+
+			_, err := validator.ValidateCreate(context.Background(), vw)
+			if tc.invalid {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestVirtualWorkspaceValidatorImmutableTarget(t *testing.T) {
+	oldVW := newVirtualWorkspace()
+	newVW := oldVW.DeepCopy()
+	newVW.Spec.Target.ShardRef.Name = "other"
+
+	_, err := (&VirtualWorkspaceValidator{}).ValidateUpdate(context.Background(), oldVW, newVW)
+	require.Error(t, err)
+}
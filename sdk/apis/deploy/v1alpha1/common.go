@@ -20,6 +20,16 @@ import (
 	operatorv1alpha1 "github.com/kcp-dev/kcp-operator/sdk/apis/operator/v1alpha1"
 )
 
+// TraceParentAnnotation holds the W3C trace context of the reconcile that last changed a
+// Compiled* object. The workload controllers link their own spans to it, so that a rollout
+// can be traced back to the change of the user-facing object that caused it.
+const TraceParentAnnotation = "deploy.operator.kcp.io/traceparent"
+
+// PublishedAtAnnotation holds the time (RFC 3339) at which a Compiled* object was last changed.
+// The workload controllers copy it onto the Deployments they render, to measure how long it
+// takes for a change to be rolled out.
+const PublishedAtAnnotation = "deploy.operator.kcp.io/published-at"
+
 // NamedRootShardSpec is the resolved copy of a RootShard spec.
 type NamedRootShardSpec struct {
 	// Name is the name of the RootShard object the spec was compiled from.
@@ -27,6 +37,10 @@ type NamedRootShardSpec struct {
 
 	// Spec is the resolved copy of the RootShard spec.
 	Spec operatorv1alpha1.RootShardSpec `json:"spec"`
+
+	// TrustBundles is set while the root shard's CAs are rotated. Instead of the CA Secrets,
+	// the trust bundles containing both the current and the previous CAs are mounted.
+	TrustBundles bool `json:"trustBundles,omitempty"`
 }
 
 // NamedShardSpec is the resolved copy of a Shard spec.
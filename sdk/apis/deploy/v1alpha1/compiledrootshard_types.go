@@ -35,6 +35,10 @@ type CompiledRootShardSpec struct {
 
 	// Optional: Shards are the names of all Shards belonging to the root shard.
 	Shards []string `json:"shards,omitempty"`
+
+	// TrustBundles is set while the root shard's CAs are rotated. Instead of the CA Secrets,
+	// the trust bundles containing both the current and the previous CAs are mounted.
+	TrustBundles bool `json:"trustBundles,omitempty"`
 }
 
 // +genclient
@@ -28,6 +28,10 @@ type CacheServerSpec struct {
 	// +optional
 	ClusterDomain string `json:"clusterDomain,omitempty"`
 
+	// Optional: Placement configures the workload cluster the cache server is deployed into. If
+	// not set, it is deployed into the cluster this object lives in.
+	Placement *Placement `json:"placement,omitempty"`
+
 	// Optional: Image overwrites the container image used to deploy the cache server.
 	Image *ImageSpec `json:"image,omitempty"`
 
@@ -54,8 +58,13 @@ type CacheServerSpec struct {
 	// Optional: DeploymentTemplate configures the Kubernetes Deployment created for this cache server.
 	DeploymentTemplate *DeploymentTemplate `json:"deploymentTemplate,omitempty"`
 
+	// Optional: PodDisruptionBudget configures the PodDisruptionBudget created for this cache server.
+	// A PodDisruptionBudget is only created when running more than one replica.
+	PodDisruptionBudget *PodDisruptionBudgetTemplate `json:"podDisruptionBudget,omitempty"`
+
 	// Optional: Etcd configures an external etcd connection for this cache server.
-	// If not provided, an embedded etcd is used.
+	// If not provided, an embedded etcd is used. Operator-managed etcd is not supported for cache servers.
+	// +kubebuilder:validation:XValidation:rule="!has(self.managed)",message="managed etcd is not supported for cache servers"
 	Etcd *EtcdConfig `json:"etcd,omitempty"`
 
 	// Optional: ExtraArgs defines additional command line arguments to pass to the cache server container.
@@ -80,13 +89,59 @@ type CacheServerSpec struct {
 
 // CacheServerStatus defines the observed state of CacheServer
 type CacheServerStatus struct {
-	// INSERT ADDITIONAL STATUS FIELD - define observed state of cluster
-	// Important: Run "make" to regenerate code after modifying this file
+	Phase CacheServerPhase `json:"phase,omitempty"`
+
+	// ObservedGeneration is the most recent generation observed for this CacheServer.
+	// +optional
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+
+	// +listType=map
+	// +listMapKey=type
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+
+	// RootShards is a list of root shards that are configured to use this cache server.
+	// +listType=map
+	// +listMapKey=name
+	// +optional
+	RootShards []ShardReference `json:"rootShards,omitempty"`
+
+	// Placement reports the workload cluster the cache server has been deployed into, if it
+	// is not running in the cluster this object lives in.
+	// +optional
+	Placement *PlacementStatus `json:"placement,omitempty"`
+
+	// Shards is a list of shards that use this cache server, either directly or because
+	// their root shard is configured to use it.
+	// +listType=map
+	// +listMapKey=name
+	// +optional
+	Shards []ShardReference `json:"shards,omitempty"`
+
+	// CertificateRotation reports the progress of the most recent certificate rotation, see
+	// RotateCertificatesAnnotation.
+	// +optional
+	CertificateRotation *CertificateRotationStatus `json:"certificateRotation,omitempty"`
+
+	// Certificates lists the certificates issued for this component.
+	// +listType=map
+	// +listMapKey=name
+	// +optional
+	Certificates []CertificateStatus `json:"certificates,omitempty"`
 }
 
+type CacheServerPhase string
+
+const (
+	CacheServerPhaseProvisioning CacheServerPhase = "Provisioning"
+	CacheServerPhaseRunning      CacheServerPhase = "Running"
+	CacheServerPhaseDeleting     CacheServerPhase = "Deleting"
+)
+
 // +genclient
 // +kubebuilder:object:root=true
 // +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:JSONPath=".status.phase",name="Phase",type="string"
+// +kubebuilder:printcolumn:JSONPath=".metadata.creationTimestamp",name="Age",type="date"
 
 // CacheServer is the Schema for the cacheservers API
 type CacheServer struct {
@@ -19,8 +19,12 @@ package v1alpha1
 import (
 	"time"
 
+	autoscalingv2 "k8s.io/api/autoscaling/v2"
 	corev1 "k8s.io/api/core/v1"
+	policyv1 "k8s.io/api/policy/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
 )
 
 var (
@@ -40,20 +44,165 @@ type ImageSpec struct {
 	ImagePullSecrets []corev1.LocalObjectReference `json:"imagePullSecrets,omitempty"`
 }
 
+// Placement decides which cluster the workloads of a component are deployed into.
+type Placement struct {
+	// Cluster is the name of the workload cluster to deploy into. The Compiled* object for
+	// the component is labelled with this name and only synced into the cluster by the syncer
+	// started with the same cluster name. Empty deploys into the cluster this object lives in.
+	//
+	// +kubebuilder:validation:MaxLength=63
+	// +kubebuilder:validation:Pattern=`^([a-z0-9]([-a-z0-9]*[a-z0-9])?)?$`
+	// +optional
+	Cluster string `json:"cluster,omitempty"`
+}
+
+// PlacementStatus reports where the workloads of a component have been deployed.
+type PlacementStatus struct {
+	// Cluster is the name of the workload cluster that the syncer reported the component's
+	// status from.
+	// +optional
+	Cluster string `json:"cluster,omitempty"`
+}
+
+// CertificateStatus describes one of the certificates issued for a component.
+type CertificateStatus struct {
+	// Name is the name of the Certificate object.
+	Name string `json:"name"`
+
+	// Certificate is the logical name of the certificate, e.g. "server" or "service-account".
+	// +optional
+	Certificate Certificate `json:"certificate,omitempty"`
+
+	// CA is the logical name of the CA, if the certificate is one.
+	// +optional
+	CA CA `json:"ca,omitempty"`
+
+	// SecretName is the name of the Secret the certificate is stored in.
+	SecretName string `json:"secretName"`
+
+	// Issuer is the name of the issuer that signs the certificate.
+	// +optional
+	Issuer string `json:"issuer,omitempty"`
+
+	// NotAfter is the time at which the current certificate expires.
+	// +optional
+	NotAfter *metav1.Time `json:"notAfter,omitempty"`
+
+	// Revision counts how often the certificate has been issued.
+	// +optional
+	Revision int `json:"revision,omitempty"`
+
+	// Ready is true if the certificate is up to date and has not expired.
+	Ready bool `json:"ready"`
+}
+
+// CertificateRotationStatus describes how far the rotation most recently requested via the
+// RotateCertificatesAnnotation has progressed.
+type CertificateRotationStatus struct {
+	// Request is the value of the RotateCertificatesAnnotation that started this rotation.
+	// The same value is not handled again.
+	Request string `json:"request"`
+
+	Phase CertificateRotationPhase `json:"phase"`
+
+	// StartTime is the time at which the request was handled.
+	StartTime metav1.Time `json:"startTime"`
+
+	// CompletionTime is the time at which the workloads were running with the new certificates.
+	// +optional
+	CompletionTime *metav1.Time `json:"completionTime,omitempty"`
+
+	// Message explains what the rotation is waiting for, or why the request is invalid.
+	// +optional
+	Message string `json:"message,omitempty"`
+}
+
+type CertificateRotationPhase string
+
+const (
+	// CertificateRotationPhaseRollingOut means that the certificates are issued again and the
+	// workloads are rolled out with them.
+	CertificateRotationPhaseRollingOut CertificateRotationPhase = "RollingOut"
+	// CertificateRotationPhaseCompleted means that the workloads are running with the new
+	// certificates.
+	CertificateRotationPhaseCompleted CertificateRotationPhase = "Completed"
+	// CertificateRotationPhaseInvalid means that the request could not be understood and has
+	// been ignored.
+	CertificateRotationPhaseInvalid CertificateRotationPhase = "Invalid"
+)
+
+// VersionStatus describes which image and kcp version a component is running.
+type VersionStatus struct {
+	// DesiredImage is the image configured for the component.
+	// +optional
+	DesiredImage string `json:"desiredImage,omitempty"`
+
+	// CurrentImage is the image that all replicas of the component have been rolled out with.
+	// While a rollout is in progress, this is still the previous image.
+	// +optional
+	CurrentImage string `json:"currentImage,omitempty"`
+
+	// KCPVersion is the version reported by the component's /version endpoint.
+	// +optional
+	KCPVersion string `json:"kcpVersion,omitempty"`
+}
+
 type RootShardConfig struct {
 	// Reference references a local RootShard object.
 	Reference *corev1.LocalObjectReference `json:"ref,omitempty"`
 }
 
+// +kubebuilder:validation:XValidation:rule="has(self.endpoints) != has(self.managed)",message="exactly one of endpoints or managed must be configured"
+// +kubebuilder:validation:XValidation:rule="!has(self.managed) || !has(self.tlsConfig)",message="tlsConfig cannot be combined with managed etcd"
 type EtcdConfig struct {
 	// Endpoints is a list of http urls at which etcd nodes are available. The expected format is "https://etcd-hostname:2379".
-	Endpoints []string `json:"endpoints"`
+	// Mutually exclusive with Managed.
+	// +optional
+	Endpoints []string `json:"endpoints,omitempty"`
 	// ClientCert configures the client certificate used to access etcd.
 	// +optional
 	TLSConfig *EtcdTLSConfig `json:"tlsConfig,omitempty"`
 	// Prefix is the etcd key prefix under which the component stores its data. If unset, the components default prefix is used.
 	// +optional
 	Prefix string `json:"prefix,omitempty"`
+	// Managed makes the kcp-operator run a dedicated etcd cluster for this shard, including its
+	// PKI. This is only supported for RootShards and Shards and is mutually exclusive with Endpoints.
+	// +optional
+	Managed *ManagedEtcdSpec `json:"managed,omitempty"`
+}
+
+// ManagedEtcdSpec configures an etcd cluster that is run by the kcp-operator next to a shard.
+type ManagedEtcdSpec struct {
+	// Replicas is the number of etcd members. Changing the number of members of an existing
+	// cluster is not supported.
+	// +kubebuilder:validation:Enum=1;3;5
+	// +kubebuilder:default=3
+	// +optional
+	Replicas *int32 `json:"replicas,omitempty"`
+
+	// Image overrides the etcd container image. Defaults to `quay.io/coreos/etcd` in the version
+	// supported by the kcp-operator.
+	// +optional
+	Image *ImageSpec `json:"image,omitempty"`
+
+	// Resources overrides the default resource requirements for the etcd containers.
+	// +optional
+	Resources *corev1.ResourceRequirements `json:"resources,omitempty"`
+
+	// Storage configures the PersistentVolumeClaims used by the etcd members.
+	// +optional
+	Storage *ManagedEtcdStorageSpec `json:"storage,omitempty"`
+}
+
+type ManagedEtcdStorageSpec struct {
+	// StorageClassName is the StorageClass to use for the etcd data volumes. If unset, the
+	// cluster's default StorageClass is used.
+	// +optional
+	StorageClassName *string `json:"storageClassName,omitempty"`
+
+	// Size is the requested size of each etcd data volume. Defaults to 8Gi.
+	// +optional
+	Size *resource.Quantity `json:"size,omitempty"`
 }
 
 type EtcdTLSConfig struct {
@@ -103,6 +252,12 @@ const (
 	// OperatorCertificate is created for a RootShard and used by the operator to
 	// connect
 	OperatorCertificate Certificate = "kcp-operator"
+
+	// EtcdServerCertificate is served by the members of an operator-managed etcd cluster,
+	// both to clients and to their peers.
+	EtcdServerCertificate Certificate = "etcd-server"
+	// EtcdClientCertificate is used by a shard to connect to its operator-managed etcd cluster.
+	EtcdClientCertificate Certificate = "etcd-client"
 )
 
 type CA string
@@ -121,6 +276,9 @@ const (
 
 	// CABundleCA is the CA used to validate the API server's TLS certificate.
 	CABundleCA CA = "ca-bundle"
+
+	// EtcdCA issues the server and client certificates for operator-managed etcd clusters.
+	EtcdCA CA = "etcd"
 )
 
 type CertificateTemplateMap map[string]CertificateTemplate
@@ -274,6 +432,34 @@ type CertificateSecretTemplate struct {
 	Labels map[string]string `json:"labels,omitempty"`
 }
 
+const (
+	// PausedAnnotation can be set to "true" on RootShards, Shards, FrontProxies, CacheServers and
+	// VirtualWorkspaces to stop the operator from reconciling them and the resources rendered for
+	// them, for example during manual incident work. The annotation is propagated to the
+	// corresponding Compiled* object, so the workload controllers stop as well.
+	PausedAnnotation = "operator.kcp.io/paused"
+
+	// RotateCertificatesAnnotation can be set to an RFC 3339 timestamp on RootShards, Shards,
+	// FrontProxies, CacheServers and VirtualWorkspaces to issue all of their certificates that
+	// were issued before that time again, for example after a suspected key compromise. CAs are
+	// not affected, see RotateCAsAnnotation on RootShards.
+	RotateCertificatesAnnotation = "operator.kcp.io/rotate-certificates"
+
+	// EndpointURLAnnotation overrides the base URL under which the operator reaches a component
+	// when the "compiled" addresser is used. Like all annotations, it is propagated from the
+	// user-facing object to the corresponding Compiled* object, where it is read from.
+	EndpointURLAnnotation = "operator.kcp.io/endpoint-url"
+	// EndpointServerNameAnnotation overrides the name verified during the TLS handshake with
+	// the component reached via EndpointURLAnnotation.
+	EndpointServerNameAnnotation = "operator.kcp.io/endpoint-server-name"
+	// ProxyEndpointURLAnnotation is the equivalent of EndpointURLAnnotation for the internal
+	// proxy deployed alongside a RootShard.
+	ProxyEndpointURLAnnotation = "operator.kcp.io/proxy-endpoint-url"
+	// ProxyEndpointServerNameAnnotation is the equivalent of EndpointServerNameAnnotation for
+	// the internal proxy deployed alongside a RootShard.
+	ProxyEndpointServerNameAnnotation = "operator.kcp.io/proxy-endpoint-server-name"
+)
+
 type ConditionType string
 
 const (
@@ -281,6 +467,13 @@ const (
 	ConditionTypeReady          ConditionType = "Ready"
 	ConditionTypeRootShard      ConditionType = "RootShard"
 	ConditionTypeReferenceValid ConditionType = "ReferenceValid"
+	ConditionTypeEtcdHealthy    ConditionType = "EtcdHealthy"
+	ConditionTypeDrained        ConditionType = "Drained"
+	ConditionTypePaused         ConditionType = "Paused"
+
+	ConditionTypeCertificatesReady   ConditionType = "CertificatesReady"
+	ConditionTypeCertificatesRotated ConditionType = "CertificatesRotated"
+	ConditionTypeHealthy             ConditionType = "Healthy"
 )
 
 type ConditionReason string
@@ -302,6 +495,48 @@ const (
 
 	ConditionReasonReferenceValid    ConditionReason = "ReferenceValid"
 	ConditionReasonReferenceNotFound ConditionReason = "ReferenceNotFound"
+
+	// reasons for ConditionTypeEtcdHealthy
+
+	ConditionReasonEtcdMembersReady       ConditionReason = "MembersReady"
+	ConditionReasonEtcdMembersUnavailable ConditionReason = "MembersUnavailable"
+
+	// reasons for ConditionTypeDrained
+
+	ConditionReasonLogicalClustersRemaining ConditionReason = "LogicalClustersRemaining"
+	ConditionReasonShardEmpty               ConditionReason = "ShardEmpty"
+	ConditionReasonForceDeleted             ConditionReason = "ForceDeleted"
+
+	// reasons for ConditionTypePaused
+
+	ConditionReasonPausedByAnnotation ConditionReason = "PausedByAnnotation"
+
+	// reasons for ConditionTypeCertificatesReady
+
+	ConditionReasonCertificatesReady    ConditionReason = "CertificatesReady"
+	ConditionReasonCertificatesNotReady ConditionReason = "CertificatesNotReady"
+
+	// reasons for ConditionTypeCertificatesRotated
+
+	ConditionReasonRotationProgressing    ConditionReason = "Progressing"
+	ConditionReasonRotationCompleted      ConditionReason = "Completed"
+	ConditionReasonRotationRequestInvalid ConditionReason = "InvalidRequest"
+
+	// reasons for ConditionTypeHealthy
+
+	ConditionReasonChecksPassed ConditionReason = "ChecksPassed"
+	ConditionReasonChecksFailed ConditionReason = "ChecksFailed"
+	ConditionReasonProbeFailed  ConditionReason = "ProbeFailed"
+
+	// reasons for ConditionTypeReady on EtcdSnapshots, EtcdBackupSchedules and EtcdRestores
+
+	ConditionReasonJobRunning      ConditionReason = "JobRunning"
+	ConditionReasonJobCompleted    ConditionReason = "JobCompleted"
+	ConditionReasonJobFailed       ConditionReason = "JobFailed"
+	ConditionReasonScheduled       ConditionReason = "Scheduled"
+	ConditionReasonInvalidSchedule ConditionReason = "InvalidSchedule"
+	ConditionReasonWaiting         ConditionReason = "Waiting"
+	ConditionReasonUnsupported     ConditionReason = "Unsupported"
 )
 
 type ServiceTemplate struct {
@@ -346,6 +581,74 @@ type DeploymentSpecTemplate struct {
 	Template *PodTemplateSpec `json:"template,omitempty"`
 }
 
+// AutoscalingSpec configures a HorizontalPodAutoscaler. If no target is configured at all,
+// the average CPU utilization is targeted at 80%.
+// +kubebuilder:validation:XValidation:rule="!has(self.minReplicas) || self.minReplicas <= self.maxReplicas",message="minReplicas must not be greater than maxReplicas"
+type AutoscalingSpec struct {
+	// MinReplicas is the lower limit for the number of replicas. Defaults to 2.
+	// +kubebuilder:validation:Minimum=1
+	// +optional
+	MinReplicas *int32 `json:"minReplicas,omitempty"`
+
+	// MaxReplicas is the upper limit for the number of replicas.
+	// +kubebuilder:validation:Minimum=1
+	MaxReplicas int32 `json:"maxReplicas"`
+
+	// TargetCPUUtilizationPercentage is the target average CPU utilization, relative to the
+	// requested CPU of the Pods.
+	// +kubebuilder:validation:Minimum=1
+	// +optional
+	TargetCPUUtilizationPercentage *int32 `json:"targetCPUUtilizationPercentage,omitempty"`
+
+	// TargetMemoryUtilizationPercentage is the target average memory utilization, relative to
+	// the requested memory of the Pods.
+	// +kubebuilder:validation:Minimum=1
+	// +optional
+	TargetMemoryUtilizationPercentage *int32 `json:"targetMemoryUtilizationPercentage,omitempty"`
+
+	// Metrics are additional metrics to scale on, for example custom or external metrics.
+	// +optional
+	Metrics []autoscalingv2.MetricSpec `json:"metrics,omitempty"`
+
+	// Behavior configures the scaling behavior in both up and down directions.
+	// +optional
+	Behavior *autoscalingv2.HorizontalPodAutoscalerBehavior `json:"behavior,omitempty"`
+}
+
+type PodDisruptionBudgetTemplate struct {
+	Metadata *PodDisruptionBudgetMetadataTemplate `json:"metadata,omitempty"`
+	Spec     *PodDisruptionBudgetSpecTemplate     `json:"spec,omitempty"`
+}
+
+type PodDisruptionBudgetMetadataTemplate struct {
+	// Annotations is a key value map to be copied to the target PodDisruptionBudget.
+	// +optional
+	Annotations map[string]string `json:"annotations,omitempty"`
+
+	// Labels is a key value map to be copied to the target PodDisruptionBudget.
+	// +optional
+	Labels map[string]string `json:"labels,omitempty"`
+}
+
+// PodDisruptionBudgetSpecTemplate overrides the disruption settings. If neither MinAvailable
+// nor MaxUnavailable are set, at most one Pod may be unavailable at any time.
+// +kubebuilder:validation:XValidation:rule="!(has(self.minAvailable) && has(self.maxUnavailable))",message="minAvailable and maxUnavailable are mutually exclusive"
+type PodDisruptionBudgetSpecTemplate struct {
+	// MinAvailable is the number or percentage of Pods that must still be available after an eviction.
+	// +optional
+	MinAvailable *intstr.IntOrString `json:"minAvailable,omitempty"`
+
+	// MaxUnavailable is the number or percentage of Pods that can be unavailable after an eviction.
+	// +optional
+	MaxUnavailable *intstr.IntOrString `json:"maxUnavailable,omitempty"`
+
+	// UnhealthyPodEvictionPolicy defines the criteria for when unhealthy Pods should be considered
+	// for eviction.
+	// +kubebuilder:validation:Enum=IfHealthyBudget;AlwaysAllow
+	// +optional
+	UnhealthyPodEvictionPolicy *policyv1.UnhealthyPodEvictionPolicyType `json:"unhealthyPodEvictionPolicy,omitempty"`
+}
+
 type PodTemplateSpec struct {
 	Metadata *PodMetadataTemplate `json:"metadata,omitempty"`
 	Spec     *PodSpecTemplate     `json:"spec,omitempty"`
@@ -0,0 +1,98 @@
+/*
+Copyright 2026 The kcp Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// EtcdBackupScheduleSpec defines the desired state of EtcdBackupSchedule.
+type EtcdBackupScheduleSpec struct {
+	// Schedule is a cron expression in the standard five-field format (e.g. "0 */6 * * *"),
+	// evaluated in UTC.
+	// +kubebuilder:validation:MinLength=1
+	Schedule string `json:"schedule"`
+
+	// Optional: Suspend stops the creation of new snapshots. Existing snapshots are not affected.
+	Suspend bool `json:"suspend,omitempty"`
+
+	// Target configures the RootShard or Shard whose etcd should be snapshotted.
+	Target EtcdSnapshotTarget `json:"target"`
+
+	// Storage configures where the snapshots are written to.
+	Storage EtcdSnapshotStorage `json:"storage"`
+
+	// Optional: Image overrides the container image that provides `etcdctl`.
+	Image *ImageSpec `json:"image,omitempty"`
+
+	// Optional: SuccessfulSnapshotsHistoryLimit is the number of completed EtcdSnapshots to keep.
+	// Deleting an EtcdSnapshot does not delete the snapshot file itself.
+	// +kubebuilder:default=7
+	// +kubebuilder:validation:Minimum=0
+	SuccessfulSnapshotsHistoryLimit *int32 `json:"successfulSnapshotsHistoryLimit,omitempty"`
+
+	// Optional: FailedSnapshotsHistoryLimit is the number of failed EtcdSnapshots to keep.
+	// +kubebuilder:default=1
+	// +kubebuilder:validation:Minimum=0
+	FailedSnapshotsHistoryLimit *int32 `json:"failedSnapshotsHistoryLimit,omitempty"`
+}
+
+// EtcdBackupScheduleStatus defines the observed state of EtcdBackupSchedule.
+type EtcdBackupScheduleStatus struct {
+	// LastScheduleTime is the time at which the most recent EtcdSnapshot was scheduled.
+	// +optional
+	LastScheduleTime *metav1.Time `json:"lastScheduleTime,omitempty"`
+
+	// LastSuccessfulSnapshot is the name of the most recent completed EtcdSnapshot.
+	// +optional
+	LastSuccessfulSnapshot string `json:"lastSuccessfulSnapshot,omitempty"`
+
+	// LastSuccessfulTime is the completion time of the most recent completed EtcdSnapshot.
+	// +optional
+	LastSuccessfulTime *metav1.Time `json:"lastSuccessfulTime,omitempty"`
+
+	// +listType=map
+	// +listMapKey=type
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+}
+
+// +genclient
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:JSONPath=".spec.schedule",name="Schedule",type="string"
+// +kubebuilder:printcolumn:JSONPath=".spec.suspend",name="Suspend",type="boolean"
+// +kubebuilder:printcolumn:JSONPath=".status.lastSuccessfulTime",name="Last Success",type="date"
+// +kubebuilder:printcolumn:JSONPath=".metadata.creationTimestamp",name="Age",type="date"
+
+// EtcdBackupSchedule is the Schema for the etcdbackupschedules API. It periodically creates
+// EtcdSnapshot objects for a RootShard or Shard.
+type EtcdBackupSchedule struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   EtcdBackupScheduleSpec   `json:"spec,omitempty"`
+	Status EtcdBackupScheduleStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// EtcdBackupScheduleList contains a list of EtcdBackupSchedule
+type EtcdBackupScheduleList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []EtcdBackupSchedule `json:"items"`
+}
@@ -0,0 +1,94 @@
+/*
+Copyright 2026 The kcp Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	// EtcdRestoreAnnotation is set on a RootShard or Shard while an EtcdRestore is in progress.
+	// Its value is the name of the EtcdRestore. While it is present, the kcp Deployment and the
+	// managed etcd StatefulSet are scaled down to zero.
+	EtcdRestoreAnnotation = "operator.kcp.io/etcd-restore"
+
+	// EtcdDataDirAnnotation is set on a RootShard or Shard by a successful EtcdRestore and
+	// names the directory on the etcd data volumes that the managed etcd members use.
+	EtcdDataDirAnnotation = "operator.kcp.io/etcd-data-dir"
+)
+
+// EtcdRestoreSpec defines the desired state of EtcdRestore.
+// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="the spec of an EtcdRestore is immutable"
+type EtcdRestoreSpec struct {
+	// SnapshotRef references the completed EtcdSnapshot to restore. The snapshot is restored
+	// into the etcd of the RootShard or Shard that it was taken from.
+	SnapshotRef corev1.LocalObjectReference `json:"snapshotRef"`
+}
+
+// EtcdRestoreStatus defines the observed state of EtcdRestore.
+type EtcdRestoreStatus struct {
+	Phase EtcdRestorePhase `json:"phase,omitempty"`
+
+	// +optional
+	StartTime *metav1.Time `json:"startTime,omitempty"`
+
+	// +optional
+	CompletionTime *metav1.Time `json:"completionTime,omitempty"`
+
+	// +listType=map
+	// +listMapKey=type
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+}
+
+type EtcdRestorePhase string
+
+const (
+	EtcdRestorePhasePending     EtcdRestorePhase = "Pending"
+	EtcdRestorePhaseScalingDown EtcdRestorePhase = "ScalingDown"
+	EtcdRestorePhaseRestoring   EtcdRestorePhase = "Restoring"
+	EtcdRestorePhaseScalingUp   EtcdRestorePhase = "ScalingUp"
+	EtcdRestorePhaseCompleted   EtcdRestorePhase = "Completed"
+	EtcdRestorePhaseFailed      EtcdRestorePhase = "Failed"
+)
+
+// +genclient
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:JSONPath=".spec.snapshotRef.name",name="Snapshot",type="string"
+// +kubebuilder:printcolumn:JSONPath=".status.phase",name="Phase",type="string"
+// +kubebuilder:printcolumn:JSONPath=".metadata.creationTimestamp",name="Age",type="date"
+
+// EtcdRestore is the Schema for the etcdrestores API. It restores an EtcdSnapshot into the
+// operator-managed etcd of the RootShard or Shard the snapshot was taken from. The shard is
+// unavailable while the restore is in progress.
+type EtcdRestore struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   EtcdRestoreSpec   `json:"spec,omitempty"`
+	Status EtcdRestoreStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// EtcdRestoreList contains a list of EtcdRestore
+type EtcdRestoreList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []EtcdRestore `json:"items"`
+}
@@ -0,0 +1,150 @@
+/*
+Copyright 2026 The kcp Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// EtcdSnapshotSpec defines the desired state of EtcdSnapshot.
+// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="the spec of an EtcdSnapshot is immutable"
+type EtcdSnapshotSpec struct {
+	// Target configures the RootShard or Shard whose etcd should be snapshotted.
+	Target EtcdSnapshotTarget `json:"target"`
+
+	// Storage configures where the snapshot is written to.
+	Storage EtcdSnapshotStorage `json:"storage"`
+
+	// Optional: Image overrides the container image that provides `etcdctl`. Defaults to the
+	// etcd image used for operator-managed etcd clusters.
+	Image *ImageSpec `json:"image,omitempty"`
+}
+
+// EtcdSnapshotTarget references the (root) shard whose etcd is snapshotted. The shard's EtcdConfig
+// determines the endpoints and the TLS client certificate that are used.
+// +kubebuilder:validation:XValidation:rule="has(self.rootShardRef) != has(self.shardRef)",message="exactly one of rootShardRef or shardRef must be configured"
+type EtcdSnapshotTarget struct {
+	RootShardRef *corev1.LocalObjectReference `json:"rootShardRef,omitempty"`
+	ShardRef     *corev1.LocalObjectReference `json:"shardRef,omitempty"`
+}
+
+// EtcdSnapshotStorage configures the location of etcd snapshots.
+// +kubebuilder:validation:XValidation:rule="has(self.persistentVolumeClaim) != has(self.s3)",message="exactly one of persistentVolumeClaim or s3 must be configured"
+type EtcdSnapshotStorage struct {
+	// PersistentVolumeClaim stores snapshots on an existing PersistentVolumeClaim in the
+	// namespace of the snapshot.
+	// +optional
+	PersistentVolumeClaim *PersistentVolumeClaimSnapshotStorage `json:"persistentVolumeClaim,omitempty"`
+
+	// S3 uploads snapshots to an S3-compatible object storage.
+	// +optional
+	S3 *S3SnapshotStorage `json:"s3,omitempty"`
+}
+
+type PersistentVolumeClaimSnapshotStorage struct {
+	// ClaimName is the name of the PersistentVolumeClaim.
+	// +kubebuilder:validation:MinLength=1
+	ClaimName string `json:"claimName"`
+
+	// Optional: SubPath is the directory on the volume that snapshots are stored in.
+	// Defaults to the volume's root.
+	SubPath string `json:"subPath,omitempty"`
+}
+
+type S3SnapshotStorage struct {
+	// Endpoint is the URL of the S3 API, for example "https://s3.eu-central-1.amazonaws.com".
+	// It must not contain a path.
+	// +kubebuilder:validation:Pattern=`^https?://`
+	Endpoint string `json:"endpoint"`
+
+	// Bucket is the name of the bucket that snapshots are stored in.
+	// +kubebuilder:validation:MinLength=1
+	Bucket string `json:"bucket"`
+
+	// Optional: Prefix is prepended to the object names of snapshots.
+	Prefix string `json:"prefix,omitempty"`
+
+	// CredentialsSecretRef references a Secret with the keys `accessKeyID` and `secretAccessKey`.
+	CredentialsSecretRef corev1.LocalObjectReference `json:"credentialsSecretRef"`
+
+	// Optional: Image overrides the container image of the MinIO client that is used to
+	// transfer snapshots. Defaults to `quay.io/minio/mc`.
+	Image *ImageSpec `json:"image,omitempty"`
+}
+
+const (
+	// S3AccessKeyIDKey is the key in the S3 credentials Secret that holds the access key ID.
+	S3AccessKeyIDKey = "accessKeyID"
+	// S3SecretAccessKeyKey is the key in the S3 credentials Secret that holds the secret access key.
+	S3SecretAccessKeyKey = "secretAccessKey"
+)
+
+// EtcdSnapshotStatus defines the observed state of EtcdSnapshot.
+type EtcdSnapshotStatus struct {
+	Phase EtcdSnapshotPhase `json:"phase,omitempty"`
+
+	// Location describes where the snapshot has been stored, either as a path on the
+	// PersistentVolumeClaim or as an S3 object URL.
+	// +optional
+	Location string `json:"location,omitempty"`
+
+	// +optional
+	StartTime *metav1.Time `json:"startTime,omitempty"`
+
+	// +optional
+	CompletionTime *metav1.Time `json:"completionTime,omitempty"`
+
+	// +listType=map
+	// +listMapKey=type
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+}
+
+type EtcdSnapshotPhase string
+
+const (
+	EtcdSnapshotPhasePending   EtcdSnapshotPhase = "Pending"
+	EtcdSnapshotPhaseRunning   EtcdSnapshotPhase = "Running"
+	EtcdSnapshotPhaseCompleted EtcdSnapshotPhase = "Completed"
+	EtcdSnapshotPhaseFailed    EtcdSnapshotPhase = "Failed"
+)
+
+// +genclient
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:JSONPath=".status.phase",name="Phase",type="string"
+// +kubebuilder:printcolumn:JSONPath=".status.location",name="Location",type="string"
+// +kubebuilder:printcolumn:JSONPath=".metadata.creationTimestamp",name="Age",type="date"
+
+// EtcdSnapshot is the Schema for the etcdsnapshots API. Each object represents a single
+// snapshot of the etcd cluster behind a RootShard or Shard.
+type EtcdSnapshot struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   EtcdSnapshotSpec   `json:"spec,omitempty"`
+	Status EtcdSnapshotStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// EtcdSnapshotList contains a list of EtcdSnapshot
+type EtcdSnapshotList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []EtcdSnapshot `json:"items"`
+}
@@ -23,11 +23,15 @@ import (
 
 // FrontProxySpec defines the desired state of FrontProxy.
 // +kubebuilder:validation:XValidation:rule="!(has(self.externalHostname) && has(self.external.hostname))",message="Cannot set both ExternalHostname (deprecated) and External.hostname. Use External.hostname only."
+// +kubebuilder:validation:XValidation:rule="!(has(self.replicas) && has(self.autoscaling))",message="replicas and autoscaling are mutually exclusive"
 type FrontProxySpec struct {
 	// RootShard configures the kcp root shard that this front-proxy instance should connect to.
 	RootShard RootShardConfig `json:"rootShard"`
 	// Optional: Replicas configures the replica count for the front-proxy Deployment.
 	Replicas *int32 `json:"replicas,omitempty"`
+	// Optional: Autoscaling makes the replica count of the front-proxy Deployment managed by a
+	// HorizontalPodAutoscaler. Mutually exclusive with Replicas.
+	Autoscaling *AutoscalingSpec `json:"autoscaling,omitempty"`
 	// Resources overrides the default resource requests and limits.
 	Resources *corev1.ResourceRequirements `json:"resources,omitempty"`
 	// Optional: Auth configures various aspects of Authentication and Authorization for this front-proxy instance.
@@ -52,6 +56,14 @@ type FrontProxySpec struct {
 	// Optional: DeploymentTemplate configures the Kubernetes Deployment created for this shard.
 	DeploymentTemplate *DeploymentTemplate `json:"deploymentTemplate,omitempty"`
 
+	// Optional: PodDisruptionBudget configures the PodDisruptionBudget created for this front-proxy.
+	// A PodDisruptionBudget is only created when running more than one replica.
+	PodDisruptionBudget *PodDisruptionBudgetTemplate `json:"podDisruptionBudget,omitempty"`
+
+	// Optional: Placement configures the workload cluster the front-proxy is deployed into. If
+	// not set, it is deployed into the cluster this object lives in.
+	Placement *Placement `json:"placement,omitempty"`
+
 	// CertificateTemplates allows to customize the properties on the generated
 	// certificates for this front-proxy.
 	CertificateTemplates CertificateTemplateMap `json:"certificateTemplates,omitempty"`
@@ -65,6 +77,13 @@ type FrontProxySpec struct {
 	// +optional
 	CABundleSecretRef *corev1.LocalObjectReference `json:"caBundleSecretRef,omitempty"`
 
+	// CABundleConfigMapRef is an alternative to CABundleSecretRef that references a key in a
+	// v1.ConfigMap holding the PEM encoded CA bundle, for example a ConfigMap distributed by a
+	// trust-manager Bundle. It is used in the same way as CABundleSecretRef and both fields are
+	// mutually exclusive.
+	// +optional
+	CABundleConfigMapRef *LocalDataKeyReference `json:"caBundleConfigMapRef,omitempty"`
+
 	// ClientCABundleRef references a v1.Secret object that contains an additional client CA bundle
 	// that should be trusted by the front-proxy for client certificate authentication.
 	// The secret must contain a key named `tls.crt` that holds the PEM encoded CA certificate(s).
@@ -156,6 +175,26 @@ type FrontProxyStatus struct {
 	// +listType=map
 	// +listMapKey=type
 	Conditions []metav1.Condition `json:"conditions,omitempty"`
+
+	// Version reports the image and kcp version this component is running.
+	// +optional
+	Version *VersionStatus `json:"version,omitempty"`
+
+	// Placement reports the workload cluster the component has been deployed into, if it
+	// is not running in the cluster this object lives in.
+	// +optional
+	Placement *PlacementStatus `json:"placement,omitempty"`
+
+	// CertificateRotation reports the progress of the most recent certificate rotation, see
+	// RotateCertificatesAnnotation.
+	// +optional
+	CertificateRotation *CertificateRotationStatus `json:"certificateRotation,omitempty"`
+
+	// Certificates lists the certificates issued for this component.
+	// +listType=map
+	// +listMapKey=name
+	// +optional
+	Certificates []CertificateStatus `json:"certificates,omitempty"`
 }
 
 type FrontProxyPhase string
@@ -172,6 +211,9 @@ const (
 // +kubebuilder:printcolumn:JSONPath=".spec.rootShard.ref.name",name="RootShard",type="string"
 // +kubebuilder:printcolumn:JSONPath=".spec.externalHostname",name="ExternalHostname",type="string"
 // +kubebuilder:printcolumn:JSONPath=".status.phase",name="Phase",type="string"
+// +kubebuilder:printcolumn:JSONPath=".status.version.kcpVersion",name="Version",type="string"
+// +kubebuilder:printcolumn:JSONPath=".status.version.desiredImage",name="Desired Image",type="string",priority=1
+// +kubebuilder:printcolumn:JSONPath=".status.version.currentImage",name="Current Image",type="string",priority=1
 // +kubebuilder:printcolumn:JSONPath=".metadata.creationTimestamp",name="Age",type="date"
 
 // FrontProxy is the Schema for the frontproxies API
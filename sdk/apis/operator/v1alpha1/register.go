@@ -53,6 +53,12 @@ func addKnownTypes(scheme *runtime.Scheme) error {
 	scheme.AddKnownTypes(SchemeGroupVersion,
 		&CacheServer{},
 		&CacheServerList{},
+		&EtcdBackupSchedule{},
+		&EtcdBackupScheduleList{},
+		&EtcdRestore{},
+		&EtcdRestoreList{},
+		&EtcdSnapshot{},
+		&EtcdSnapshotList{},
 		&FrontProxy{},
 		&FrontProxyList{},
 		&Kubeconfig{},
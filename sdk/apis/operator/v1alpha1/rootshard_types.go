@@ -38,8 +38,14 @@ type RootShardSpec struct {
 	// Certificates configures how the operator should create the kcp root CA, from which it will
 	// then create all other sub CAs and leaf certificates.
 	Certificates Certificates `json:"certificates"`
+
+	// Optional: TrustBundle publishes the server CA of this root shard as a trust-manager Bundle,
+	// so that clients in other namespaces can trust the front-proxy and shards without having to
+	// copy CA Secrets around. trust-manager must be installed in the cluster.
+	TrustBundle *TrustBundleSpec `json:"trustBundle,omitempty"`
 }
 
+// +kubebuilder:validation:XValidation:rule="!(has(self.replicas) && has(self.autoscaling))",message="replicas and autoscaling are mutually exclusive"
 type RootShardProxySpec struct {
 	// Optional: Image allows to override the container image used for this proxy.
 	Image *ImageSpec `json:"image,omitempty"`
@@ -47,6 +53,10 @@ type RootShardProxySpec struct {
 	// Optional: Replicas configures how many instances of this proxy run in parallel. Defaults to 2 if not set.
 	Replicas *int32 `json:"replicas,omitempty"`
 
+	// Optional: Autoscaling makes the replica count of this proxy managed by a
+	// HorizontalPodAutoscaler. Mutually exclusive with Replicas.
+	Autoscaling *AutoscalingSpec `json:"autoscaling,omitempty"`
+
 	// Optional: Resources overrides the default resource requests and limits.
 	Resources *corev1.ResourceRequirements `json:"resources,omitempty"`
 
@@ -56,6 +66,10 @@ type RootShardProxySpec struct {
 	// Optional: DeploymentTemplate configures the Kubernetes Deployment created for this proxy.
 	DeploymentTemplate *DeploymentTemplate `json:"deploymentTemplate,omitempty"`
 
+	// Optional: PodDisruptionBudget configures the PodDisruptionBudget created for this proxy.
+	// A PodDisruptionBudget is only created when running more than one replica.
+	PodDisruptionBudget *PodDisruptionBudgetTemplate `json:"podDisruptionBudget,omitempty"`
+
 	// CertificateTemplates allows to customize the properties on the generated
 	// certificates for this front-proxy.
 	CertificateTemplates CertificateTemplateMap `json:"certificateTemplates,omitempty"`
@@ -118,6 +132,24 @@ type Certificates struct {
 	CASecretRef *corev1.LocalObjectReference `json:"caSecretRef,omitempty"`
 }
 
+// TrustBundleSpec configures the trust-manager Bundle that distributes a root shard's server CA.
+type TrustBundleSpec struct {
+	// Optional: Name is the name of the cluster-scoped Bundle, which is also the name of the
+	// ConfigMaps trust-manager creates for it. Defaults to "<namespace>-<root shard>-server-ca".
+	// +optional
+	Name string `json:"name,omitempty"`
+
+	// Optional: Key is the key in the ConfigMaps that holds the PEM encoded CA certificates.
+	// Defaults to "ca.crt".
+	// +optional
+	Key string `json:"key,omitempty"`
+
+	// Optional: NamespaceSelector limits the namespaces into which trust-manager copies the
+	// bundle. If not set, the bundle is distributed into all namespaces.
+	// +optional
+	NamespaceSelector *metav1.LabelSelector `json:"namespaceSelector,omitempty"`
+}
+
 type RootShardCacheConfig struct {
 	// Embedded configures settings for starting the cache server embedded in the root shard.
 	//
@@ -147,13 +179,131 @@ type RootShardStatus struct {
 	// +listMapKey=name
 	// +optional
 	Shards []ShardReference `json:"shards,omitempty"`
+
+	// Upgrade reports the kcp version of every component of this installation, in the order
+	// in which the components are upgraded.
+	// +optional
+	Upgrade *UpgradeStatus `json:"upgrade,omitempty"`
+
+	// Version reports the image and kcp version this component is running.
+	// +optional
+	Version *VersionStatus `json:"version,omitempty"`
+
+	// Placement reports the workload cluster the component has been deployed into, if it
+	// is not running in the cluster this object lives in.
+	// +optional
+	Placement *PlacementStatus `json:"placement,omitempty"`
+
+	// CARotation reports the progress of the most recent CA rotation, see RotateCAsAnnotation.
+	// +optional
+	CARotation *CARotationStatus `json:"caRotation,omitempty"`
+
+	// CertificateRotation reports the progress of the most recent certificate rotation, see
+	// RotateCertificatesAnnotation.
+	// +optional
+	CertificateRotation *CertificateRotationStatus `json:"certificateRotation,omitempty"`
+
+	// Certificates lists the certificates issued for this component.
+	// +listType=map
+	// +listMapKey=name
+	// +optional
+	Certificates []CertificateStatus `json:"certificates,omitempty"`
+}
+
+// CARotationStatus describes how far the rotation of the root shard's CAs has progressed.
+type CARotationStatus struct {
+	// Request is the value of the RotateCAsAnnotation that started this rotation.
+	Request string `json:"request"`
+
+	Phase CARotationPhase `json:"phase"`
+
+	// StartTime is the time at which the rotation was started.
+	StartTime metav1.Time `json:"startTime"`
+
+	// CompletionTime is the time at which the previous CAs stopped being trusted.
+	// +optional
+	CompletionTime *metav1.Time `json:"completionTime,omitempty"`
+
+	// Message explains what the current phase is waiting for.
+	// +optional
+	Message string `json:"message,omitempty"`
+}
+
+type CARotationPhase string
+
+const (
+	// CARotationPhasePublishingTrust means that all components are configured to trust both
+	// the current and the previous CAs.
+	CARotationPhasePublishingTrust CARotationPhase = "PublishingTrust"
+	// CARotationPhaseIssuingCAs means that new CA certificates and keys are being issued.
+	CARotationPhaseIssuingCAs CARotationPhase = "IssuingCAs"
+	// CARotationPhaseReissuingCertificates means that all certificates signed by the previous
+	// CAs are issued again and rolled out.
+	CARotationPhaseReissuingCertificates CARotationPhase = "ReissuingCertificates"
+	// CARotationPhaseRemovingTrust means that the previous CAs are removed from all trust
+	// bundles again.
+	CARotationPhaseRemovingTrust CARotationPhase = "RemovingTrust"
+	// CARotationPhaseCompleted means that only the new CAs are in use.
+	CARotationPhaseCompleted CARotationPhase = "Completed"
+)
+
+// UpgradeStatus describes how far a version change has been rolled out across a kcp installation.
+// Components are upgraded in the order cache server, root shard, shards, virtual workspaces and
+// front-proxies, and each step only starts once all previous steps are available.
+type UpgradeStatus struct {
+	// Components lists all components of the installation in upgrade order.
+	// +optional
+	Components []ComponentUpgradeStatus `json:"components,omitempty"`
+}
+
+type ComponentUpgradeStatus struct {
+	// Kind is the kind of the component, like "Shard" or "FrontProxy". The internal proxy of
+	// the root shard is reported as "RootShardProxy".
+	Kind string `json:"kind"`
+
+	// Name is the name of the component.
+	Name string `json:"name"`
+
+	// CurrentVersion is the image tag that is currently rolled out.
+	// +optional
+	CurrentVersion string `json:"currentVersion,omitempty"`
+
+	// DesiredVersion is the image tag configured for the component.
+	DesiredVersion string `json:"desiredVersion"`
+
+	State UpgradeState `json:"state"`
+
+	// Message explains why the component is waiting or blocked.
+	// +optional
+	Message string `json:"message,omitempty"`
 }
 
+type UpgradeState string
+
+const (
+	// UpgradeStateUpToDate means the desired version is rolled out and available.
+	UpgradeStateUpToDate UpgradeState = "UpToDate"
+	// UpgradeStateProgressing means the desired version is being rolled out.
+	UpgradeStateProgressing UpgradeState = "Progressing"
+	// UpgradeStateWaiting means the component waits for a previous upgrade step to finish.
+	UpgradeStateWaiting UpgradeState = "Waiting"
+	// UpgradeStateBlocked means the desired version would cause an unsupported version skew
+	// and is not rolled out.
+	UpgradeStateBlocked UpgradeState = "Blocked"
+)
+
 type ShardReference struct {
 	// Name is the name of the shard.
 	Name string `json:"name"`
 }
 
+const (
+	// RotateCAsAnnotation starts a rotation of the root shard's CAs whenever its value changes,
+	// e.g. by setting it to the current time. While a rotation is in progress, changing the
+	// value starts another rotation once the current one has completed.
+	RotateCAsAnnotation = "operator.kcp.io/rotate-cas"
+)
+
 type RootShardPhase string
 
 const (
@@ -167,6 +317,10 @@ const (
 // +kubebuilder:subresource:status
 // +kubebuilder:printcolumn:JSONPath=".spec.external.hostname",name="Hostname",type="string"
 // +kubebuilder:printcolumn:JSONPath=".status.phase",name="Phase",type="string"
+// +kubebuilder:printcolumn:JSONPath=".status.version.kcpVersion",name="Version",type="string"
+// +kubebuilder:printcolumn:JSONPath=".status.version.desiredImage",name="Desired Image",type="string",priority=1
+// +kubebuilder:printcolumn:JSONPath=".status.version.currentImage",name="Current Image",type="string",priority=1
+// +kubebuilder:printcolumn:JSONPath=".status.caRotation.phase",name="CA Rotation",type="string",priority=1
 // +kubebuilder:printcolumn:JSONPath=".metadata.creationTimestamp",name="Age",type="date"
 
 // RootShard is the Schema for the kcpinstances API
@@ -37,6 +37,10 @@ type CommonShardSpec struct {
 	// +optional
 	ClusterDomain string `json:"clusterDomain,omitempty"`
 
+	// Optional: Placement configures the workload cluster the shard is deployed into. If
+	// not set, it is deployed into the cluster this object lives in.
+	Placement *Placement `json:"placement,omitempty"`
+
 	// ShardBaseURL is the base URL under which this shard should be reachable. This is used to configure
 	// the external URL. If not provided, the operator will use kubernetes service address to generate it.
 	// +optional
@@ -79,6 +83,10 @@ type CommonShardSpec struct {
 	// Optional: DeploymentTemplate configures the Kubernetes Deployment created for this shard.
 	DeploymentTemplate *DeploymentTemplate `json:"deploymentTemplate,omitempty"`
 
+	// Optional: PodDisruptionBudget configures the PodDisruptionBudget created for this shard.
+	// A PodDisruptionBudget is only created when running more than one replica.
+	PodDisruptionBudget *PodDisruptionBudgetTemplate `json:"podDisruptionBudget,omitempty"`
+
 	// CABundle references a v1.Secret object that contains the CA bundle that should be used
 	// to validate the API server's TLS certificate. The secret must contain a key named `tls.crt`
 	// that holds the PEM encoded CA certificate. It will be merged into the
@@ -91,6 +99,14 @@ type CommonShardSpec struct {
 	// +optional
 	CABundleSecretRef *corev1.LocalObjectReference `json:"caBundleSecretRef,omitempty"`
 
+	// CABundleConfigMapRef is an alternative to CABundleSecretRef that references a key in a
+	// v1.ConfigMap holding the PEM encoded CA bundle, for example a ConfigMap distributed by a
+	// trust-manager Bundle. It is used in the same way as CABundleSecretRef and both fields are
+	// mutually exclusive.
+	//
+	// +optional
+	CABundleConfigMapRef *LocalDataKeyReference `json:"caBundleConfigMapRef,omitempty"`
+
 	// ClientCABundleRef references a v1.Secret containing an additional client CA bundle
 	// for client certificate authentication. The secret must contain a key named `tls.crt`.
 	// This CA bundle will be merged with the root shard's client CA.
@@ -220,6 +236,26 @@ type ShardStatus struct {
 	// +listType=map
 	// +listMapKey=type
 	Conditions []metav1.Condition `json:"conditions,omitempty"`
+
+	// Version reports the image and kcp version this component is running.
+	// +optional
+	Version *VersionStatus `json:"version,omitempty"`
+
+	// Placement reports the workload cluster the component has been deployed into, if it
+	// is not running in the cluster this object lives in.
+	// +optional
+	Placement *PlacementStatus `json:"placement,omitempty"`
+
+	// CertificateRotation reports the progress of the most recent certificate rotation, see
+	// RotateCertificatesAnnotation.
+	// +optional
+	CertificateRotation *CertificateRotationStatus `json:"certificateRotation,omitempty"`
+
+	// Certificates lists the certificates issued for this component.
+	// +listType=map
+	// +listMapKey=name
+	// +optional
+	Certificates []CertificateStatus `json:"certificates,omitempty"`
 }
 
 type ShardPhase string
@@ -227,14 +263,24 @@ type ShardPhase string
 const (
 	ShardPhaseProvisioning ShardPhase = "Provisioning"
 	ShardPhaseRunning      ShardPhase = "Running"
+	ShardPhaseDraining     ShardPhase = "Draining"
 	ShardPhaseDeleting     ShardPhase = "Deleting"
 )
 
+const (
+	// ForceDeleteAnnotation can be set to "true" on a Shard to skip draining it when it is
+	// deleted. Any logical clusters that still live on the shard will become unavailable.
+	ForceDeleteAnnotation = "operator.kcp.io/force-delete"
+)
+
 // +genclient
 // +kubebuilder:object:root=true
 // +kubebuilder:subresource:status
 // +kubebuilder:printcolumn:JSONPath=".spec.rootShard.ref.name",name="RootShard",type="string"
 // +kubebuilder:printcolumn:JSONPath=".status.phase",name="Phase",type="string"
+// +kubebuilder:printcolumn:JSONPath=".status.version.kcpVersion",name="Version",type="string"
+// +kubebuilder:printcolumn:JSONPath=".status.version.desiredImage",name="Desired Image",type="string",priority=1
+// +kubebuilder:printcolumn:JSONPath=".status.version.currentImage",name="Current Image",type="string",priority=1
 // +kubebuilder:printcolumn:JSONPath=".metadata.creationTimestamp",name="Age",type="date"
 
 // Shard is the Schema for the shards API
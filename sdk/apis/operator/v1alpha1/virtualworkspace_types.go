@@ -24,6 +24,10 @@ import (
 // +genclient
 // +kubebuilder:object:root=true
 // +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:JSONPath=".status.version.kcpVersion",name="Version",type="string"
+// +kubebuilder:printcolumn:JSONPath=".status.version.desiredImage",name="Desired Image",type="string",priority=1
+// +kubebuilder:printcolumn:JSONPath=".status.version.currentImage",name="Current Image",type="string",priority=1
+// +kubebuilder:printcolumn:JSONPath=".metadata.creationTimestamp",name="Age",type="date"
 
 // VirtualWorkspace represents an external virtual workspace server that will be deployed as a
 // single Deployment (plus a few auxiliary resources). Creating a per-shard virtual workspace means
@@ -93,6 +97,10 @@ type VirtualWorkspaceSpec struct {
 	// Optional: DeploymentTemplate configures the Kubernetes Deployment created for this server.
 	DeploymentTemplate *DeploymentTemplate `json:"deploymentTemplate,omitempty"`
 
+	// Optional: PodDisruptionBudget configures the PodDisruptionBudget created for this server.
+	// A PodDisruptionBudget is only created when running more than one replica.
+	PodDisruptionBudget *PodDisruptionBudgetTemplate `json:"podDisruptionBudget,omitempty"`
+
 	// CABundle references a v1.Secret object that contains the CA bundle that should be used
 	// to validate the API server's TLS certificate. The secret must contain a key named `tls.crt`
 	// that holds the PEM encoded CA certificate. It will be merged into the
@@ -156,6 +164,10 @@ type VirtualWorkspaceSpec struct {
 	//
 	// +optional
 	ClusterDomain string `json:"clusterDomain,omitempty"`
+
+	// Optional: Placement configures the workload cluster the virtual workspace server is deployed into. If
+	// not set, it is deployed into the cluster this object lives in.
+	Placement *Placement `json:"placement,omitempty"`
 }
 
 // VirtualWorkspaceInitContainer describes a container that runs to completion before the virtual
@@ -239,6 +251,26 @@ type VirtualWorkspaceStatus struct {
 	// +listType=map
 	// +listMapKey=type
 	Conditions []metav1.Condition `json:"conditions,omitempty"`
+
+	// Version reports the image and kcp version this component is running.
+	// +optional
+	Version *VersionStatus `json:"version,omitempty"`
+
+	// Placement reports the workload cluster the component has been deployed into, if it
+	// is not running in the cluster this object lives in.
+	// +optional
+	Placement *PlacementStatus `json:"placement,omitempty"`
+
+	// CertificateRotation reports the progress of the most recent certificate rotation, see
+	// RotateCertificatesAnnotation.
+	// +optional
+	CertificateRotation *CertificateRotationStatus `json:"certificateRotation,omitempty"`
+
+	// Certificates lists the certificates issued for this component.
+	// +listType=map
+	// +listMapKey=name
+	// +optional
+	Certificates []CertificateStatus `json:"certificates,omitempty"`
 }
 
 // +kubebuilder:object:root=true
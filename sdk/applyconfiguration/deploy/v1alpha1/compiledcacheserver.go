@@ -23,7 +23,7 @@ import (
 	types "k8s.io/apimachinery/pkg/types"
 	v1 "k8s.io/client-go/applyconfigurations/meta/v1"
 
-	operatorv1alpha1 "github.com/kcp-dev/kcp-operator/sdk/apis/operator/v1alpha1"
+	operatorv1alpha1 "github.com/kcp-dev/kcp-operator/sdk/applyconfiguration/operator/v1alpha1"
 )
 
 // CompiledCacheServerApplyConfiguration represents a declarative configuration of the CompiledCacheServer type for use
@@ -31,8 +31,8 @@ import (
 type CompiledCacheServerApplyConfiguration struct {
 	v1.TypeMetaApplyConfiguration    `json:",inline"`
 	*v1.ObjectMetaApplyConfiguration `json:"metadata,omitempty"`
-	Spec                             *CompiledCacheServerSpecApplyConfiguration `json:"spec,omitempty"`
-	Status                           *operatorv1alpha1.CacheServerStatus        `json:"status,omitempty"`
+	Spec                             *CompiledCacheServerSpecApplyConfiguration            `json:"spec,omitempty"`
+	Status                           *operatorv1alpha1.CacheServerStatusApplyConfiguration `json:"status,omitempty"`
 }
 
 // CompiledCacheServer constructs a declarative configuration of the CompiledCacheServer type for use with
@@ -215,8 +215,8 @@ func (b *CompiledCacheServerApplyConfiguration) WithSpec(value *CompiledCacheSer
 // WithStatus sets the Status field in the declarative configuration to the given value
 // and returns the receiver, so that objects can be built by chaining "With" function invocations.
 // If called multiple times, the Status field is set to the value of the last call.
-func (b *CompiledCacheServerApplyConfiguration) WithStatus(value operatorv1alpha1.CacheServerStatus) *CompiledCacheServerApplyConfiguration {
-	b.Status = &value
+func (b *CompiledCacheServerApplyConfiguration) WithStatus(value *operatorv1alpha1.CacheServerStatusApplyConfiguration) *CompiledCacheServerApplyConfiguration {
+	b.Status = value
 	return b
 }
 
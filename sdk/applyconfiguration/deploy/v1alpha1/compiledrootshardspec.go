@@ -28,6 +28,7 @@ type CompiledRootShardSpecApplyConfiguration struct {
 	RootShard        *operatorv1alpha1.RootShardSpecApplyConfiguration `json:"rootShard,omitempty"`
 	VirtualWorkspace *NamedVirtualWorkspaceSpecApplyConfiguration      `json:"virtualWorkspace,omitempty"`
 	Shards           []string                                          `json:"shards,omitempty"`
+	TrustBundles     *bool                                             `json:"trustBundles,omitempty"`
 }
 
 // CompiledRootShardSpecApplyConfiguration constructs a declarative configuration of the CompiledRootShardSpec type for use with
@@ -61,3 +62,11 @@ func (b *CompiledRootShardSpecApplyConfiguration) WithShards(values ...string) *
 	}
 	return b
 }
+
+// WithTrustBundles sets the TrustBundles field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the TrustBundles field is set to the value of the last call.
+func (b *CompiledRootShardSpecApplyConfiguration) WithTrustBundles(value bool) *CompiledRootShardSpecApplyConfiguration {
+	b.TrustBundles = &value
+	return b
+}
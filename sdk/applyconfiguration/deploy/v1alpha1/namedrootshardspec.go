@@ -25,8 +25,9 @@ import (
 // NamedRootShardSpecApplyConfiguration represents a declarative configuration of the NamedRootShardSpec type for use
 // with apply.
 type NamedRootShardSpecApplyConfiguration struct {
-	Name *string                                           `json:"name,omitempty"`
-	Spec *operatorv1alpha1.RootShardSpecApplyConfiguration `json:"spec,omitempty"`
+	Name         *string                                           `json:"name,omitempty"`
+	Spec         *operatorv1alpha1.RootShardSpecApplyConfiguration `json:"spec,omitempty"`
+	TrustBundles *bool                                             `json:"trustBundles,omitempty"`
 }
 
 // NamedRootShardSpecApplyConfiguration constructs a declarative configuration of the NamedRootShardSpec type for use with
@@ -50,3 +51,11 @@ func (b *NamedRootShardSpecApplyConfiguration) WithSpec(value *operatorv1alpha1.
 	b.Spec = value
 	return b
 }
+
+// WithTrustBundles sets the TrustBundles field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the TrustBundles field is set to the value of the last call.
+func (b *NamedRootShardSpecApplyConfiguration) WithTrustBundles(value bool) *NamedRootShardSpecApplyConfiguration {
+	b.TrustBundles = &value
+	return b
+}
@@ -22,8 +22,6 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	types "k8s.io/apimachinery/pkg/types"
 	v1 "k8s.io/client-go/applyconfigurations/meta/v1"
-
-	operatorv1alpha1 "github.com/kcp-dev/kcp-operator/sdk/apis/operator/v1alpha1"
 )
 
 // CacheServerApplyConfiguration represents a declarative configuration of the CacheServer type for use
@@ -31,8 +29,8 @@ import (
 type CacheServerApplyConfiguration struct {
 	v1.TypeMetaApplyConfiguration    `json:",inline"`
 	*v1.ObjectMetaApplyConfiguration `json:"metadata,omitempty"`
-	Spec                             *CacheServerSpecApplyConfiguration  `json:"spec,omitempty"`
-	Status                           *operatorv1alpha1.CacheServerStatus `json:"status,omitempty"`
+	Spec                             *CacheServerSpecApplyConfiguration   `json:"spec,omitempty"`
+	Status                           *CacheServerStatusApplyConfiguration `json:"status,omitempty"`
 }
 
 // CacheServer constructs a declarative configuration of the CacheServer type for use with
@@ -215,8 +213,8 @@ func (b *CacheServerApplyConfiguration) WithSpec(value *CacheServerSpecApplyConf
 // WithStatus sets the Status field in the declarative configuration to the given value
 // and returns the receiver, so that objects can be built by chaining "With" function invocations.
 // If called multiple times, the Status field is set to the value of the last call.
-func (b *CacheServerApplyConfiguration) WithStatus(value operatorv1alpha1.CacheServerStatus) *CacheServerApplyConfiguration {
-	b.Status = &value
+func (b *CacheServerApplyConfiguration) WithStatus(value *CacheServerStatusApplyConfiguration) *CacheServerApplyConfiguration {
+	b.Status = value
 	return b
 }
 
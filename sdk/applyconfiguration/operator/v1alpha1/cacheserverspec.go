@@ -27,18 +27,20 @@ import (
 // CacheServerSpecApplyConfiguration represents a declarative configuration of the CacheServerSpec type for use
 // with apply.
 type CacheServerSpecApplyConfiguration struct {
-	ClusterDomain        *string                                  `json:"clusterDomain,omitempty"`
-	Image                *ImageSpecApplyConfiguration             `json:"image,omitempty"`
-	Replicas             *int32                                   `json:"replicas,omitempty"`
-	Logging              *LoggingSpecApplyConfiguration           `json:"logging,omitempty"`
-	Certificates         *CertificatesApplyConfiguration          `json:"certificates,omitempty"`
-	CertificateTemplates *operatorv1alpha1.CertificateTemplateMap `json:"certificateTemplates,omitempty"`
-	ServiceTemplate      *ServiceTemplateApplyConfiguration       `json:"serviceTemplate,omitempty"`
-	DeploymentTemplate   *DeploymentTemplateApplyConfiguration    `json:"deploymentTemplate,omitempty"`
-	Etcd                 *EtcdConfigApplyConfiguration            `json:"etcd,omitempty"`
-	ExtraArgs            []string                                 `json:"extraArgs,omitempty"`
-	ExtraVolumes         []v1.Volume                              `json:"extraVolumes,omitempty"`
-	ExtraVolumeMounts    []v1.VolumeMount                         `json:"extraVolumeMounts,omitempty"`
+	ClusterDomain        *string                                        `json:"clusterDomain,omitempty"`
+	Placement            *PlacementApplyConfiguration                   `json:"placement,omitempty"`
+	Image                *ImageSpecApplyConfiguration                   `json:"image,omitempty"`
+	Replicas             *int32                                         `json:"replicas,omitempty"`
+	Logging              *LoggingSpecApplyConfiguration                 `json:"logging,omitempty"`
+	Certificates         *CertificatesApplyConfiguration                `json:"certificates,omitempty"`
+	CertificateTemplates *operatorv1alpha1.CertificateTemplateMap       `json:"certificateTemplates,omitempty"`
+	ServiceTemplate      *ServiceTemplateApplyConfiguration             `json:"serviceTemplate,omitempty"`
+	DeploymentTemplate   *DeploymentTemplateApplyConfiguration          `json:"deploymentTemplate,omitempty"`
+	PodDisruptionBudget  *PodDisruptionBudgetTemplateApplyConfiguration `json:"podDisruptionBudget,omitempty"`
+	Etcd                 *EtcdConfigApplyConfiguration                  `json:"etcd,omitempty"`
+	ExtraArgs            []string                                       `json:"extraArgs,omitempty"`
+	ExtraVolumes         []v1.Volume                                    `json:"extraVolumes,omitempty"`
+	ExtraVolumeMounts    []v1.VolumeMount                               `json:"extraVolumeMounts,omitempty"`
 }
 
 // CacheServerSpecApplyConfiguration constructs a declarative configuration of the CacheServerSpec type for use with
@@ -55,6 +57,14 @@ func (b *CacheServerSpecApplyConfiguration) WithClusterDomain(value string) *Cac
 	return b
 }
 
+// WithPlacement sets the Placement field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Placement field is set to the value of the last call.
+func (b *CacheServerSpecApplyConfiguration) WithPlacement(value *PlacementApplyConfiguration) *CacheServerSpecApplyConfiguration {
+	b.Placement = value
+	return b
+}
+
 // WithImage sets the Image field in the declarative configuration to the given value
 // and returns the receiver, so that objects can be built by chaining "With" function invocations.
 // If called multiple times, the Image field is set to the value of the last call.
@@ -111,6 +121,14 @@ func (b *CacheServerSpecApplyConfiguration) WithDeploymentTemplate(value *Deploy
 	return b
 }
 
+// WithPodDisruptionBudget sets the PodDisruptionBudget field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the PodDisruptionBudget field is set to the value of the last call.
+func (b *CacheServerSpecApplyConfiguration) WithPodDisruptionBudget(value *PodDisruptionBudgetTemplateApplyConfiguration) *CacheServerSpecApplyConfiguration {
+	b.PodDisruptionBudget = value
+	return b
+}
+
 // WithEtcd sets the Etcd field in the declarative configuration to the given value
 // and returns the receiver, so that objects can be built by chaining "With" function invocations.
 // If called multiple times, the Etcd field is set to the value of the last call.
@@ -27,25 +27,28 @@ import (
 // CommonShardSpecApplyConfiguration represents a declarative configuration of the CommonShardSpec type for use
 // with apply.
 type CommonShardSpecApplyConfiguration struct {
-	ClusterDomain        *string                                  `json:"clusterDomain,omitempty"`
-	ShardBaseURL         *string                                  `json:"shardBaseURL,omitempty"`
-	Etcd                 *EtcdConfigApplyConfiguration            `json:"etcd,omitempty"`
-	Image                *ImageSpecApplyConfiguration             `json:"image,omitempty"`
-	Replicas             *int32                                   `json:"replicas,omitempty"`
-	Resources            *v1.ResourceRequirements                 `json:"resources,omitempty"`
-	Audit                *AuditSpecApplyConfiguration             `json:"audit,omitempty"`
-	Authorization        *AuthorizationSpecApplyConfiguration     `json:"authorization,omitempty"`
-	Auth                 *AuthSpecApplyConfiguration              `json:"auth,omitempty"`
-	KCPVirtualWorkspace  *v1.LocalObjectReference                 `json:"kcpVirtualWorkspace,omitempty"`
-	CertificateTemplates *operatorv1alpha1.CertificateTemplateMap `json:"certificateTemplates,omitempty"`
-	ServiceTemplate      *ServiceTemplateApplyConfiguration       `json:"serviceTemplate,omitempty"`
-	DeploymentTemplate   *DeploymentTemplateApplyConfiguration    `json:"deploymentTemplate,omitempty"`
-	CABundleSecretRef    *v1.LocalObjectReference                 `json:"caBundleSecretRef,omitempty"`
-	ClientCABundleRef    *v1.LocalObjectReference                 `json:"clientCABundleRef,omitempty"`
-	ExtraArgs            []string                                 `json:"extraArgs,omitempty"`
-	ExtraVolumes         []v1.Volume                              `json:"extraVolumes,omitempty"`
-	ExtraVolumeMounts    []v1.VolumeMount                         `json:"extraVolumeMounts,omitempty"`
-	Logging              *LoggingSpecApplyConfiguration           `json:"logging,omitempty"`
+	ClusterDomain        *string                                        `json:"clusterDomain,omitempty"`
+	Placement            *PlacementApplyConfiguration                   `json:"placement,omitempty"`
+	ShardBaseURL         *string                                        `json:"shardBaseURL,omitempty"`
+	Etcd                 *EtcdConfigApplyConfiguration                  `json:"etcd,omitempty"`
+	Image                *ImageSpecApplyConfiguration                   `json:"image,omitempty"`
+	Replicas             *int32                                         `json:"replicas,omitempty"`
+	Resources            *v1.ResourceRequirements                       `json:"resources,omitempty"`
+	Audit                *AuditSpecApplyConfiguration                   `json:"audit,omitempty"`
+	Authorization        *AuthorizationSpecApplyConfiguration           `json:"authorization,omitempty"`
+	Auth                 *AuthSpecApplyConfiguration                    `json:"auth,omitempty"`
+	KCPVirtualWorkspace  *v1.LocalObjectReference                       `json:"kcpVirtualWorkspace,omitempty"`
+	CertificateTemplates *operatorv1alpha1.CertificateTemplateMap       `json:"certificateTemplates,omitempty"`
+	ServiceTemplate      *ServiceTemplateApplyConfiguration             `json:"serviceTemplate,omitempty"`
+	DeploymentTemplate   *DeploymentTemplateApplyConfiguration          `json:"deploymentTemplate,omitempty"`
+	PodDisruptionBudget  *PodDisruptionBudgetTemplateApplyConfiguration `json:"podDisruptionBudget,omitempty"`
+	CABundleSecretRef    *v1.LocalObjectReference                       `json:"caBundleSecretRef,omitempty"`
+	CABundleConfigMapRef *LocalDataKeyReferenceApplyConfiguration       `json:"caBundleConfigMapRef,omitempty"`
+	ClientCABundleRef    *v1.LocalObjectReference                       `json:"clientCABundleRef,omitempty"`
+	ExtraArgs            []string                                       `json:"extraArgs,omitempty"`
+	ExtraVolumes         []v1.Volume                                    `json:"extraVolumes,omitempty"`
+	ExtraVolumeMounts    []v1.VolumeMount                               `json:"extraVolumeMounts,omitempty"`
+	Logging              *LoggingSpecApplyConfiguration                 `json:"logging,omitempty"`
 }
 
 // CommonShardSpecApplyConfiguration constructs a declarative configuration of the CommonShardSpec type for use with
@@ -62,6 +65,14 @@ func (b *CommonShardSpecApplyConfiguration) WithClusterDomain(value string) *Com
 	return b
 }
 
+// WithPlacement sets the Placement field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Placement field is set to the value of the last call.
+func (b *CommonShardSpecApplyConfiguration) WithPlacement(value *PlacementApplyConfiguration) *CommonShardSpecApplyConfiguration {
+	b.Placement = value
+	return b
+}
+
 // WithShardBaseURL sets the ShardBaseURL field in the declarative configuration to the given value
 // and returns the receiver, so that objects can be built by chaining "With" function invocations.
 // If called multiple times, the ShardBaseURL field is set to the value of the last call.
@@ -158,6 +169,14 @@ func (b *CommonShardSpecApplyConfiguration) WithDeploymentTemplate(value *Deploy
 	return b
 }
 
+// WithPodDisruptionBudget sets the PodDisruptionBudget field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the PodDisruptionBudget field is set to the value of the last call.
+func (b *CommonShardSpecApplyConfiguration) WithPodDisruptionBudget(value *PodDisruptionBudgetTemplateApplyConfiguration) *CommonShardSpecApplyConfiguration {
+	b.PodDisruptionBudget = value
+	return b
+}
+
 // WithCABundleSecretRef sets the CABundleSecretRef field in the declarative configuration to the given value
 // and returns the receiver, so that objects can be built by chaining "With" function invocations.
 // If called multiple times, the CABundleSecretRef field is set to the value of the last call.
@@ -166,6 +185,14 @@ func (b *CommonShardSpecApplyConfiguration) WithCABundleSecretRef(value v1.Local
 	return b
 }
 
+// WithCABundleConfigMapRef sets the CABundleConfigMapRef field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the CABundleConfigMapRef field is set to the value of the last call.
+func (b *CommonShardSpecApplyConfiguration) WithCABundleConfigMapRef(value *LocalDataKeyReferenceApplyConfiguration) *CommonShardSpecApplyConfiguration {
+	b.CABundleConfigMapRef = value
+	return b
+}
+
 // WithClientCABundleRef sets the ClientCABundleRef field in the declarative configuration to the given value
 // and returns the receiver, so that objects can be built by chaining "With" function invocations.
 // If called multiple times, the ClientCABundleRef field is set to the value of the last call.
@@ -21,9 +21,10 @@ package v1alpha1
 // EtcdConfigApplyConfiguration represents a declarative configuration of the EtcdConfig type for use
 // with apply.
 type EtcdConfigApplyConfiguration struct {
-	Endpoints []string                         `json:"endpoints,omitempty"`
-	TLSConfig *EtcdTLSConfigApplyConfiguration `json:"tlsConfig,omitempty"`
-	Prefix    *string                          `json:"prefix,omitempty"`
+	Endpoints []string                           `json:"endpoints,omitempty"`
+	TLSConfig *EtcdTLSConfigApplyConfiguration   `json:"tlsConfig,omitempty"`
+	Prefix    *string                            `json:"prefix,omitempty"`
+	Managed   *ManagedEtcdSpecApplyConfiguration `json:"managed,omitempty"`
 }
 
 // EtcdConfigApplyConfiguration constructs a declarative configuration of the EtcdConfig type for use with
@@ -57,3 +58,11 @@ func (b *EtcdConfigApplyConfiguration) WithPrefix(value string) *EtcdConfigApply
 	b.Prefix = &value
 	return b
 }
+
+// WithManaged sets the Managed field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Managed field is set to the value of the last call.
+func (b *EtcdConfigApplyConfiguration) WithManaged(value *ManagedEtcdSpecApplyConfiguration) *EtcdConfigApplyConfiguration {
+	b.Managed = value
+	return b
+}
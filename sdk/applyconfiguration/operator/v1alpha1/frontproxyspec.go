@@ -27,23 +27,27 @@ import (
 // FrontProxySpecApplyConfiguration represents a declarative configuration of the FrontProxySpec type for use
 // with apply.
 type FrontProxySpecApplyConfiguration struct {
-	RootShard              *RootShardConfigApplyConfiguration       `json:"rootShard,omitempty"`
-	Replicas               *int32                                   `json:"replicas,omitempty"`
-	Resources              *v1.ResourceRequirements                 `json:"resources,omitempty"`
-	Auth                   *AuthSpecApplyConfiguration              `json:"auth,omitempty"`
-	AdditionalPathMappings []PathMappingEntryApplyConfiguration     `json:"additionalPathMappings,omitempty"`
-	Image                  *ImageSpecApplyConfiguration             `json:"image,omitempty"`
-	ExternalHostname       *string                                  `json:"externalHostname,omitempty"`
-	External               *ExternalConfigApplyConfiguration        `json:"external,omitempty"`
-	ServiceTemplate        *ServiceTemplateApplyConfiguration       `json:"serviceTemplate,omitempty"`
-	DeploymentTemplate     *DeploymentTemplateApplyConfiguration    `json:"deploymentTemplate,omitempty"`
-	CertificateTemplates   *operatorv1alpha1.CertificateTemplateMap `json:"certificateTemplates,omitempty"`
-	CABundleSecretRef      *v1.LocalObjectReference                 `json:"caBundleSecretRef,omitempty"`
-	ClientCABundleRef      *v1.LocalObjectReference                 `json:"clientCABundleRef,omitempty"`
-	ExtraArgs              []string                                 `json:"extraArgs,omitempty"`
-	ExtraVolumes           []v1.Volume                              `json:"extraVolumes,omitempty"`
-	ExtraVolumeMounts      []v1.VolumeMount                         `json:"extraVolumeMounts,omitempty"`
-	Logging                *LoggingSpecApplyConfiguration           `json:"logging,omitempty"`
+	RootShard              *RootShardConfigApplyConfiguration             `json:"rootShard,omitempty"`
+	Replicas               *int32                                         `json:"replicas,omitempty"`
+	Autoscaling            *AutoscalingSpecApplyConfiguration             `json:"autoscaling,omitempty"`
+	Resources              *v1.ResourceRequirements                       `json:"resources,omitempty"`
+	Auth                   *AuthSpecApplyConfiguration                    `json:"auth,omitempty"`
+	AdditionalPathMappings []PathMappingEntryApplyConfiguration           `json:"additionalPathMappings,omitempty"`
+	Image                  *ImageSpecApplyConfiguration                   `json:"image,omitempty"`
+	ExternalHostname       *string                                        `json:"externalHostname,omitempty"`
+	External               *ExternalConfigApplyConfiguration              `json:"external,omitempty"`
+	ServiceTemplate        *ServiceTemplateApplyConfiguration             `json:"serviceTemplate,omitempty"`
+	DeploymentTemplate     *DeploymentTemplateApplyConfiguration          `json:"deploymentTemplate,omitempty"`
+	PodDisruptionBudget    *PodDisruptionBudgetTemplateApplyConfiguration `json:"podDisruptionBudget,omitempty"`
+	Placement              *PlacementApplyConfiguration                   `json:"placement,omitempty"`
+	CertificateTemplates   *operatorv1alpha1.CertificateTemplateMap       `json:"certificateTemplates,omitempty"`
+	CABundleSecretRef      *v1.LocalObjectReference                       `json:"caBundleSecretRef,omitempty"`
+	CABundleConfigMapRef   *LocalDataKeyReferenceApplyConfiguration       `json:"caBundleConfigMapRef,omitempty"`
+	ClientCABundleRef      *v1.LocalObjectReference                       `json:"clientCABundleRef,omitempty"`
+	ExtraArgs              []string                                       `json:"extraArgs,omitempty"`
+	ExtraVolumes           []v1.Volume                                    `json:"extraVolumes,omitempty"`
+	ExtraVolumeMounts      []v1.VolumeMount                               `json:"extraVolumeMounts,omitempty"`
+	Logging                *LoggingSpecApplyConfiguration                 `json:"logging,omitempty"`
 }
 
 // FrontProxySpecApplyConfiguration constructs a declarative configuration of the FrontProxySpec type for use with
@@ -68,6 +72,14 @@ func (b *FrontProxySpecApplyConfiguration) WithReplicas(value int32) *FrontProxy
 	return b
 }
 
+// WithAutoscaling sets the Autoscaling field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Autoscaling field is set to the value of the last call.
+func (b *FrontProxySpecApplyConfiguration) WithAutoscaling(value *AutoscalingSpecApplyConfiguration) *FrontProxySpecApplyConfiguration {
+	b.Autoscaling = value
+	return b
+}
+
 // WithResources sets the Resources field in the declarative configuration to the given value
 // and returns the receiver, so that objects can be built by chaining "With" function invocations.
 // If called multiple times, the Resources field is set to the value of the last call.
@@ -137,6 +149,22 @@ func (b *FrontProxySpecApplyConfiguration) WithDeploymentTemplate(value *Deploym
 	return b
 }
 
+// WithPodDisruptionBudget sets the PodDisruptionBudget field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the PodDisruptionBudget field is set to the value of the last call.
+func (b *FrontProxySpecApplyConfiguration) WithPodDisruptionBudget(value *PodDisruptionBudgetTemplateApplyConfiguration) *FrontProxySpecApplyConfiguration {
+	b.PodDisruptionBudget = value
+	return b
+}
+
+// WithPlacement sets the Placement field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Placement field is set to the value of the last call.
+func (b *FrontProxySpecApplyConfiguration) WithPlacement(value *PlacementApplyConfiguration) *FrontProxySpecApplyConfiguration {
+	b.Placement = value
+	return b
+}
+
 // WithCertificateTemplates sets the CertificateTemplates field in the declarative configuration to the given value
 // and returns the receiver, so that objects can be built by chaining "With" function invocations.
 // If called multiple times, the CertificateTemplates field is set to the value of the last call.
@@ -153,6 +181,14 @@ func (b *FrontProxySpecApplyConfiguration) WithCABundleSecretRef(value v1.LocalO
 	return b
 }
 
+// WithCABundleConfigMapRef sets the CABundleConfigMapRef field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the CABundleConfigMapRef field is set to the value of the last call.
+func (b *FrontProxySpecApplyConfiguration) WithCABundleConfigMapRef(value *LocalDataKeyReferenceApplyConfiguration) *FrontProxySpecApplyConfiguration {
+	b.CABundleConfigMapRef = value
+	return b
+}
+
 // WithClientCABundleRef sets the ClientCABundleRef field in the declarative configuration to the given value
 // and returns the receiver, so that objects can be built by chaining "With" function invocations.
 // If called multiple times, the ClientCABundleRef field is set to the value of the last call.
@@ -27,8 +27,12 @@ import (
 // FrontProxyStatusApplyConfiguration represents a declarative configuration of the FrontProxyStatus type for use
 // with apply.
 type FrontProxyStatusApplyConfiguration struct {
-	Phase      *operatorv1alpha1.FrontProxyPhase `json:"phase,omitempty"`
-	Conditions []v1.ConditionApplyConfiguration  `json:"conditions,omitempty"`
+	Phase               *operatorv1alpha1.FrontProxyPhase            `json:"phase,omitempty"`
+	Conditions          []v1.ConditionApplyConfiguration             `json:"conditions,omitempty"`
+	Version             *VersionStatusApplyConfiguration             `json:"version,omitempty"`
+	Placement           *PlacementStatusApplyConfiguration           `json:"placement,omitempty"`
+	CertificateRotation *CertificateRotationStatusApplyConfiguration `json:"certificateRotation,omitempty"`
+	Certificates        []CertificateStatusApplyConfiguration        `json:"certificates,omitempty"`
 }
 
 // FrontProxyStatusApplyConfiguration constructs a declarative configuration of the FrontProxyStatus type for use with
@@ -57,3 +61,40 @@ func (b *FrontProxyStatusApplyConfiguration) WithConditions(values ...*v1.Condit
 	}
 	return b
 }
+
+// WithVersion sets the Version field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Version field is set to the value of the last call.
+func (b *FrontProxyStatusApplyConfiguration) WithVersion(value *VersionStatusApplyConfiguration) *FrontProxyStatusApplyConfiguration {
+	b.Version = value
+	return b
+}
+
+// WithPlacement sets the Placement field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Placement field is set to the value of the last call.
+func (b *FrontProxyStatusApplyConfiguration) WithPlacement(value *PlacementStatusApplyConfiguration) *FrontProxyStatusApplyConfiguration {
+	b.Placement = value
+	return b
+}
+
+// WithCertificateRotation sets the CertificateRotation field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the CertificateRotation field is set to the value of the last call.
+func (b *FrontProxyStatusApplyConfiguration) WithCertificateRotation(value *CertificateRotationStatusApplyConfiguration) *FrontProxyStatusApplyConfiguration {
+	b.CertificateRotation = value
+	return b
+}
+
+// WithCertificates adds the given value to the Certificates field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the Certificates field.
+func (b *FrontProxyStatusApplyConfiguration) WithCertificates(values ...*CertificateStatusApplyConfiguration) *FrontProxyStatusApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithCertificates")
+		}
+		b.Certificates = append(b.Certificates, *values[i])
+	}
+	return b
+}
@@ -27,16 +27,18 @@ import (
 // RootShardProxySpecApplyConfiguration represents a declarative configuration of the RootShardProxySpec type for use
 // with apply.
 type RootShardProxySpecApplyConfiguration struct {
-	Image                *ImageSpecApplyConfiguration             `json:"image,omitempty"`
-	Replicas             *int32                                   `json:"replicas,omitempty"`
-	Resources            *v1.ResourceRequirements                 `json:"resources,omitempty"`
-	ServiceTemplate      *ServiceTemplateApplyConfiguration       `json:"serviceTemplate,omitempty"`
-	DeploymentTemplate   *DeploymentTemplateApplyConfiguration    `json:"deploymentTemplate,omitempty"`
-	CertificateTemplates *operatorv1alpha1.CertificateTemplateMap `json:"certificateTemplates,omitempty"`
-	ExtraArgs            []string                                 `json:"extraArgs,omitempty"`
-	ExtraVolumes         []v1.Volume                              `json:"extraVolumes,omitempty"`
-	ExtraVolumeMounts    []v1.VolumeMount                         `json:"extraVolumeMounts,omitempty"`
-	Logging              *LoggingSpecApplyConfiguration           `json:"logging,omitempty"`
+	Image                *ImageSpecApplyConfiguration                   `json:"image,omitempty"`
+	Replicas             *int32                                         `json:"replicas,omitempty"`
+	Autoscaling          *AutoscalingSpecApplyConfiguration             `json:"autoscaling,omitempty"`
+	Resources            *v1.ResourceRequirements                       `json:"resources,omitempty"`
+	ServiceTemplate      *ServiceTemplateApplyConfiguration             `json:"serviceTemplate,omitempty"`
+	DeploymentTemplate   *DeploymentTemplateApplyConfiguration          `json:"deploymentTemplate,omitempty"`
+	PodDisruptionBudget  *PodDisruptionBudgetTemplateApplyConfiguration `json:"podDisruptionBudget,omitempty"`
+	CertificateTemplates *operatorv1alpha1.CertificateTemplateMap       `json:"certificateTemplates,omitempty"`
+	ExtraArgs            []string                                       `json:"extraArgs,omitempty"`
+	ExtraVolumes         []v1.Volume                                    `json:"extraVolumes,omitempty"`
+	ExtraVolumeMounts    []v1.VolumeMount                               `json:"extraVolumeMounts,omitempty"`
+	Logging              *LoggingSpecApplyConfiguration                 `json:"logging,omitempty"`
 }
 
 // RootShardProxySpecApplyConfiguration constructs a declarative configuration of the RootShardProxySpec type for use with
@@ -61,6 +63,14 @@ func (b *RootShardProxySpecApplyConfiguration) WithReplicas(value int32) *RootSh
 	return b
 }
 
+// WithAutoscaling sets the Autoscaling field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Autoscaling field is set to the value of the last call.
+func (b *RootShardProxySpecApplyConfiguration) WithAutoscaling(value *AutoscalingSpecApplyConfiguration) *RootShardProxySpecApplyConfiguration {
+	b.Autoscaling = value
+	return b
+}
+
 // WithResources sets the Resources field in the declarative configuration to the given value
 // and returns the receiver, so that objects can be built by chaining "With" function invocations.
 // If called multiple times, the Resources field is set to the value of the last call.
@@ -85,6 +95,14 @@ func (b *RootShardProxySpecApplyConfiguration) WithDeploymentTemplate(value *Dep
 	return b
 }
 
+// WithPodDisruptionBudget sets the PodDisruptionBudget field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the PodDisruptionBudget field is set to the value of the last call.
+func (b *RootShardProxySpecApplyConfiguration) WithPodDisruptionBudget(value *PodDisruptionBudgetTemplateApplyConfiguration) *RootShardProxySpecApplyConfiguration {
+	b.PodDisruptionBudget = value
+	return b
+}
+
 // WithCertificateTemplates sets the CertificateTemplates field in the declarative configuration to the given value
 // and returns the receiver, so that objects can be built by chaining "With" function invocations.
 // If called multiple times, the CertificateTemplates field is set to the value of the last call.
@@ -32,6 +32,7 @@ type RootShardSpecApplyConfiguration struct {
 	Cache                             *RootShardCacheConfigApplyConfiguration `json:"cache,omitempty"`
 	Proxy                             *RootShardProxySpecApplyConfiguration   `json:"proxy,omitempty"`
 	Certificates                      *CertificatesApplyConfiguration         `json:"certificates,omitempty"`
+	TrustBundle                       *TrustBundleSpecApplyConfiguration      `json:"trustBundle,omitempty"`
 }
 
 // RootShardSpecApplyConfiguration constructs a declarative configuration of the RootShardSpec type for use with
@@ -48,6 +49,14 @@ func (b *RootShardSpecApplyConfiguration) WithClusterDomain(value string) *RootS
 	return b
 }
 
+// WithPlacement sets the Placement field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Placement field is set to the value of the last call.
+func (b *RootShardSpecApplyConfiguration) WithPlacement(value *PlacementApplyConfiguration) *RootShardSpecApplyConfiguration {
+	b.CommonShardSpecApplyConfiguration.Placement = value
+	return b
+}
+
 // WithShardBaseURL sets the ShardBaseURL field in the declarative configuration to the given value
 // and returns the receiver, so that objects can be built by chaining "With" function invocations.
 // If called multiple times, the ShardBaseURL field is set to the value of the last call.
@@ -144,6 +153,14 @@ func (b *RootShardSpecApplyConfiguration) WithDeploymentTemplate(value *Deployme
 	return b
 }
 
+// WithPodDisruptionBudget sets the PodDisruptionBudget field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the PodDisruptionBudget field is set to the value of the last call.
+func (b *RootShardSpecApplyConfiguration) WithPodDisruptionBudget(value *PodDisruptionBudgetTemplateApplyConfiguration) *RootShardSpecApplyConfiguration {
+	b.CommonShardSpecApplyConfiguration.PodDisruptionBudget = value
+	return b
+}
+
 // WithCABundleSecretRef sets the CABundleSecretRef field in the declarative configuration to the given value
 // and returns the receiver, so that objects can be built by chaining "With" function invocations.
 // If called multiple times, the CABundleSecretRef field is set to the value of the last call.
@@ -152,6 +169,14 @@ func (b *RootShardSpecApplyConfiguration) WithCABundleSecretRef(value v1.LocalOb
 	return b
 }
 
+// WithCABundleConfigMapRef sets the CABundleConfigMapRef field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the CABundleConfigMapRef field is set to the value of the last call.
+func (b *RootShardSpecApplyConfiguration) WithCABundleConfigMapRef(value *LocalDataKeyReferenceApplyConfiguration) *RootShardSpecApplyConfiguration {
+	b.CommonShardSpecApplyConfiguration.CABundleConfigMapRef = value
+	return b
+}
+
 // WithClientCABundleRef sets the ClientCABundleRef field in the declarative configuration to the given value
 // and returns the receiver, so that objects can be built by chaining "With" function invocations.
 // If called multiple times, the ClientCABundleRef field is set to the value of the last call.
@@ -229,3 +254,11 @@ func (b *RootShardSpecApplyConfiguration) WithCertificates(value *CertificatesAp
 	b.Certificates = value
 	return b
 }
+
+// WithTrustBundle sets the TrustBundle field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the TrustBundle field is set to the value of the last call.
+func (b *RootShardSpecApplyConfiguration) WithTrustBundle(value *TrustBundleSpecApplyConfiguration) *RootShardSpecApplyConfiguration {
+	b.TrustBundle = value
+	return b
+}
@@ -27,9 +27,15 @@ import (
 // RootShardStatusApplyConfiguration represents a declarative configuration of the RootShardStatus type for use
 // with apply.
 type RootShardStatusApplyConfiguration struct {
-	Phase      *operatorv1alpha1.RootShardPhase   `json:"phase,omitempty"`
-	Conditions []v1.ConditionApplyConfiguration   `json:"conditions,omitempty"`
-	Shards     []ShardReferenceApplyConfiguration `json:"shards,omitempty"`
+	Phase               *operatorv1alpha1.RootShardPhase             `json:"phase,omitempty"`
+	Conditions          []v1.ConditionApplyConfiguration             `json:"conditions,omitempty"`
+	Shards              []ShardReferenceApplyConfiguration           `json:"shards,omitempty"`
+	Upgrade             *UpgradeStatusApplyConfiguration             `json:"upgrade,omitempty"`
+	Version             *VersionStatusApplyConfiguration             `json:"version,omitempty"`
+	Placement           *PlacementStatusApplyConfiguration           `json:"placement,omitempty"`
+	CARotation          *CARotationStatusApplyConfiguration          `json:"caRotation,omitempty"`
+	CertificateRotation *CertificateRotationStatusApplyConfiguration `json:"certificateRotation,omitempty"`
+	Certificates        []CertificateStatusApplyConfiguration        `json:"certificates,omitempty"`
 }
 
 // RootShardStatusApplyConfiguration constructs a declarative configuration of the RootShardStatus type for use with
@@ -71,3 +77,56 @@ func (b *RootShardStatusApplyConfiguration) WithShards(values ...*ShardReference
 	}
 	return b
 }
+
+// WithUpgrade sets the Upgrade field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Upgrade field is set to the value of the last call.
+func (b *RootShardStatusApplyConfiguration) WithUpgrade(value *UpgradeStatusApplyConfiguration) *RootShardStatusApplyConfiguration {
+	b.Upgrade = value
+	return b
+}
+
+// WithVersion sets the Version field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Version field is set to the value of the last call.
+func (b *RootShardStatusApplyConfiguration) WithVersion(value *VersionStatusApplyConfiguration) *RootShardStatusApplyConfiguration {
+	b.Version = value
+	return b
+}
+
+// WithPlacement sets the Placement field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Placement field is set to the value of the last call.
+func (b *RootShardStatusApplyConfiguration) WithPlacement(value *PlacementStatusApplyConfiguration) *RootShardStatusApplyConfiguration {
+	b.Placement = value
+	return b
+}
+
+// WithCARotation sets the CARotation field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the CARotation field is set to the value of the last call.
+func (b *RootShardStatusApplyConfiguration) WithCARotation(value *CARotationStatusApplyConfiguration) *RootShardStatusApplyConfiguration {
+	b.CARotation = value
+	return b
+}
+
+// WithCertificateRotation sets the CertificateRotation field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the CertificateRotation field is set to the value of the last call.
+func (b *RootShardStatusApplyConfiguration) WithCertificateRotation(value *CertificateRotationStatusApplyConfiguration) *RootShardStatusApplyConfiguration {
+	b.CertificateRotation = value
+	return b
+}
+
+// WithCertificates adds the given value to the Certificates field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the Certificates field.
+func (b *RootShardStatusApplyConfiguration) WithCertificates(values ...*CertificateStatusApplyConfiguration) *RootShardStatusApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithCertificates")
+		}
+		b.Certificates = append(b.Certificates, *values[i])
+	}
+	return b
+}
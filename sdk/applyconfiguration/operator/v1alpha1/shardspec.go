@@ -46,6 +46,14 @@ func (b *ShardSpecApplyConfiguration) WithClusterDomain(value string) *ShardSpec
 	return b
 }
 
+// WithPlacement sets the Placement field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Placement field is set to the value of the last call.
+func (b *ShardSpecApplyConfiguration) WithPlacement(value *PlacementApplyConfiguration) *ShardSpecApplyConfiguration {
+	b.CommonShardSpecApplyConfiguration.Placement = value
+	return b
+}
+
 // WithShardBaseURL sets the ShardBaseURL field in the declarative configuration to the given value
 // and returns the receiver, so that objects can be built by chaining "With" function invocations.
 // If called multiple times, the ShardBaseURL field is set to the value of the last call.
@@ -142,6 +150,14 @@ func (b *ShardSpecApplyConfiguration) WithDeploymentTemplate(value *DeploymentTe
 	return b
 }
 
+// WithPodDisruptionBudget sets the PodDisruptionBudget field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the PodDisruptionBudget field is set to the value of the last call.
+func (b *ShardSpecApplyConfiguration) WithPodDisruptionBudget(value *PodDisruptionBudgetTemplateApplyConfiguration) *ShardSpecApplyConfiguration {
+	b.CommonShardSpecApplyConfiguration.PodDisruptionBudget = value
+	return b
+}
+
 // WithCABundleSecretRef sets the CABundleSecretRef field in the declarative configuration to the given value
 // and returns the receiver, so that objects can be built by chaining "With" function invocations.
 // If called multiple times, the CABundleSecretRef field is set to the value of the last call.
@@ -150,6 +166,14 @@ func (b *ShardSpecApplyConfiguration) WithCABundleSecretRef(value v1.LocalObject
 	return b
 }
 
+// WithCABundleConfigMapRef sets the CABundleConfigMapRef field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the CABundleConfigMapRef field is set to the value of the last call.
+func (b *ShardSpecApplyConfiguration) WithCABundleConfigMapRef(value *LocalDataKeyReferenceApplyConfiguration) *ShardSpecApplyConfiguration {
+	b.CommonShardSpecApplyConfiguration.CABundleConfigMapRef = value
+	return b
+}
+
 // WithClientCABundleRef sets the ClientCABundleRef field in the declarative configuration to the given value
 // and returns the receiver, so that objects can be built by chaining "With" function invocations.
 // If called multiple times, the ClientCABundleRef field is set to the value of the last call.
@@ -37,6 +37,7 @@ type VirtualWorkspaceSpecApplyConfiguration struct {
 	CertificateTemplates *operatorv1alpha1.CertificateTemplateMap          `json:"certificateTemplates,omitempty"`
 	ServiceTemplate      *ServiceTemplateApplyConfiguration                `json:"serviceTemplate,omitempty"`
 	DeploymentTemplate   *DeploymentTemplateApplyConfiguration             `json:"deploymentTemplate,omitempty"`
+	PodDisruptionBudget  *PodDisruptionBudgetTemplateApplyConfiguration    `json:"podDisruptionBudget,omitempty"`
 	CABundleSecretRef    *v1.LocalObjectReference                          `json:"caBundleSecretRef,omitempty"`
 	ClientCABundleRef    *v1.LocalObjectReference                          `json:"clientCABundleRef,omitempty"`
 	KubeconfigSecretRef  *v1.LocalObjectReference                          `json:"kubeconfigSecretRef,omitempty"`
@@ -45,6 +46,7 @@ type VirtualWorkspaceSpecApplyConfiguration struct {
 	ExtraVolumeMounts    []v1.VolumeMount                                  `json:"extraVolumeMounts,omitempty"`
 	Logging              *LoggingSpecApplyConfiguration                    `json:"logging,omitempty"`
 	ClusterDomain        *string                                           `json:"clusterDomain,omitempty"`
+	Placement            *PlacementApplyConfiguration                      `json:"placement,omitempty"`
 }
 
 // VirtualWorkspaceSpecApplyConfiguration constructs a declarative configuration of the VirtualWorkspaceSpec type for use with
@@ -140,6 +142,14 @@ func (b *VirtualWorkspaceSpecApplyConfiguration) WithDeploymentTemplate(value *D
 	return b
 }
 
+// WithPodDisruptionBudget sets the PodDisruptionBudget field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the PodDisruptionBudget field is set to the value of the last call.
+func (b *VirtualWorkspaceSpecApplyConfiguration) WithPodDisruptionBudget(value *PodDisruptionBudgetTemplateApplyConfiguration) *VirtualWorkspaceSpecApplyConfiguration {
+	b.PodDisruptionBudget = value
+	return b
+}
+
 // WithCABundleSecretRef sets the CABundleSecretRef field in the declarative configuration to the given value
 // and returns the receiver, so that objects can be built by chaining "With" function invocations.
 // If called multiple times, the CABundleSecretRef field is set to the value of the last call.
@@ -209,3 +219,11 @@ func (b *VirtualWorkspaceSpecApplyConfiguration) WithClusterDomain(value string)
 	b.ClusterDomain = &value
 	return b
 }
+
+// WithPlacement sets the Placement field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Placement field is set to the value of the last call.
+func (b *VirtualWorkspaceSpecApplyConfiguration) WithPlacement(value *PlacementApplyConfiguration) *VirtualWorkspaceSpecApplyConfiguration {
+	b.Placement = value
+	return b
+}
@@ -25,7 +25,11 @@ import (
 // VirtualWorkspaceStatusApplyConfiguration represents a declarative configuration of the VirtualWorkspaceStatus type for use
 // with apply.
 type VirtualWorkspaceStatusApplyConfiguration struct {
-	Conditions []v1.ConditionApplyConfiguration `json:"conditions,omitempty"`
+	Conditions          []v1.ConditionApplyConfiguration             `json:"conditions,omitempty"`
+	Version             *VersionStatusApplyConfiguration             `json:"version,omitempty"`
+	Placement           *PlacementStatusApplyConfiguration           `json:"placement,omitempty"`
+	CertificateRotation *CertificateRotationStatusApplyConfiguration `json:"certificateRotation,omitempty"`
+	Certificates        []CertificateStatusApplyConfiguration        `json:"certificates,omitempty"`
 }
 
 // VirtualWorkspaceStatusApplyConfiguration constructs a declarative configuration of the VirtualWorkspaceStatus type for use with
@@ -46,3 +50,40 @@ func (b *VirtualWorkspaceStatusApplyConfiguration) WithConditions(values ...*v1.
 	}
 	return b
 }
+
+// WithVersion sets the Version field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Version field is set to the value of the last call.
+func (b *VirtualWorkspaceStatusApplyConfiguration) WithVersion(value *VersionStatusApplyConfiguration) *VirtualWorkspaceStatusApplyConfiguration {
+	b.Version = value
+	return b
+}
+
+// WithPlacement sets the Placement field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Placement field is set to the value of the last call.
+func (b *VirtualWorkspaceStatusApplyConfiguration) WithPlacement(value *PlacementStatusApplyConfiguration) *VirtualWorkspaceStatusApplyConfiguration {
+	b.Placement = value
+	return b
+}
+
+// WithCertificateRotation sets the CertificateRotation field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the CertificateRotation field is set to the value of the last call.
+func (b *VirtualWorkspaceStatusApplyConfiguration) WithCertificateRotation(value *CertificateRotationStatusApplyConfiguration) *VirtualWorkspaceStatusApplyConfiguration {
+	b.CertificateRotation = value
+	return b
+}
+
+// WithCertificates adds the given value to the Certificates field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the Certificates field.
+func (b *VirtualWorkspaceStatusApplyConfiguration) WithCertificates(values ...*CertificateStatusApplyConfiguration) *VirtualWorkspaceStatusApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithCertificates")
+		}
+		b.Certificates = append(b.Certificates, *values[i])
+	}
+	return b
+}
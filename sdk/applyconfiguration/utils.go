@@ -77,24 +77,36 @@ func ForKind(kind schema.GroupVersionKind) interface{} {
 		return &applyconfigurationoperatorv1alpha1.AuthorizationWebhookSpecApplyConfiguration{}
 	case operatorv1alpha1.SchemeGroupVersion.WithKind("AuthSpec"):
 		return &applyconfigurationoperatorv1alpha1.AuthSpecApplyConfiguration{}
+	case operatorv1alpha1.SchemeGroupVersion.WithKind("AutoscalingSpec"):
+		return &applyconfigurationoperatorv1alpha1.AutoscalingSpecApplyConfiguration{}
 	case operatorv1alpha1.SchemeGroupVersion.WithKind("CacheServer"):
 		return &applyconfigurationoperatorv1alpha1.CacheServerApplyConfiguration{}
 	case operatorv1alpha1.SchemeGroupVersion.WithKind("CacheServerSpec"):
 		return &applyconfigurationoperatorv1alpha1.CacheServerSpecApplyConfiguration{}
+	case operatorv1alpha1.SchemeGroupVersion.WithKind("CacheServerStatus"):
+		return &applyconfigurationoperatorv1alpha1.CacheServerStatusApplyConfiguration{}
+	case operatorv1alpha1.SchemeGroupVersion.WithKind("CARotationStatus"):
+		return &applyconfigurationoperatorv1alpha1.CARotationStatusApplyConfiguration{}
 	case operatorv1alpha1.SchemeGroupVersion.WithKind("CertificateMetadataTemplate"):
 		return &applyconfigurationoperatorv1alpha1.CertificateMetadataTemplateApplyConfiguration{}
 	case operatorv1alpha1.SchemeGroupVersion.WithKind("CertificatePrivateKeyTemplate"):
 		return &applyconfigurationoperatorv1alpha1.CertificatePrivateKeyTemplateApplyConfiguration{}
+	case operatorv1alpha1.SchemeGroupVersion.WithKind("CertificateRotationStatus"):
+		return &applyconfigurationoperatorv1alpha1.CertificateRotationStatusApplyConfiguration{}
 	case operatorv1alpha1.SchemeGroupVersion.WithKind("Certificates"):
 		return &applyconfigurationoperatorv1alpha1.CertificatesApplyConfiguration{}
 	case operatorv1alpha1.SchemeGroupVersion.WithKind("CertificateSecretTemplate"):
 		return &applyconfigurationoperatorv1alpha1.CertificateSecretTemplateApplyConfiguration{}
 	case operatorv1alpha1.SchemeGroupVersion.WithKind("CertificateSpecTemplate"):
 		return &applyconfigurationoperatorv1alpha1.CertificateSpecTemplateApplyConfiguration{}
+	case operatorv1alpha1.SchemeGroupVersion.WithKind("CertificateStatus"):
+		return &applyconfigurationoperatorv1alpha1.CertificateStatusApplyConfiguration{}
 	case operatorv1alpha1.SchemeGroupVersion.WithKind("CertificateTemplate"):
 		return &applyconfigurationoperatorv1alpha1.CertificateTemplateApplyConfiguration{}
 	case operatorv1alpha1.SchemeGroupVersion.WithKind("CommonShardSpec"):
 		return &applyconfigurationoperatorv1alpha1.CommonShardSpecApplyConfiguration{}
+	case operatorv1alpha1.SchemeGroupVersion.WithKind("ComponentUpgradeStatus"):
+		return &applyconfigurationoperatorv1alpha1.ComponentUpgradeStatusApplyConfiguration{}
 	case operatorv1alpha1.SchemeGroupVersion.WithKind("DeploymentMetadataTemplate"):
 		return &applyconfigurationoperatorv1alpha1.DeploymentMetadataTemplateApplyConfiguration{}
 	case operatorv1alpha1.SchemeGroupVersion.WithKind("DeploymentSpecTemplate"):
@@ -103,8 +115,30 @@ func ForKind(kind schema.GroupVersionKind) interface{} {
 		return &applyconfigurationoperatorv1alpha1.DeploymentTemplateApplyConfiguration{}
 	case operatorv1alpha1.SchemeGroupVersion.WithKind("EmbeddedCacheConfiguration"):
 		return &applyconfigurationoperatorv1alpha1.EmbeddedCacheConfigurationApplyConfiguration{}
+	case operatorv1alpha1.SchemeGroupVersion.WithKind("EtcdBackupSchedule"):
+		return &applyconfigurationoperatorv1alpha1.EtcdBackupScheduleApplyConfiguration{}
+	case operatorv1alpha1.SchemeGroupVersion.WithKind("EtcdBackupScheduleSpec"):
+		return &applyconfigurationoperatorv1alpha1.EtcdBackupScheduleSpecApplyConfiguration{}
+	case operatorv1alpha1.SchemeGroupVersion.WithKind("EtcdBackupScheduleStatus"):
+		return &applyconfigurationoperatorv1alpha1.EtcdBackupScheduleStatusApplyConfiguration{}
 	case operatorv1alpha1.SchemeGroupVersion.WithKind("EtcdConfig"):
 		return &applyconfigurationoperatorv1alpha1.EtcdConfigApplyConfiguration{}
+	case operatorv1alpha1.SchemeGroupVersion.WithKind("EtcdRestore"):
+		return &applyconfigurationoperatorv1alpha1.EtcdRestoreApplyConfiguration{}
+	case operatorv1alpha1.SchemeGroupVersion.WithKind("EtcdRestoreSpec"):
+		return &applyconfigurationoperatorv1alpha1.EtcdRestoreSpecApplyConfiguration{}
+	case operatorv1alpha1.SchemeGroupVersion.WithKind("EtcdRestoreStatus"):
+		return &applyconfigurationoperatorv1alpha1.EtcdRestoreStatusApplyConfiguration{}
+	case operatorv1alpha1.SchemeGroupVersion.WithKind("EtcdSnapshot"):
+		return &applyconfigurationoperatorv1alpha1.EtcdSnapshotApplyConfiguration{}
+	case operatorv1alpha1.SchemeGroupVersion.WithKind("EtcdSnapshotSpec"):
+		return &applyconfigurationoperatorv1alpha1.EtcdSnapshotSpecApplyConfiguration{}
+	case operatorv1alpha1.SchemeGroupVersion.WithKind("EtcdSnapshotStatus"):
+		return &applyconfigurationoperatorv1alpha1.EtcdSnapshotStatusApplyConfiguration{}
+	case operatorv1alpha1.SchemeGroupVersion.WithKind("EtcdSnapshotStorage"):
+		return &applyconfigurationoperatorv1alpha1.EtcdSnapshotStorageApplyConfiguration{}
+	case operatorv1alpha1.SchemeGroupVersion.WithKind("EtcdSnapshotTarget"):
+		return &applyconfigurationoperatorv1alpha1.EtcdSnapshotTargetApplyConfiguration{}
 	case operatorv1alpha1.SchemeGroupVersion.WithKind("EtcdTLSConfig"):
 		return &applyconfigurationoperatorv1alpha1.EtcdTLSConfigApplyConfiguration{}
 	case operatorv1alpha1.SchemeGroupVersion.WithKind("ExternalConfig"):
@@ -135,6 +169,10 @@ func ForKind(kind schema.GroupVersionKind) interface{} {
 		return &applyconfigurationoperatorv1alpha1.LocalDataKeyReferenceApplyConfiguration{}
 	case operatorv1alpha1.SchemeGroupVersion.WithKind("LoggingSpec"):
 		return &applyconfigurationoperatorv1alpha1.LoggingSpecApplyConfiguration{}
+	case operatorv1alpha1.SchemeGroupVersion.WithKind("ManagedEtcdSpec"):
+		return &applyconfigurationoperatorv1alpha1.ManagedEtcdSpecApplyConfiguration{}
+	case operatorv1alpha1.SchemeGroupVersion.WithKind("ManagedEtcdStorageSpec"):
+		return &applyconfigurationoperatorv1alpha1.ManagedEtcdStorageSpecApplyConfiguration{}
 	case operatorv1alpha1.SchemeGroupVersion.WithKind("ObjectReference"):
 		return &applyconfigurationoperatorv1alpha1.ObjectReferenceApplyConfiguration{}
 	case operatorv1alpha1.SchemeGroupVersion.WithKind("OIDCCAFileRef"):
@@ -143,6 +181,18 @@ func ForKind(kind schema.GroupVersionKind) interface{} {
 		return &applyconfigurationoperatorv1alpha1.OIDCConfigurationApplyConfiguration{}
 	case operatorv1alpha1.SchemeGroupVersion.WithKind("PathMappingEntry"):
 		return &applyconfigurationoperatorv1alpha1.PathMappingEntryApplyConfiguration{}
+	case operatorv1alpha1.SchemeGroupVersion.WithKind("PersistentVolumeClaimSnapshotStorage"):
+		return &applyconfigurationoperatorv1alpha1.PersistentVolumeClaimSnapshotStorageApplyConfiguration{}
+	case operatorv1alpha1.SchemeGroupVersion.WithKind("Placement"):
+		return &applyconfigurationoperatorv1alpha1.PlacementApplyConfiguration{}
+	case operatorv1alpha1.SchemeGroupVersion.WithKind("PlacementStatus"):
+		return &applyconfigurationoperatorv1alpha1.PlacementStatusApplyConfiguration{}
+	case operatorv1alpha1.SchemeGroupVersion.WithKind("PodDisruptionBudgetMetadataTemplate"):
+		return &applyconfigurationoperatorv1alpha1.PodDisruptionBudgetMetadataTemplateApplyConfiguration{}
+	case operatorv1alpha1.SchemeGroupVersion.WithKind("PodDisruptionBudgetSpecTemplate"):
+		return &applyconfigurationoperatorv1alpha1.PodDisruptionBudgetSpecTemplateApplyConfiguration{}
+	case operatorv1alpha1.SchemeGroupVersion.WithKind("PodDisruptionBudgetTemplate"):
+		return &applyconfigurationoperatorv1alpha1.PodDisruptionBudgetTemplateApplyConfiguration{}
 	case operatorv1alpha1.SchemeGroupVersion.WithKind("PodMetadataTemplate"):
 		return &applyconfigurationoperatorv1alpha1.PodMetadataTemplateApplyConfiguration{}
 	case operatorv1alpha1.SchemeGroupVersion.WithKind("PodSpecTemplate"):
@@ -161,6 +211,8 @@ func ForKind(kind schema.GroupVersionKind) interface{} {
 		return &applyconfigurationoperatorv1alpha1.RootShardSpecApplyConfiguration{}
 	case operatorv1alpha1.SchemeGroupVersion.WithKind("RootShardStatus"):
 		return &applyconfigurationoperatorv1alpha1.RootShardStatusApplyConfiguration{}
+	case operatorv1alpha1.SchemeGroupVersion.WithKind("S3SnapshotStorage"):
+		return &applyconfigurationoperatorv1alpha1.S3SnapshotStorageApplyConfiguration{}
 	case operatorv1alpha1.SchemeGroupVersion.WithKind("ServiceAccountAuthentication"):
 		return &applyconfigurationoperatorv1alpha1.ServiceAccountAuthenticationApplyConfiguration{}
 	case operatorv1alpha1.SchemeGroupVersion.WithKind("ServiceMetadataTemplate"):
@@ -181,6 +233,12 @@ func ForKind(kind schema.GroupVersionKind) interface{} {
 		return &applyconfigurationoperatorv1alpha1.ShardStatusApplyConfiguration{}
 	case operatorv1alpha1.SchemeGroupVersion.WithKind("TokenAuthFileSpec"):
 		return &applyconfigurationoperatorv1alpha1.TokenAuthFileSpecApplyConfiguration{}
+	case operatorv1alpha1.SchemeGroupVersion.WithKind("TrustBundleSpec"):
+		return &applyconfigurationoperatorv1alpha1.TrustBundleSpecApplyConfiguration{}
+	case operatorv1alpha1.SchemeGroupVersion.WithKind("UpgradeStatus"):
+		return &applyconfigurationoperatorv1alpha1.UpgradeStatusApplyConfiguration{}
+	case operatorv1alpha1.SchemeGroupVersion.WithKind("VersionStatus"):
+		return &applyconfigurationoperatorv1alpha1.VersionStatusApplyConfiguration{}
 	case operatorv1alpha1.SchemeGroupVersion.WithKind("VirtualWorkspace"):
 		return &applyconfigurationoperatorv1alpha1.VirtualWorkspaceApplyConfiguration{}
 	case operatorv1alpha1.SchemeGroupVersion.WithKind("VirtualWorkspaceInitContainer"):
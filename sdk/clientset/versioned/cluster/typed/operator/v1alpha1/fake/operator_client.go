@@ -48,6 +48,18 @@ func (c *OperatorV1alpha1ClusterClient) CacheServers() kcpoperatorv1alpha1.Cache
 	return &cacheServersClusterClient{Fake: c.Fake}
 }
 
+func (c *OperatorV1alpha1ClusterClient) EtcdBackupSchedules() kcpoperatorv1alpha1.EtcdBackupScheduleClusterInterface {
+	return &etcdBackupSchedulesClusterClient{Fake: c.Fake}
+}
+
+func (c *OperatorV1alpha1ClusterClient) EtcdRestores() kcpoperatorv1alpha1.EtcdRestoreClusterInterface {
+	return &etcdRestoresClusterClient{Fake: c.Fake}
+}
+
+func (c *OperatorV1alpha1ClusterClient) EtcdSnapshots() kcpoperatorv1alpha1.EtcdSnapshotClusterInterface {
+	return &etcdSnapshotsClusterClient{Fake: c.Fake}
+}
+
 func (c *OperatorV1alpha1ClusterClient) FrontProxies() kcpoperatorv1alpha1.FrontProxyClusterInterface {
 	return &frontProxiesClusterClient{Fake: c.Fake}
 }
@@ -84,6 +96,18 @@ func (c *OperatorV1alpha1Client) CacheServers(namespace string) operatorv1alpha1
 	return &cacheServersClient{Fake: c.Fake, ClusterPath: c.ClusterPath, Namespace: namespace}
 }
 
+func (c *OperatorV1alpha1Client) EtcdBackupSchedules(namespace string) operatorv1alpha1.EtcdBackupScheduleInterface {
+	return &etcdBackupSchedulesClient{Fake: c.Fake, ClusterPath: c.ClusterPath, Namespace: namespace}
+}
+
+func (c *OperatorV1alpha1Client) EtcdRestores(namespace string) operatorv1alpha1.EtcdRestoreInterface {
+	return &etcdRestoresClient{Fake: c.Fake, ClusterPath: c.ClusterPath, Namespace: namespace}
+}
+
+func (c *OperatorV1alpha1Client) EtcdSnapshots(namespace string) operatorv1alpha1.EtcdSnapshotInterface {
+	return &etcdSnapshotsClient{Fake: c.Fake, ClusterPath: c.ClusterPath, Namespace: namespace}
+}
+
 func (c *OperatorV1alpha1Client) FrontProxies(namespace string) operatorv1alpha1.FrontProxyInterface {
 	return &frontProxiesClient{Fake: c.Fake, ClusterPath: c.ClusterPath, Namespace: namespace}
 }
@@ -35,6 +35,9 @@ import (
 type OperatorV1alpha1ClusterInterface interface {
 	OperatorV1alpha1ClusterScoper
 	CacheServersClusterGetter
+	EtcdBackupSchedulesClusterGetter
+	EtcdRestoresClusterGetter
+	EtcdSnapshotsClusterGetter
 	FrontProxiesClusterGetter
 	KubeconfigsClusterGetter
 	RootShardsClusterGetter
@@ -61,6 +64,18 @@ func (c *OperatorV1alpha1ClusterClient) CacheServers() CacheServerClusterInterfa
 	return &cacheServersClusterInterface{clientCache: c.clientCache}
 }
 
+func (c *OperatorV1alpha1ClusterClient) EtcdBackupSchedules() EtcdBackupScheduleClusterInterface {
+	return &etcdBackupSchedulesClusterInterface{clientCache: c.clientCache}
+}
+
+func (c *OperatorV1alpha1ClusterClient) EtcdRestores() EtcdRestoreClusterInterface {
+	return &etcdRestoresClusterInterface{clientCache: c.clientCache}
+}
+
+func (c *OperatorV1alpha1ClusterClient) EtcdSnapshots() EtcdSnapshotClusterInterface {
+	return &etcdSnapshotsClusterInterface{clientCache: c.clientCache}
+}
+
 func (c *OperatorV1alpha1ClusterClient) FrontProxies() FrontProxyClusterInterface {
 	return &frontProxiesClusterInterface{clientCache: c.clientCache}
 }
@@ -33,6 +33,18 @@ func (c *FakeOperatorV1alpha1) CacheServers(namespace string) v1alpha1.CacheServ
 	return newFakeCacheServers(c, namespace)
 }
 
+func (c *FakeOperatorV1alpha1) EtcdBackupSchedules(namespace string) v1alpha1.EtcdBackupScheduleInterface {
+	return newFakeEtcdBackupSchedules(c, namespace)
+}
+
+func (c *FakeOperatorV1alpha1) EtcdRestores(namespace string) v1alpha1.EtcdRestoreInterface {
+	return newFakeEtcdRestores(c, namespace)
+}
+
+func (c *FakeOperatorV1alpha1) EtcdSnapshots(namespace string) v1alpha1.EtcdSnapshotInterface {
+	return newFakeEtcdSnapshots(c, namespace)
+}
+
 func (c *FakeOperatorV1alpha1) FrontProxies(namespace string) v1alpha1.FrontProxyInterface {
 	return newFakeFrontProxies(c, namespace)
 }
@@ -20,6 +20,12 @@ package v1alpha1
 
 type CacheServerExpansion interface{}
 
+type EtcdBackupScheduleExpansion interface{}
+
+type EtcdRestoreExpansion interface{}
+
+type EtcdSnapshotExpansion interface{}
+
 type FrontProxyExpansion interface{}
 
 type KubeconfigExpansion interface{}
@@ -30,6 +30,9 @@ import (
 type OperatorV1alpha1Interface interface {
 	RESTClient() rest.Interface
 	CacheServersGetter
+	EtcdBackupSchedulesGetter
+	EtcdRestoresGetter
+	EtcdSnapshotsGetter
 	FrontProxiesGetter
 	KubeconfigsGetter
 	RootShardsGetter
@@ -46,6 +49,18 @@ func (c *OperatorV1alpha1Client) CacheServers(namespace string) CacheServerInter
 	return newCacheServers(c, namespace)
 }
 
+func (c *OperatorV1alpha1Client) EtcdBackupSchedules(namespace string) EtcdBackupScheduleInterface {
+	return newEtcdBackupSchedules(c, namespace)
+}
+
+func (c *OperatorV1alpha1Client) EtcdRestores(namespace string) EtcdRestoreInterface {
+	return newEtcdRestores(c, namespace)
+}
+
+func (c *OperatorV1alpha1Client) EtcdSnapshots(namespace string) EtcdSnapshotInterface {
+	return newEtcdSnapshots(c, namespace)
+}
+
 func (c *OperatorV1alpha1Client) FrontProxies(namespace string) FrontProxyInterface {
 	return newFrontProxies(c, namespace)
 }
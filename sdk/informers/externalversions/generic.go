@@ -101,6 +101,12 @@ func (f *sharedInformerFactory) ForResource(resource schema.GroupVersionResource
 	// Group=operator.kcp.io, Version=V1alpha1
 	case operatorv1alpha1.SchemeGroupVersion.WithResource("cacheservers"):
 		return &genericClusterInformer{resource: resource.GroupResource(), informer: f.Operator().V1alpha1().CacheServers().Informer()}, nil
+	case operatorv1alpha1.SchemeGroupVersion.WithResource("etcdbackupschedules"):
+		return &genericClusterInformer{resource: resource.GroupResource(), informer: f.Operator().V1alpha1().EtcdBackupSchedules().Informer()}, nil
+	case operatorv1alpha1.SchemeGroupVersion.WithResource("etcdrestores"):
+		return &genericClusterInformer{resource: resource.GroupResource(), informer: f.Operator().V1alpha1().EtcdRestores().Informer()}, nil
+	case operatorv1alpha1.SchemeGroupVersion.WithResource("etcdsnapshots"):
+		return &genericClusterInformer{resource: resource.GroupResource(), informer: f.Operator().V1alpha1().EtcdSnapshots().Informer()}, nil
 	case operatorv1alpha1.SchemeGroupVersion.WithResource("frontproxies"):
 		return &genericClusterInformer{resource: resource.GroupResource(), informer: f.Operator().V1alpha1().FrontProxies().Informer()}, nil
 	case operatorv1alpha1.SchemeGroupVersion.WithResource("kubeconfigs"):
@@ -140,6 +146,15 @@ func (f *sharedScopedInformerFactory) ForResource(resource schema.GroupVersionRe
 	case operatorv1alpha1.SchemeGroupVersion.WithResource("cacheservers"):
 		informer := f.Operator().V1alpha1().CacheServers().Informer()
 		return &genericInformer{lister: cache.NewGenericLister(informer.GetIndexer(), resource.GroupResource()), informer: informer}, nil
+	case operatorv1alpha1.SchemeGroupVersion.WithResource("etcdbackupschedules"):
+		informer := f.Operator().V1alpha1().EtcdBackupSchedules().Informer()
+		return &genericInformer{lister: cache.NewGenericLister(informer.GetIndexer(), resource.GroupResource()), informer: informer}, nil
+	case operatorv1alpha1.SchemeGroupVersion.WithResource("etcdrestores"):
+		informer := f.Operator().V1alpha1().EtcdRestores().Informer()
+		return &genericInformer{lister: cache.NewGenericLister(informer.GetIndexer(), resource.GroupResource()), informer: informer}, nil
+	case operatorv1alpha1.SchemeGroupVersion.WithResource("etcdsnapshots"):
+		informer := f.Operator().V1alpha1().EtcdSnapshots().Informer()
+		return &genericInformer{lister: cache.NewGenericLister(informer.GetIndexer(), resource.GroupResource()), informer: informer}, nil
 	case operatorv1alpha1.SchemeGroupVersion.WithResource("frontproxies"):
 		informer := f.Operator().V1alpha1().FrontProxies().Informer()
 		return &genericInformer{lister: cache.NewGenericLister(informer.GetIndexer(), resource.GroupResource()), informer: informer}, nil
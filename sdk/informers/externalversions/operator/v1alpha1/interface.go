@@ -28,6 +28,12 @@ import (
 type ClusterInterface interface {
 	// CacheServers returns a CacheServerClusterInformer
 	CacheServers() CacheServerClusterInformer
+	// EtcdBackupSchedules returns a EtcdBackupScheduleClusterInformer
+	EtcdBackupSchedules() EtcdBackupScheduleClusterInformer
+	// EtcdRestores returns a EtcdRestoreClusterInformer
+	EtcdRestores() EtcdRestoreClusterInformer
+	// EtcdSnapshots returns a EtcdSnapshotClusterInformer
+	EtcdSnapshots() EtcdSnapshotClusterInformer
 	// FrontProxies returns a FrontProxyClusterInformer
 	FrontProxies() FrontProxyClusterInformer
 	// Kubeconfigs returns a KubeconfigClusterInformer
@@ -55,6 +61,21 @@ func (v *version) CacheServers() CacheServerClusterInformer {
 	return &cacheServerClusterInformer{factory: v.factory, tweakListOptions: v.tweakListOptions}
 }
 
+// EtcdBackupSchedules returns a EtcdBackupScheduleClusterInformer
+func (v *version) EtcdBackupSchedules() EtcdBackupScheduleClusterInformer {
+	return &etcdBackupScheduleClusterInformer{factory: v.factory, tweakListOptions: v.tweakListOptions}
+}
+
+// EtcdRestores returns a EtcdRestoreClusterInformer
+func (v *version) EtcdRestores() EtcdRestoreClusterInformer {
+	return &etcdRestoreClusterInformer{factory: v.factory, tweakListOptions: v.tweakListOptions}
+}
+
+// EtcdSnapshots returns a EtcdSnapshotClusterInformer
+func (v *version) EtcdSnapshots() EtcdSnapshotClusterInformer {
+	return &etcdSnapshotClusterInformer{factory: v.factory, tweakListOptions: v.tweakListOptions}
+}
+
 // FrontProxies returns a FrontProxyClusterInformer
 func (v *version) FrontProxies() FrontProxyClusterInformer {
 	return &frontProxyClusterInformer{factory: v.factory, tweakListOptions: v.tweakListOptions}
@@ -83,6 +104,12 @@ func (v *version) VirtualWorkspaces() VirtualWorkspaceClusterInformer {
 type Interface interface {
 	// CacheServers returns a CacheServerInformer
 	CacheServers() CacheServerInformer
+	// EtcdBackupSchedules returns a EtcdBackupScheduleInformer
+	EtcdBackupSchedules() EtcdBackupScheduleInformer
+	// EtcdRestores returns a EtcdRestoreInformer
+	EtcdRestores() EtcdRestoreInformer
+	// EtcdSnapshots returns a EtcdSnapshotInformer
+	EtcdSnapshots() EtcdSnapshotInformer
 	// FrontProxies returns a FrontProxyInformer
 	FrontProxies() FrontProxyInformer
 	// Kubeconfigs returns a KubeconfigInformer
@@ -111,6 +138,21 @@ func (v *scopedVersion) CacheServers() CacheServerInformer {
 	return &cacheServerScopedInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
 }
 
+// EtcdBackupSchedules returns a EtcdBackupScheduleInformer
+func (v *scopedVersion) EtcdBackupSchedules() EtcdBackupScheduleInformer {
+	return &etcdBackupScheduleScopedInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
+}
+
+// EtcdRestores returns a EtcdRestoreInformer
+func (v *scopedVersion) EtcdRestores() EtcdRestoreInformer {
+	return &etcdRestoreScopedInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
+}
+
+// EtcdSnapshots returns a EtcdSnapshotInformer
+func (v *scopedVersion) EtcdSnapshots() EtcdSnapshotInformer {
+	return &etcdSnapshotScopedInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
+}
+
 // FrontProxies returns a FrontProxyInformer
 func (v *scopedVersion) FrontProxies() FrontProxyInformer {
 	return &frontProxyScopedInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}